@@ -284,6 +284,8 @@ func CheckerFromEvent(event Event) (EventChecker, error) {
 		return NewProcessLoaderChecker("").FromProcessLoader(ev), nil
 	case *tetragon.RateLimitInfo:
 		return NewRateLimitInfoChecker("").FromRateLimitInfo(ev), nil
+	case *tetragon.EventsGap:
+		return NewEventsGapChecker("").FromEventsGap(ev), nil
 	case *tetragon.ProcessThrottle:
 		return NewProcessThrottleChecker("").FromProcessThrottle(ev), nil
 
@@ -350,6 +352,8 @@ func EventFromResponse(response *tetragon.GetEventsResponse) (Event, error) {
 		return ev.ProcessLoader, nil
 	case *tetragon.GetEventsResponse_RateLimitInfo:
 		return ev.RateLimitInfo, nil
+	case *tetragon.GetEventsResponse_EventsGap:
+		return ev.EventsGap, nil
 	case *tetragon.GetEventsResponse_ProcessThrottle:
 		return ev.ProcessThrottle, nil
 
@@ -748,6 +752,8 @@ type ProcessKprobeChecker struct {
 	UserStackTrace   *StackTraceEntryListMatcher  `json:"userStackTrace,omitempty"`
 	Ancestors        *ProcessListMatcher          `json:"ancestors,omitempty"`
 	Data             *KprobeArgumentListMatcher   `json:"data,omitempty"`
+	Sequence         *SequenceMatchChecker        `json:"sequence,omitempty"`
+	SampleRate       *uint32                      `json:"sampleRate,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -859,6 +865,16 @@ func (checker *ProcessKprobeChecker) Check(event *tetragon.ProcessKprobe) error
 				return fmt.Errorf("Data check failed: %w", err)
 			}
 		}
+		if checker.Sequence != nil {
+			if err := checker.Sequence.Check(event.Sequence); err != nil {
+				return fmt.Errorf("Sequence check failed: %w", err)
+			}
+		}
+		if checker.SampleRate != nil {
+			if *checker.SampleRate != event.SampleRate {
+				return fmt.Errorf("SampleRate has value %d which does not match expected value %d", event.SampleRate, *checker.SampleRate)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -953,6 +969,18 @@ func (checker *ProcessKprobeChecker) WithData(check *KprobeArgumentListMatcher)
 	return checker
 }
 
+// WithSequence adds a Sequence check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithSequence(check *SequenceMatchChecker) *ProcessKprobeChecker {
+	checker.Sequence = check
+	return checker
+}
+
+// WithSampleRate adds a SampleRate check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithSampleRate(check uint32) *ProcessKprobeChecker {
+	checker.SampleRate = &check
+	return checker
+}
+
 //FromProcessKprobe populates the ProcessKprobeChecker using data from a ProcessKprobe event
 func (checker *ProcessKprobeChecker) FromProcessKprobe(event *tetragon.ProcessKprobe) *ProcessKprobeChecker {
 	if event == nil {
@@ -1048,6 +1076,13 @@ func (checker *ProcessKprobeChecker) FromProcessKprobe(event *tetragon.ProcessKp
 			WithValues(checks...)
 		checker.Data = lm
 	}
+	if event.Sequence != nil {
+		checker.Sequence = NewSequenceMatchChecker().FromSequenceMatch(event.Sequence)
+	}
+	{
+		val := event.SampleRate
+		checker.SampleRate = &val
+	}
 	return checker
 }
 
@@ -1364,6 +1399,8 @@ type ProcessTracepointChecker struct {
 	Message     *stringmatcher.StringMatcher `json:"message,omitempty"`
 	Tags        *StringListMatcher           `json:"tags,omitempty"`
 	Ancestors   *ProcessListMatcher          `json:"ancestors,omitempty"`
+	Sequence    *SequenceMatchChecker        `json:"sequence,omitempty"`
+	SampleRate  *uint32                      `json:"sampleRate,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -1455,6 +1492,16 @@ func (checker *ProcessTracepointChecker) Check(event *tetragon.ProcessTracepoint
 				return fmt.Errorf("Ancestors check failed: %w", err)
 			}
 		}
+		if checker.Sequence != nil {
+			if err := checker.Sequence.Check(event.Sequence); err != nil {
+				return fmt.Errorf("Sequence check failed: %w", err)
+			}
+		}
+		if checker.SampleRate != nil {
+			if *checker.SampleRate != event.SampleRate {
+				return fmt.Errorf("SampleRate has value %d which does not match expected value %d", event.SampleRate, *checker.SampleRate)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -1524,6 +1571,18 @@ func (checker *ProcessTracepointChecker) WithAncestors(check *ProcessListMatcher
 	return checker
 }
 
+// WithSequence adds a Sequence check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithSequence(check *SequenceMatchChecker) *ProcessTracepointChecker {
+	checker.Sequence = check
+	return checker
+}
+
+// WithSampleRate adds a SampleRate check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithSampleRate(check uint32) *ProcessTracepointChecker {
+	checker.SampleRate = &check
+	return checker
+}
+
 //FromProcessTracepoint populates the ProcessTracepointChecker using data from a ProcessTracepoint event
 func (checker *ProcessTracepointChecker) FromProcessTracepoint(event *tetragon.ProcessTracepoint) *ProcessTracepointChecker {
 	if event == nil {
@@ -1577,6 +1636,13 @@ func (checker *ProcessTracepointChecker) FromProcessTracepoint(event *tetragon.P
 			WithValues(checks...)
 		checker.Ancestors = lm
 	}
+	if event.Sequence != nil {
+		checker.Sequence = NewSequenceMatchChecker().FromSequenceMatch(event.Sequence)
+	}
+	{
+		val := event.SampleRate
+		checker.SampleRate = &val
+	}
 	return checker
 }
 
@@ -1596,6 +1662,7 @@ type ProcessUprobeChecker struct {
 	RefCtrOffset *uint64                      `json:"refCtrOffset,omitempty"`
 	Action       *KprobeActionChecker         `json:"action,omitempty"`
 	Data         *KprobeArgumentListMatcher   `json:"data,omitempty"`
+	SampleRate   *uint32                      `json:"sampleRate,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -1702,6 +1769,11 @@ func (checker *ProcessUprobeChecker) Check(event *tetragon.ProcessUprobe) error
 				return fmt.Errorf("Data check failed: %w", err)
 			}
 		}
+		if checker.SampleRate != nil {
+			if *checker.SampleRate != event.SampleRate {
+				return fmt.Errorf("SampleRate has value %d which does not match expected value %d", event.SampleRate, *checker.SampleRate)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -1789,6 +1861,12 @@ func (checker *ProcessUprobeChecker) WithData(check *KprobeArgumentListMatcher)
 	return checker
 }
 
+// WithSampleRate adds a SampleRate check to the ProcessUprobeChecker
+func (checker *ProcessUprobeChecker) WithSampleRate(check uint32) *ProcessUprobeChecker {
+	checker.SampleRate = &check
+	return checker
+}
+
 //FromProcessUprobe populates the ProcessUprobeChecker using data from a ProcessUprobe event
 func (checker *ProcessUprobeChecker) FromProcessUprobe(event *tetragon.ProcessUprobe) *ProcessUprobeChecker {
 	if event == nil {
@@ -1863,6 +1941,10 @@ func (checker *ProcessUprobeChecker) FromProcessUprobe(event *tetragon.ProcessUp
 			WithValues(checks...)
 		checker.Data = lm
 	}
+	{
+		val := event.SampleRate
+		checker.SampleRate = &val
+	}
 	return checker
 }
 
@@ -1881,6 +1963,7 @@ type ProcessUsdtChecker struct {
 	Ancestors   *ProcessListMatcher          `json:"ancestors,omitempty"`
 	Action      *KprobeActionChecker         `json:"action,omitempty"`
 	Flags       *stringmatcher.StringMatcher `json:"flags,omitempty"`
+	SampleRate  *uint32                      `json:"sampleRate,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -1982,6 +2065,11 @@ func (checker *ProcessUsdtChecker) Check(event *tetragon.ProcessUsdt) error {
 				return fmt.Errorf("Flags check failed: %w", err)
 			}
 		}
+		if checker.SampleRate != nil {
+			if *checker.SampleRate != event.SampleRate {
+				return fmt.Errorf("SampleRate has value %d which does not match expected value %d", event.SampleRate, *checker.SampleRate)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -2063,6 +2151,12 @@ func (checker *ProcessUsdtChecker) WithFlags(check *stringmatcher.StringMatcher)
 	return checker
 }
 
+// WithSampleRate adds a SampleRate check to the ProcessUsdtChecker
+func (checker *ProcessUsdtChecker) WithSampleRate(check uint32) *ProcessUsdtChecker {
+	checker.SampleRate = &check
+	return checker
+}
+
 //FromProcessUsdt populates the ProcessUsdtChecker using data from a ProcessUsdt event
 func (checker *ProcessUsdtChecker) FromProcessUsdt(event *tetragon.ProcessUsdt) *ProcessUsdtChecker {
 	if event == nil {
@@ -2118,6 +2212,10 @@ func (checker *ProcessUsdtChecker) FromProcessUsdt(event *tetragon.ProcessUsdt)
 	}
 	checker.Action = NewKprobeActionChecker(event.Action)
 	checker.Flags = stringmatcher.Full(event.Flags)
+	{
+		val := event.SampleRate
+		checker.SampleRate = &val
+	}
 	return checker
 }
 
@@ -2134,6 +2232,7 @@ type ProcessLsmChecker struct {
 	Tags         *StringListMatcher           `json:"tags,omitempty"`
 	Ancestors    *ProcessListMatcher          `json:"ancestors,omitempty"`
 	ImaHash      *stringmatcher.StringMatcher `json:"imaHash,omitempty"`
+	SampleRate   *uint32                      `json:"sampleRate,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -2225,6 +2324,11 @@ func (checker *ProcessLsmChecker) Check(event *tetragon.ProcessLsm) error {
 				return fmt.Errorf("ImaHash check failed: %w", err)
 			}
 		}
+		if checker.SampleRate != nil {
+			if *checker.SampleRate != event.SampleRate {
+				return fmt.Errorf("SampleRate has value %d which does not match expected value %d", event.SampleRate, *checker.SampleRate)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -2294,6 +2398,12 @@ func (checker *ProcessLsmChecker) WithImaHash(check *stringmatcher.StringMatcher
 	return checker
 }
 
+// WithSampleRate adds a SampleRate check to the ProcessLsmChecker
+func (checker *ProcessLsmChecker) WithSampleRate(check uint32) *ProcessLsmChecker {
+	checker.SampleRate = &check
+	return checker
+}
+
 //FromProcessLsm populates the ProcessLsmChecker using data from a ProcessLsm event
 func (checker *ProcessLsmChecker) FromProcessLsm(event *tetragon.ProcessLsm) *ProcessLsmChecker {
 	if event == nil {
@@ -2347,6 +2457,10 @@ func (checker *ProcessLsmChecker) FromProcessLsm(event *tetragon.ProcessLsm) *Pr
 		checker.Ancestors = lm
 	}
 	checker.ImaHash = stringmatcher.Full(event.ImaHash)
+	{
+		val := event.SampleRate
+		checker.SampleRate = &val
+	}
 	return checker
 }
 
@@ -2691,11 +2805,121 @@ func (checker *RateLimitInfoChecker) FromRateLimitInfo(event *tetragon.RateLimit
 	return checker
 }
 
+// EventsGapChecker implements a checker struct to check a EventsGap event
+type EventsGapChecker struct {
+	CheckerName     string  `json:"checkerName"`
+	FirstSequence   *uint64 `json:"firstSequence,omitempty"`
+	LastSequence    *uint64 `json:"lastSequence,omitempty"`
+	InstanceChanged *bool   `json:"instanceChanged,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *EventsGapChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.EventsGap); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%s: %T is not a EventsGap event", CheckerLogPrefix(checker), event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *EventsGapChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewEventsGapChecker creates a new EventsGapChecker
+func NewEventsGapChecker(name string) *EventsGapChecker {
+	return &EventsGapChecker{CheckerName: name}
+}
+
+// Get the name associated with the checker
+func (checker *EventsGapChecker) GetCheckerName() string {
+	return checker.CheckerName
+}
+
+// Get the type of the checker as a string
+func (checker *EventsGapChecker) GetCheckerType() string {
+	return "EventsGapChecker"
+}
+
+// Check checks a EventsGap event
+func (checker *EventsGapChecker) Check(event *tetragon.EventsGap) error {
+	if event == nil {
+		return fmt.Errorf("%s: EventsGap event is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.FirstSequence != nil {
+			if *checker.FirstSequence != event.FirstSequence {
+				return fmt.Errorf("FirstSequence has value %d which does not match expected value %d", event.FirstSequence, *checker.FirstSequence)
+			}
+		}
+		if checker.LastSequence != nil {
+			if *checker.LastSequence != event.LastSequence {
+				return fmt.Errorf("LastSequence has value %d which does not match expected value %d", event.LastSequence, *checker.LastSequence)
+			}
+		}
+		if checker.InstanceChanged != nil {
+			if *checker.InstanceChanged != event.InstanceChanged {
+				return fmt.Errorf("InstanceChanged has value %t which does not match expected value %t", event.InstanceChanged, *checker.InstanceChanged)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithFirstSequence adds a FirstSequence check to the EventsGapChecker
+func (checker *EventsGapChecker) WithFirstSequence(check uint64) *EventsGapChecker {
+	checker.FirstSequence = &check
+	return checker
+}
+
+// WithLastSequence adds a LastSequence check to the EventsGapChecker
+func (checker *EventsGapChecker) WithLastSequence(check uint64) *EventsGapChecker {
+	checker.LastSequence = &check
+	return checker
+}
+
+// WithInstanceChanged adds a InstanceChanged check to the EventsGapChecker
+func (checker *EventsGapChecker) WithInstanceChanged(check bool) *EventsGapChecker {
+	checker.InstanceChanged = &check
+	return checker
+}
+
+//FromEventsGap populates the EventsGapChecker using data from a EventsGap event
+func (checker *EventsGapChecker) FromEventsGap(event *tetragon.EventsGap) *EventsGapChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.FirstSequence
+		checker.FirstSequence = &val
+	}
+	{
+		val := event.LastSequence
+		checker.LastSequence = &val
+	}
+	{
+		val := event.InstanceChanged
+		checker.InstanceChanged = &val
+	}
+	return checker
+}
+
 // ProcessThrottleChecker implements a checker struct to check a ProcessThrottle event
 type ProcessThrottleChecker struct {
-	CheckerName string                       `json:"checkerName"`
-	Type        *ThrottleTypeChecker         `json:"type,omitempty"`
-	Cgroup      *stringmatcher.StringMatcher `json:"cgroup,omitempty"`
+	CheckerName     string                       `json:"checkerName"`
+	Type            *ThrottleTypeChecker         `json:"type,omitempty"`
+	Cgroup          *stringmatcher.StringMatcher `json:"cgroup,omitempty"`
+	PolicyName      *stringmatcher.StringMatcher `json:"policyName,omitempty"`
+	PolicyNamespace *stringmatcher.StringMatcher `json:"policyNamespace,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -2747,6 +2971,16 @@ func (checker *ProcessThrottleChecker) Check(event *tetragon.ProcessThrottle) er
 				return fmt.Errorf("Cgroup check failed: %w", err)
 			}
 		}
+		if checker.PolicyName != nil {
+			if err := checker.PolicyName.Match(event.PolicyName); err != nil {
+				return fmt.Errorf("PolicyName check failed: %w", err)
+			}
+		}
+		if checker.PolicyNamespace != nil {
+			if err := checker.PolicyNamespace.Match(event.PolicyNamespace); err != nil {
+				return fmt.Errorf("PolicyNamespace check failed: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -2768,6 +3002,18 @@ func (checker *ProcessThrottleChecker) WithCgroup(check *stringmatcher.StringMat
 	return checker
 }
 
+// WithPolicyName adds a PolicyName check to the ProcessThrottleChecker
+func (checker *ProcessThrottleChecker) WithPolicyName(check *stringmatcher.StringMatcher) *ProcessThrottleChecker {
+	checker.PolicyName = check
+	return checker
+}
+
+// WithPolicyNamespace adds a PolicyNamespace check to the ProcessThrottleChecker
+func (checker *ProcessThrottleChecker) WithPolicyNamespace(check *stringmatcher.StringMatcher) *ProcessThrottleChecker {
+	checker.PolicyNamespace = check
+	return checker
+}
+
 //FromProcessThrottle populates the ProcessThrottleChecker using data from a ProcessThrottle event
 func (checker *ProcessThrottleChecker) FromProcessThrottle(event *tetragon.ProcessThrottle) *ProcessThrottleChecker {
 	if event == nil {
@@ -2775,6 +3021,8 @@ func (checker *ProcessThrottleChecker) FromProcessThrottle(event *tetragon.Proce
 	}
 	checker.Type = NewThrottleTypeChecker(event.Type)
 	checker.Cgroup = stringmatcher.Full(event.Cgroup)
+	checker.PolicyName = stringmatcher.Full(event.PolicyName)
+	checker.PolicyNamespace = stringmatcher.Full(event.PolicyNamespace)
 	return checker
 }
 
@@ -5065,17 +5313,19 @@ nextCheck:
 
 // KprobeSockChecker implements a checker struct to check a KprobeSock field
 type KprobeSockChecker struct {
-	Family   *stringmatcher.StringMatcher `json:"family,omitempty"`
-	Type     *stringmatcher.StringMatcher `json:"type,omitempty"`
-	Protocol *stringmatcher.StringMatcher `json:"protocol,omitempty"`
-	Mark     *uint32                      `json:"mark,omitempty"`
-	Priority *uint32                      `json:"priority,omitempty"`
-	Saddr    *stringmatcher.StringMatcher `json:"saddr,omitempty"`
-	Daddr    *stringmatcher.StringMatcher `json:"daddr,omitempty"`
-	Sport    *uint32                      `json:"sport,omitempty"`
-	Dport    *uint32                      `json:"dport,omitempty"`
-	Cookie   *uint64                      `json:"cookie,omitempty"`
-	State    *stringmatcher.StringMatcher `json:"state,omitempty"`
+	Family      *stringmatcher.StringMatcher `json:"family,omitempty"`
+	Type        *stringmatcher.StringMatcher `json:"type,omitempty"`
+	Protocol    *stringmatcher.StringMatcher `json:"protocol,omitempty"`
+	Mark        *uint32                      `json:"mark,omitempty"`
+	Priority    *uint32                      `json:"priority,omitempty"`
+	Saddr       *stringmatcher.StringMatcher `json:"saddr,omitempty"`
+	Daddr       *stringmatcher.StringMatcher `json:"daddr,omitempty"`
+	Sport       *uint32                      `json:"sport,omitempty"`
+	Dport       *uint32                      `json:"dport,omitempty"`
+	Cookie      *uint64                      `json:"cookie,omitempty"`
+	State       *stringmatcher.StringMatcher `json:"state,omitempty"`
+	Destination *NetworkEndpointChecker      `json:"destination,omitempty"`
+	Hostnames   *StringListMatcher           `json:"hostnames,omitempty"`
 }
 
 // NewKprobeSockChecker creates a new KprobeSockChecker
@@ -5150,6 +5400,16 @@ func (checker *KprobeSockChecker) Check(event *tetragon.KprobeSock) error {
 				return fmt.Errorf("State check failed: %w", err)
 			}
 		}
+		if checker.Destination != nil {
+			if err := checker.Destination.Check(event.Destination); err != nil {
+				return fmt.Errorf("Destination check failed: %w", err)
+			}
+		}
+		if checker.Hostnames != nil {
+			if err := checker.Hostnames.Check(event.Hostnames); err != nil {
+				return fmt.Errorf("Hostnames check failed: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -5224,6 +5484,18 @@ func (checker *KprobeSockChecker) WithState(check *stringmatcher.StringMatcher)
 	return checker
 }
 
+// WithDestination adds a Destination check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithDestination(check *NetworkEndpointChecker) *KprobeSockChecker {
+	checker.Destination = check
+	return checker
+}
+
+// WithHostnames adds a Hostnames check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithHostnames(check *StringListMatcher) *KprobeSockChecker {
+	checker.Hostnames = check
+	return checker
+}
+
 //FromKprobeSock populates the KprobeSockChecker using data from a KprobeSock field
 func (checker *KprobeSockChecker) FromKprobeSock(event *tetragon.KprobeSock) *KprobeSockChecker {
 	if event == nil {
@@ -5255,6 +5527,20 @@ func (checker *KprobeSockChecker) FromKprobeSock(event *tetragon.KprobeSock) *Kp
 		checker.Cookie = &val
 	}
 	checker.State = stringmatcher.Full(event.State)
+	if event.Destination != nil {
+		checker.Destination = NewNetworkEndpointChecker().FromNetworkEndpoint(event.Destination)
+	}
+	{
+		var checks []*stringmatcher.StringMatcher
+		for _, check := range event.Hostnames {
+			var convertedCheck *stringmatcher.StringMatcher
+			convertedCheck = stringmatcher.Full(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStringListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Hostnames = lm
+	}
 	return checker
 }
 
@@ -5273,6 +5559,7 @@ type KprobeSkbChecker struct {
 	SecPathOlen *uint32                      `json:"secPathOlen,omitempty"`
 	Protocol    *stringmatcher.StringMatcher `json:"protocol,omitempty"`
 	Family      *stringmatcher.StringMatcher `json:"family,omitempty"`
+	Destination *NetworkEndpointChecker      `json:"destination,omitempty"`
 }
 
 // NewKprobeSkbChecker creates a new KprobeSkbChecker
@@ -5357,6 +5644,11 @@ func (checker *KprobeSkbChecker) Check(event *tetragon.KprobeSkb) error {
 				return fmt.Errorf("Family check failed: %w", err)
 			}
 		}
+		if checker.Destination != nil {
+			if err := checker.Destination.Check(event.Destination); err != nil {
+				return fmt.Errorf("Destination check failed: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -5443,6 +5735,12 @@ func (checker *KprobeSkbChecker) WithFamily(check *stringmatcher.StringMatcher)
 	return checker
 }
 
+// WithDestination adds a Destination check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithDestination(check *NetworkEndpointChecker) *KprobeSkbChecker {
+	checker.Destination = check
+	return checker
+}
+
 //FromKprobeSkb populates the KprobeSkbChecker using data from a KprobeSkb field
 func (checker *KprobeSkbChecker) FromKprobeSkb(event *tetragon.KprobeSkb) *KprobeSkbChecker {
 	if event == nil {
@@ -5488,14 +5786,19 @@ func (checker *KprobeSkbChecker) FromKprobeSkb(event *tetragon.KprobeSkb) *Kprob
 	}
 	checker.Protocol = stringmatcher.Full(event.Protocol)
 	checker.Family = stringmatcher.Full(event.Family)
+	if event.Destination != nil {
+		checker.Destination = NewNetworkEndpointChecker().FromNetworkEndpoint(event.Destination)
+	}
 	return checker
 }
 
 // KprobeSockaddrChecker implements a checker struct to check a KprobeSockaddr field
 type KprobeSockaddrChecker struct {
-	Family *stringmatcher.StringMatcher `json:"family,omitempty"`
-	Addr   *stringmatcher.StringMatcher `json:"addr,omitempty"`
-	Port   *uint32                      `json:"port,omitempty"`
+	Family      *stringmatcher.StringMatcher `json:"family,omitempty"`
+	Addr        *stringmatcher.StringMatcher `json:"addr,omitempty"`
+	Port        *uint32                      `json:"port,omitempty"`
+	Destination *NetworkEndpointChecker      `json:"destination,omitempty"`
+	Hostnames   *StringListMatcher           `json:"hostnames,omitempty"`
 }
 
 // NewKprobeSockaddrChecker creates a new KprobeSockaddrChecker
@@ -5530,6 +5833,16 @@ func (checker *KprobeSockaddrChecker) Check(event *tetragon.KprobeSockaddr) erro
 				return fmt.Errorf("Port has value %d which does not match expected value %d", event.Port, *checker.Port)
 			}
 		}
+		if checker.Destination != nil {
+			if err := checker.Destination.Check(event.Destination); err != nil {
+				return fmt.Errorf("Destination check failed: %w", err)
+			}
+		}
+		if checker.Hostnames != nil {
+			if err := checker.Hostnames.Check(event.Hostnames); err != nil {
+				return fmt.Errorf("Hostnames check failed: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -5556,6 +5869,18 @@ func (checker *KprobeSockaddrChecker) WithPort(check uint32) *KprobeSockaddrChec
 	return checker
 }
 
+// WithDestination adds a Destination check to the KprobeSockaddrChecker
+func (checker *KprobeSockaddrChecker) WithDestination(check *NetworkEndpointChecker) *KprobeSockaddrChecker {
+	checker.Destination = check
+	return checker
+}
+
+// WithHostnames adds a Hostnames check to the KprobeSockaddrChecker
+func (checker *KprobeSockaddrChecker) WithHostnames(check *StringListMatcher) *KprobeSockaddrChecker {
+	checker.Hostnames = check
+	return checker
+}
+
 //FromKprobeSockaddr populates the KprobeSockaddrChecker using data from a KprobeSockaddr field
 func (checker *KprobeSockaddrChecker) FromKprobeSockaddr(event *tetragon.KprobeSockaddr) *KprobeSockaddrChecker {
 	if event == nil {
@@ -5567,6 +5892,122 @@ func (checker *KprobeSockaddrChecker) FromKprobeSockaddr(event *tetragon.KprobeS
 		val := event.Port
 		checker.Port = &val
 	}
+	if event.Destination != nil {
+		checker.Destination = NewNetworkEndpointChecker().FromNetworkEndpoint(event.Destination)
+	}
+	{
+		var checks []*stringmatcher.StringMatcher
+		for _, check := range event.Hostnames {
+			var convertedCheck *stringmatcher.StringMatcher
+			convertedCheck = stringmatcher.Full(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStringListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Hostnames = lm
+	}
+	return checker
+}
+
+// NetworkEndpointChecker implements a checker struct to check a NetworkEndpoint field
+type NetworkEndpointChecker struct {
+	Namespace    *stringmatcher.StringMatcher `json:"namespace,omitempty"`
+	PodName      *stringmatcher.StringMatcher `json:"podName,omitempty"`
+	Workload     *stringmatcher.StringMatcher `json:"workload,omitempty"`
+	WorkloadKind *stringmatcher.StringMatcher `json:"workloadKind,omitempty"`
+	Service      *stringmatcher.StringMatcher `json:"service,omitempty"`
+}
+
+// NewNetworkEndpointChecker creates a new NetworkEndpointChecker
+func NewNetworkEndpointChecker() *NetworkEndpointChecker {
+	return &NetworkEndpointChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *NetworkEndpointChecker) GetCheckerType() string {
+	return "NetworkEndpointChecker"
+}
+
+// Check checks a NetworkEndpoint field
+func (checker *NetworkEndpointChecker) Check(event *tetragon.NetworkEndpoint) error {
+	if event == nil {
+		return fmt.Errorf("%s: NetworkEndpoint field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Namespace != nil {
+			if err := checker.Namespace.Match(event.Namespace); err != nil {
+				return fmt.Errorf("Namespace check failed: %w", err)
+			}
+		}
+		if checker.PodName != nil {
+			if err := checker.PodName.Match(event.PodName); err != nil {
+				return fmt.Errorf("PodName check failed: %w", err)
+			}
+		}
+		if checker.Workload != nil {
+			if err := checker.Workload.Match(event.Workload); err != nil {
+				return fmt.Errorf("Workload check failed: %w", err)
+			}
+		}
+		if checker.WorkloadKind != nil {
+			if err := checker.WorkloadKind.Match(event.WorkloadKind); err != nil {
+				return fmt.Errorf("WorkloadKind check failed: %w", err)
+			}
+		}
+		if checker.Service != nil {
+			if err := checker.Service.Match(event.Service); err != nil {
+				return fmt.Errorf("Service check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithNamespace adds a Namespace check to the NetworkEndpointChecker
+func (checker *NetworkEndpointChecker) WithNamespace(check *stringmatcher.StringMatcher) *NetworkEndpointChecker {
+	checker.Namespace = check
+	return checker
+}
+
+// WithPodName adds a PodName check to the NetworkEndpointChecker
+func (checker *NetworkEndpointChecker) WithPodName(check *stringmatcher.StringMatcher) *NetworkEndpointChecker {
+	checker.PodName = check
+	return checker
+}
+
+// WithWorkload adds a Workload check to the NetworkEndpointChecker
+func (checker *NetworkEndpointChecker) WithWorkload(check *stringmatcher.StringMatcher) *NetworkEndpointChecker {
+	checker.Workload = check
+	return checker
+}
+
+// WithWorkloadKind adds a WorkloadKind check to the NetworkEndpointChecker
+func (checker *NetworkEndpointChecker) WithWorkloadKind(check *stringmatcher.StringMatcher) *NetworkEndpointChecker {
+	checker.WorkloadKind = check
+	return checker
+}
+
+// WithService adds a Service check to the NetworkEndpointChecker
+func (checker *NetworkEndpointChecker) WithService(check *stringmatcher.StringMatcher) *NetworkEndpointChecker {
+	checker.Service = check
+	return checker
+}
+
+//FromNetworkEndpoint populates the NetworkEndpointChecker using data from a NetworkEndpoint field
+func (checker *NetworkEndpointChecker) FromNetworkEndpoint(event *tetragon.NetworkEndpoint) *NetworkEndpointChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Namespace = stringmatcher.Full(event.Namespace)
+	checker.PodName = stringmatcher.Full(event.PodName)
+	checker.Workload = stringmatcher.Full(event.Workload)
+	checker.WorkloadKind = stringmatcher.Full(event.WorkloadKind)
+	checker.Service = stringmatcher.Full(event.Service)
 	return checker
 }
 
@@ -7385,6 +7826,258 @@ func (checker *KprobeArgumentChecker) FromKprobeArgument(event *tetragon.KprobeA
 	return checker
 }
 
+// SequenceStepChecker implements a checker struct to check a SequenceStep field
+type SequenceStepChecker struct {
+	Name *stringmatcher.StringMatcher       `json:"name,omitempty"`
+	Time *timestampmatcher.TimestampMatcher `json:"time,omitempty"`
+	Hook *stringmatcher.StringMatcher       `json:"hook,omitempty"`
+}
+
+// NewSequenceStepChecker creates a new SequenceStepChecker
+func NewSequenceStepChecker() *SequenceStepChecker {
+	return &SequenceStepChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *SequenceStepChecker) GetCheckerType() string {
+	return "SequenceStepChecker"
+}
+
+// Check checks a SequenceStep field
+func (checker *SequenceStepChecker) Check(event *tetragon.SequenceStep) error {
+	if event == nil {
+		return fmt.Errorf("%s: SequenceStep field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
+			}
+		}
+		if checker.Time != nil {
+			if err := checker.Time.Match(event.Time); err != nil {
+				return fmt.Errorf("Time check failed: %w", err)
+			}
+		}
+		if checker.Hook != nil {
+			if err := checker.Hook.Match(event.Hook); err != nil {
+				return fmt.Errorf("Hook check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithName adds a Name check to the SequenceStepChecker
+func (checker *SequenceStepChecker) WithName(check *stringmatcher.StringMatcher) *SequenceStepChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithTime adds a Time check to the SequenceStepChecker
+func (checker *SequenceStepChecker) WithTime(check *timestampmatcher.TimestampMatcher) *SequenceStepChecker {
+	checker.Time = check
+	return checker
+}
+
+// WithHook adds a Hook check to the SequenceStepChecker
+func (checker *SequenceStepChecker) WithHook(check *stringmatcher.StringMatcher) *SequenceStepChecker {
+	checker.Hook = check
+	return checker
+}
+
+//FromSequenceStep populates the SequenceStepChecker using data from a SequenceStep field
+func (checker *SequenceStepChecker) FromSequenceStep(event *tetragon.SequenceStep) *SequenceStepChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
+	// NB: We don't want to match timestamps for now
+	checker.Time = nil
+	checker.Hook = stringmatcher.Full(event.Hook)
+	return checker
+}
+
+// SequenceMatchChecker implements a checker struct to check a SequenceMatch field
+type SequenceMatchChecker struct {
+	Name  *stringmatcher.StringMatcher `json:"name,omitempty"`
+	Steps *SequenceStepListMatcher     `json:"steps,omitempty"`
+}
+
+// NewSequenceMatchChecker creates a new SequenceMatchChecker
+func NewSequenceMatchChecker() *SequenceMatchChecker {
+	return &SequenceMatchChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *SequenceMatchChecker) GetCheckerType() string {
+	return "SequenceMatchChecker"
+}
+
+// Check checks a SequenceMatch field
+func (checker *SequenceMatchChecker) Check(event *tetragon.SequenceMatch) error {
+	if event == nil {
+		return fmt.Errorf("%s: SequenceMatch field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
+			}
+		}
+		if checker.Steps != nil {
+			if err := checker.Steps.Check(event.Steps); err != nil {
+				return fmt.Errorf("Steps check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithName adds a Name check to the SequenceMatchChecker
+func (checker *SequenceMatchChecker) WithName(check *stringmatcher.StringMatcher) *SequenceMatchChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithSteps adds a Steps check to the SequenceMatchChecker
+func (checker *SequenceMatchChecker) WithSteps(check *SequenceStepListMatcher) *SequenceMatchChecker {
+	checker.Steps = check
+	return checker
+}
+
+//FromSequenceMatch populates the SequenceMatchChecker using data from a SequenceMatch field
+func (checker *SequenceMatchChecker) FromSequenceMatch(event *tetragon.SequenceMatch) *SequenceMatchChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
+	{
+		var checks []*SequenceStepChecker
+		for _, check := range event.Steps {
+			var convertedCheck *SequenceStepChecker
+			if check != nil {
+				convertedCheck = NewSequenceStepChecker().FromSequenceStep(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewSequenceStepListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Steps = lm
+	}
+	return checker
+}
+
+// SequenceStepListMatcher checks a list of *tetragon.SequenceStep fields
+type SequenceStepListMatcher struct {
+	Operator listmatcher.Operator   `json:"operator"`
+	Values   []*SequenceStepChecker `json:"values"`
+}
+
+// NewSequenceStepListMatcher creates a new SequenceStepListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewSequenceStepListMatcher() *SequenceStepListMatcher {
+	return &SequenceStepListMatcher{
+		Operator: listmatcher.Subset,
+	}
+}
+
+// WithOperator sets the match kind for the SequenceStepListMatcher
+func (checker *SequenceStepListMatcher) WithOperator(operator listmatcher.Operator) *SequenceStepListMatcher {
+	checker.Operator = operator
+	return checker
+}
+
+// WithValues sets the checkers that the SequenceStepListMatcher should use
+func (checker *SequenceStepListMatcher) WithValues(values ...*SequenceStepChecker) *SequenceStepListMatcher {
+	checker.Values = values
+	return checker
+}
+
+// Check checks a list of *tetragon.SequenceStep fields
+func (checker *SequenceStepListMatcher) Check(values []*tetragon.SequenceStep) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+	}
+}
+
+// orderedCheck checks a list of ordered *tetragon.SequenceStep fields
+func (checker *SequenceStepListMatcher) orderedCheck(values []*tetragon.SequenceStep) error {
+	innerCheck := func(check *SequenceStepChecker, value *tetragon.SequenceStep) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Steps check failed: %w", err)
+		}
+		return nil
+	}
+
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("SequenceStepListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("SequenceStepListMatcher: Check failed on element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// unorderedCheck checks a list of unordered *tetragon.SequenceStep fields
+func (checker *SequenceStepListMatcher) unorderedCheck(values []*tetragon.SequenceStep) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("SequenceStepListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	return checker.subsetCheck(values)
+}
+
+// subsetCheck checks a subset of *tetragon.SequenceStep fields
+func (checker *SequenceStepListMatcher) subsetCheck(values []*tetragon.SequenceStep) error {
+	innerCheck := func(check *SequenceStepChecker, value *tetragon.SequenceStep) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Steps check failed: %w", err)
+		}
+		return nil
+	}
+
+	numDesired := len(checker.Values)
+	numMatched := 0
+
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
+	}
+
+	if numMatched < numDesired {
+		return fmt.Errorf("SequenceStepListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
+
+	return nil
+}
+
 // KernelModuleChecker implements a checker struct to check a KernelModule field
 type KernelModuleChecker struct {
 	Name        *stringmatcher.StringMatcher `json:"name,omitempty"`
@@ -150,6 +150,7 @@ type eventCheckerHelper struct {
 	Test              *eventchecker.TestChecker              `json:"test,omitempty"`
 	ProcessLoader     *eventchecker.ProcessLoaderChecker     `json:"loader,omitempty"`
 	RateLimitInfo     *eventchecker.RateLimitInfoChecker     `json:"rateLimitInfo,omitempty"`
+	EventsGap         *eventchecker.EventsGapChecker         `json:"eventsGap,omitempty"`
 	ProcessThrottle   *eventchecker.ProcessThrottleChecker   `json:"throttle,omitempty"`
 }
 
@@ -225,6 +226,12 @@ func (checker *EventChecker) UnmarshalJSON(b []byte) error {
 		}
 		eventChecker = helper.RateLimitInfo
 	}
+	if helper.EventsGap != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.EventsGap, eventChecker)
+		}
+		eventChecker = helper.EventsGap
+	}
 	if helper.ProcessThrottle != nil {
 		if eventChecker != nil {
 			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessThrottle, eventChecker)
@@ -259,6 +266,8 @@ func (checker EventChecker) MarshalJSON() ([]byte, error) {
 		helper.ProcessLoader = c
 	case *eventchecker.RateLimitInfoChecker:
 		helper.RateLimitInfo = c
+	case *eventchecker.EventsGapChecker:
+		helper.EventsGap = c
 	case *eventchecker.ProcessThrottleChecker:
 		helper.ProcessThrottle = c
 	default:
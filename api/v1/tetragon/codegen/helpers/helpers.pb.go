@@ -45,6 +45,8 @@ func ResponseTypeString(response *tetragon.GetEventsResponse) (string, error) {
 		return tetragon.EventType_TEST.String(), nil
 	case *tetragon.GetEventsResponse_RateLimitInfo:
 		return tetragon.EventType_RATE_LIMIT_INFO.String(), nil
+	case *tetragon.GetEventsResponse_EventsGap:
+		return tetragon.EventType_EVENTS_GAP.String(), nil
 
 	}
 	return "", fmt.Errorf("Unhandled response type %T", event)
@@ -188,6 +190,7 @@ func ResponseTypeMap() map[string]proto.Message {
 		"process_usdt":       &tetragon.ProcessUsdt{},
 		"test":               &tetragon.Test{},
 		"rate_limit_info":    &tetragon.RateLimitInfo{},
+		"events_gap":         &tetragon.EventsGap{},
 	}
 }
 
@@ -217,6 +220,8 @@ func ProcessEventMapTuple(response *tetragon.GetEventsResponse) (string, any, an
 		return "test", response.GetTest(), (*tetragon.Test)(nil)
 	case *tetragon.GetEventsResponse_RateLimitInfo:
 		return "rate_limit_info", response.GetRateLimitInfo(), (*tetragon.RateLimitInfo)(nil)
+	case *tetragon.GetEventsResponse_EventsGap:
+		return "events_gap", response.GetEventsGap(), (*tetragon.EventsGap)(nil)
 
 	}
 	return "", nil, nil
@@ -236,5 +241,6 @@ func ProcessEventMapEmpty() map[string]any {
 		"process_usdt":       (*tetragon.ProcessUsdt)(nil),
 		"test":               (*tetragon.Test)(nil),
 		"rate_limit_info":    (*tetragon.RateLimitInfo)(nil),
+		"events_gap":         (*tetragon.EventsGap)(nil),
 	}
 }
@@ -26,6 +26,7 @@ const (
 	FineGuidanceSensors_GetHealth_FullMethodName              = "/tetragon.FineGuidanceSensors/GetHealth"
 	FineGuidanceSensors_AddTracingPolicy_FullMethodName       = "/tetragon.FineGuidanceSensors/AddTracingPolicy"
 	FineGuidanceSensors_DeleteTracingPolicy_FullMethodName    = "/tetragon.FineGuidanceSensors/DeleteTracingPolicy"
+	FineGuidanceSensors_UpdateTracingPolicy_FullMethodName    = "/tetragon.FineGuidanceSensors/UpdateTracingPolicy"
 	FineGuidanceSensors_ListTracingPolicies_FullMethodName    = "/tetragon.FineGuidanceSensors/ListTracingPolicies"
 	FineGuidanceSensors_ConfigureTracingPolicy_FullMethodName = "/tetragon.FineGuidanceSensors/ConfigureTracingPolicy"
 	FineGuidanceSensors_EnableTracingPolicy_FullMethodName    = "/tetragon.FineGuidanceSensors/EnableTracingPolicy"
@@ -36,6 +37,8 @@ const (
 	FineGuidanceSensors_RemoveSensor_FullMethodName           = "/tetragon.FineGuidanceSensors/RemoveSensor"
 	FineGuidanceSensors_GetStackTraceTree_FullMethodName      = "/tetragon.FineGuidanceSensors/GetStackTraceTree"
 	FineGuidanceSensors_GetVersion_FullMethodName             = "/tetragon.FineGuidanceSensors/GetVersion"
+	FineGuidanceSensors_ListProcesses_FullMethodName          = "/tetragon.FineGuidanceSensors/ListProcesses"
+	FineGuidanceSensors_GetProcessTree_FullMethodName         = "/tetragon.FineGuidanceSensors/GetProcessTree"
 	FineGuidanceSensors_RuntimeHook_FullMethodName            = "/tetragon.FineGuidanceSensors/RuntimeHook"
 	FineGuidanceSensors_GetDebug_FullMethodName               = "/tetragon.FineGuidanceSensors/GetDebug"
 	FineGuidanceSensors_SetDebug_FullMethodName               = "/tetragon.FineGuidanceSensors/SetDebug"
@@ -49,12 +52,16 @@ type FineGuidanceSensorsClient interface {
 	GetHealth(ctx context.Context, in *GetHealthStatusRequest, opts ...grpc.CallOption) (*GetHealthStatusResponse, error)
 	AddTracingPolicy(ctx context.Context, in *AddTracingPolicyRequest, opts ...grpc.CallOption) (*AddTracingPolicyResponse, error)
 	DeleteTracingPolicy(ctx context.Context, in *DeleteTracingPolicyRequest, opts ...grpc.CallOption) (*DeleteTracingPolicyResponse, error)
+	// UpdateTracingPolicy replaces a loaded tracing policy with a new version of it, with the same
+	// name and namespace. If only the selectors of the policy change, they are updated in place
+	// without reloading its BPF programs. Otherwise, the new version is loaded before the previous
+	// one is unloaded. If the update fails, the previous version remains loaded.
+	UpdateTracingPolicy(ctx context.Context, in *UpdateTracingPolicyRequest, opts ...grpc.CallOption) (*UpdateTracingPolicyResponse, error)
 	ListTracingPolicies(ctx context.Context, in *ListTracingPoliciesRequest, opts ...grpc.CallOption) (*ListTracingPoliciesResponse, error)
 	// ConfigureTracingPolicy can be used to configure a loaded tracing policy.
 	// It can be used to:
 	//   - enable/disable it
 	//   - change its mode (enforcement vs monitoring)
-	//
 	// If multiple changes are requested and an error is encountered, the resulting state might have
 	// partial updates applied. In other words, the configuring a tracing policy is not atomic.
 	ConfigureTracingPolicy(ctx context.Context, in *ConfigureTracingPolicyRequest, opts ...grpc.CallOption) (*ConfigureTracingPolicyResponse, error)
@@ -72,6 +79,10 @@ type FineGuidanceSensorsClient interface {
 	RemoveSensor(ctx context.Context, in *RemoveSensorRequest, opts ...grpc.CallOption) (*RemoveSensorResponse, error)
 	GetStackTraceTree(ctx context.Context, in *GetStackTraceTreeRequest, opts ...grpc.CallOption) (*GetStackTraceTreeResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// ListProcesses lists the processes of the process cache.
+	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error)
+	// GetProcessTree returns the ancestors and descendants of a process of the process cache.
+	GetProcessTree(ctx context.Context, in *GetProcessTreeRequest, opts ...grpc.CallOption) (*GetProcessTreeResponse, error)
 	RuntimeHook(ctx context.Context, in *RuntimeHookRequest, opts ...grpc.CallOption) (*RuntimeHookResponse, error)
 	GetDebug(ctx context.Context, in *GetDebugRequest, opts ...grpc.CallOption) (*GetDebugResponse, error)
 	SetDebug(ctx context.Context, in *SetDebugRequest, opts ...grpc.CallOption) (*SetDebugResponse, error)
@@ -134,6 +145,16 @@ func (c *fineGuidanceSensorsClient) DeleteTracingPolicy(ctx context.Context, in
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) UpdateTracingPolicy(ctx context.Context, in *UpdateTracingPolicyRequest, opts ...grpc.CallOption) (*UpdateTracingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTracingPolicyResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_UpdateTracingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fineGuidanceSensorsClient) ListTracingPolicies(ctx context.Context, in *ListTracingPoliciesRequest, opts ...grpc.CallOption) (*ListTracingPoliciesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTracingPoliciesResponse)
@@ -240,6 +261,26 @@ func (c *fineGuidanceSensorsClient) GetVersion(ctx context.Context, in *GetVersi
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProcessesResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_ListProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineGuidanceSensorsClient) GetProcessTree(ctx context.Context, in *GetProcessTreeRequest, opts ...grpc.CallOption) (*GetProcessTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProcessTreeResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_GetProcessTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fineGuidanceSensorsClient) RuntimeHook(ctx context.Context, in *RuntimeHookRequest, opts ...grpc.CallOption) (*RuntimeHookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RuntimeHookResponse)
@@ -278,12 +319,16 @@ type FineGuidanceSensorsServer interface {
 	GetHealth(context.Context, *GetHealthStatusRequest) (*GetHealthStatusResponse, error)
 	AddTracingPolicy(context.Context, *AddTracingPolicyRequest) (*AddTracingPolicyResponse, error)
 	DeleteTracingPolicy(context.Context, *DeleteTracingPolicyRequest) (*DeleteTracingPolicyResponse, error)
+	// UpdateTracingPolicy replaces a loaded tracing policy with a new version of it, with the same
+	// name and namespace. If only the selectors of the policy change, they are updated in place
+	// without reloading its BPF programs. Otherwise, the new version is loaded before the previous
+	// one is unloaded. If the update fails, the previous version remains loaded.
+	UpdateTracingPolicy(context.Context, *UpdateTracingPolicyRequest) (*UpdateTracingPolicyResponse, error)
 	ListTracingPolicies(context.Context, *ListTracingPoliciesRequest) (*ListTracingPoliciesResponse, error)
 	// ConfigureTracingPolicy can be used to configure a loaded tracing policy.
 	// It can be used to:
 	//   - enable/disable it
 	//   - change its mode (enforcement vs monitoring)
-	//
 	// If multiple changes are requested and an error is encountered, the resulting state might have
 	// partial updates applied. In other words, the configuring a tracing policy is not atomic.
 	ConfigureTracingPolicy(context.Context, *ConfigureTracingPolicyRequest) (*ConfigureTracingPolicyResponse, error)
@@ -301,6 +346,10 @@ type FineGuidanceSensorsServer interface {
 	RemoveSensor(context.Context, *RemoveSensorRequest) (*RemoveSensorResponse, error)
 	GetStackTraceTree(context.Context, *GetStackTraceTreeRequest) (*GetStackTraceTreeResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// ListProcesses lists the processes of the process cache.
+	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	// GetProcessTree returns the ancestors and descendants of a process of the process cache.
+	GetProcessTree(context.Context, *GetProcessTreeRequest) (*GetProcessTreeResponse, error)
 	RuntimeHook(context.Context, *RuntimeHookRequest) (*RuntimeHookResponse, error)
 	GetDebug(context.Context, *GetDebugRequest) (*GetDebugResponse, error)
 	SetDebug(context.Context, *SetDebugRequest) (*SetDebugResponse, error)
@@ -326,6 +375,9 @@ func (UnimplementedFineGuidanceSensorsServer) AddTracingPolicy(context.Context,
 func (UnimplementedFineGuidanceSensorsServer) DeleteTracingPolicy(context.Context, *DeleteTracingPolicyRequest) (*DeleteTracingPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTracingPolicy not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) UpdateTracingPolicy(context.Context, *UpdateTracingPolicyRequest) (*UpdateTracingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTracingPolicy not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) ListTracingPolicies(context.Context, *ListTracingPoliciesRequest) (*ListTracingPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTracingPolicies not implemented")
 }
@@ -356,6 +408,12 @@ func (UnimplementedFineGuidanceSensorsServer) GetStackTraceTree(context.Context,
 func (UnimplementedFineGuidanceSensorsServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcesses not implemented")
+}
+func (UnimplementedFineGuidanceSensorsServer) GetProcessTree(context.Context, *GetProcessTreeRequest) (*GetProcessTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessTree not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) RuntimeHook(context.Context, *RuntimeHookRequest) (*RuntimeHookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RuntimeHook not implemented")
 }
@@ -451,6 +509,24 @@ func _FineGuidanceSensors_DeleteTracingPolicy_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_UpdateTracingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTracingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).UpdateTracingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_UpdateTracingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).UpdateTracingPolicy(ctx, req.(*UpdateTracingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FineGuidanceSensors_ListTracingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTracingPoliciesRequest)
 	if err := dec(in); err != nil {
@@ -631,6 +707,42 @@ func _FineGuidanceSensors_GetVersion_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).ListProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_ListProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineGuidanceSensors_GetProcessTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).GetProcessTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_GetProcessTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).GetProcessTree(ctx, req.(*GetProcessTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FineGuidanceSensors_RuntimeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RuntimeHookRequest)
 	if err := dec(in); err != nil {
@@ -704,6 +816,10 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTracingPolicy",
 			Handler:    _FineGuidanceSensors_DeleteTracingPolicy_Handler,
 		},
+		{
+			MethodName: "UpdateTracingPolicy",
+			Handler:    _FineGuidanceSensors_UpdateTracingPolicy_Handler,
+		},
 		{
 			MethodName: "ListTracingPolicies",
 			Handler:    _FineGuidanceSensors_ListTracingPolicies_Handler,
@@ -744,6 +860,14 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _FineGuidanceSensors_GetVersion_Handler,
 		},
+		{
+			MethodName: "ListProcesses",
+			Handler:    _FineGuidanceSensors_ListProcesses_Handler,
+		},
+		{
+			MethodName: "GetProcessTree",
+			Handler:    _FineGuidanceSensors_GetProcessTree_Handler,
+		},
 		{
 			MethodName: "RuntimeHook",
 			Handler:    _FineGuidanceSensors_RuntimeHook_Handler,
@@ -259,6 +259,14 @@ func (event *RateLimitInfo) Encapsulate() IsGetEventsResponse_Event {
 	}
 }
 
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *EventsGap) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_EventsGap{
+		EventsGap: event,
+	}
+}
+
 // Encapsulate implements the Event interface.
 // Returns the event wrapped by its GetEventsResponse_* type.
 func (event *ProcessThrottle) Encapsulate() IsGetEventsResponse_Event {
@@ -294,6 +302,8 @@ func UnwrapGetEventsResponse(response *GetEventsResponse) interface{} {
 		return ev.ProcessLoader
 	case *GetEventsResponse_RateLimitInfo:
 		return ev.RateLimitInfo
+	case *GetEventsResponse_EventsGap:
+		return ev.EventsGap
 	case *GetEventsResponse_ProcessThrottle:
 		return ev.ProcessThrottle
 	}
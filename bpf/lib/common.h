@@ -10,6 +10,7 @@
 #define MSG_COMMON_FLAG_IMA_HASH	  BIT(3)
 #define MSG_COMMON_FLAG_PROCESS_NOT_FOUND BIT(4)
 #define MSG_COMMON_FLAG_ACTION_FAILED	  BIT(5)
+#define MSG_COMMON_FLAG_SEQUENCE_STEP	  BIT(6)
 
 /* Msg Layout */
 struct msg_common {
@@ -27,6 +27,10 @@ enum policy_actions {
 
 struct policy_stats {
 	u64 act_cnt[POLICY_NACTIONS_];
+	/* bytes of the arguments omitted from the events (omitArgs) */
+	u64 omitted_bytes;
+	/* events not posted because the policy exceeded its event budget */
+	u64 budget_dropped;
 };
 
 #if defined(HAS_POLICY_STATS)
@@ -47,11 +51,43 @@ policy_stats_update(int act)
 	if (pstats)
 		lock_add(&pstats->act_cnt[act], 1);
 }
+
+FUNC_INLINE void
+policy_stats_omitted(long bytes)
+{
+	struct policy_stats *pstats;
+	u32 zero = 0;
+
+	pstats = map_lookup_elem(&policy_stats, &zero);
+	if (pstats)
+		lock_add(&pstats->omitted_bytes, bytes);
+}
+
+FUNC_INLINE void
+policy_stats_budget_dropped(void)
+{
+	struct policy_stats *pstats;
+	u32 zero = 0;
+
+	pstats = map_lookup_elem(&policy_stats, &zero);
+	if (pstats)
+		lock_add(&pstats->budget_dropped, 1);
+}
 #else
 FUNC_INLINE void
 policy_stats_update(int acct)
 {
 }
+
+FUNC_INLINE void
+policy_stats_omitted(long bytes)
+{
+}
+
+FUNC_INLINE void
+policy_stats_budget_dropped(void)
+{
+}
 #endif
 
 #endif /* BPF_POLICYSTATS_H__ */
@@ -4,6 +4,9 @@
 #ifndef ADDR_LPM_MAPS_H__
 #define ADDR_LPM_MAPS_H__
 
+#include "selector_slots.h"
+
+/* per selector slot */
 #define ADDR_LPM_MAPS_OUTER_MAX_ENTRIES 8
 
 struct addr4_lpm_trie {
@@ -13,7 +16,7 @@ struct addr4_lpm_trie {
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, ADDR_LPM_MAPS_OUTER_MAX_ENTRIES);
+	__uint(max_entries, ADDR_LPM_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
 	__type(key, __u32);
 	__array(
 		values, struct {
@@ -32,7 +35,7 @@ struct addr6_lpm_trie {
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, ADDR_LPM_MAPS_OUTER_MAX_ENTRIES);
+	__uint(max_entries, ADDR_LPM_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
 	__type(key, __u32);
 	__array(
 		values, struct {
@@ -4,11 +4,14 @@
 #ifndef ARGFILTER_MAPS_H__
 #define ARGFILTER_MAPS_H__
 
+#include "selector_slots.h"
+
+/* per selector slot */
 #define ARGFILTER_MAPS_OUTER_MAX_ENTRIES 8
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, ARGFILTER_MAPS_OUTER_MAX_ENTRIES);
+	__uint(max_entries, ARGFILTER_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
 	__type(key, __u32);
 	__array(
 		values, struct {
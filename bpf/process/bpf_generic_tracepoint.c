@@ -181,7 +181,8 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 	if (!msg)
 		return 0;
 
-	config = map_lookup_elem(&config_map, &zero);
+	msg->idx = sel_index(0);
+	config = map_lookup_elem(&config_map, &msg->idx);
 	if (!config)
 		return 0;
 
@@ -190,7 +191,6 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		return 0;
 
 	/* Tail call into filters. */
-	msg->idx = 0;
 	msg->func_id = config->func_id;
 	msg->retprobe_id = 0;
 
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Tetragon */
+
+#ifndef __EVENT_BUDGET_H__
+#define __EVENT_BUDGET_H__
+
+#include "bpf_tracing.h"
+#include "bpf_helpers.h"
+#include "msg_types.h"
+#include "policy_stats.h"
+
+/* NB: values below should match the ones in pkg/sensors/tracing/eventbudget.go */
+enum {
+	EVENT_BUDGET_COUNT = 0, /* only count the events over the budget */
+	EVENT_BUDGET_SAMPLE = 1, /* post 1 out of every sample events over the budget */
+};
+
+enum {
+	EVENT_BUDGET_THROTTLE_START = 1,
+	EVENT_BUDGET_THROTTLE_STOP = 2,
+};
+
+struct event_budget {
+	/* options, set by the agent when the policy is loaded */
+	__u64 events; /* events allowed per interval, 0 if the policy has no budget */
+	__u64 interval; /* in nanoseconds */
+	__u32 mode;
+	__u32 sample;
+	/* sliding window state, see cgroup_rate in bpf_rate.h */
+	__u64 curr;
+	__u64 prev;
+	__u64 time;
+	__u64 throttled; /* time the policy got throttled, 0 if not throttled */
+	__u64 over; /* events over the budget since throttled, used for sampling */
+};
+
+struct msg_policy_throttle {
+	struct msg_common common;
+	__u64 func_id;
+	__u32 hook_op;
+	__u32 type;
+};
+
+#if defined(__LARGE_BPF_PROG) && (defined(GENERIC_KPROBE) || defined(GENERIC_TRACEPOINT) || defined(GENERIC_RAWTP))
+/* Event budget of the policy, shared by all of its programs. */
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, struct event_budget);
+} tg_budget_map SEC(".maps");
+
+FUNC_INLINE void
+send_policy_throttle(void *ctx, struct msg_generic_kprobe *e, u8 op, __u32 type, __u64 time)
+{
+	struct msg_policy_throttle msg = {
+		.common = {
+			.op = MSG_OP_POLICY_THROTTLE,
+			.size = sizeof(msg),
+			.ktime = time,
+		},
+		.func_id = e->func_id,
+		.hook_op = op,
+		.type = type,
+	};
+
+	event_output(ctx, &msg, sizeof(msg));
+}
+
+/* event_budget enforces the events per second budget of the policy and
+ * returns true if the event should be posted. Once the rate of the policy
+ * events goes over the budget, the policy is throttled: the events are only
+ * counted in the policy stats, or 1 out of every sample events is posted in
+ * sample mode, with its sample rate set accordingly. The policy is
+ * unthrottled on the first event after the rate went back under the budget,
+ * and at least one interval after it was throttled.
+ *
+ * The window is shared by all CPUs and updated without locking, so the budget
+ * is approximate under concurrent events.
+ */
+FUNC_INLINE bool
+event_budget(void *ctx, struct msg_generic_kprobe *e, u8 op)
+{
+	__u64 time, delta, interval, slide, rate;
+	struct event_budget *b;
+	__u32 zero = 0;
+
+	b = map_lookup_elem(&tg_budget_map, &zero);
+	if (!b || !b->events || !b->interval)
+		return true;
+
+	interval = b->interval;
+	time = ktime_get_ns();
+
+	delta = time - b->time;
+	if (delta > interval) {
+		if (delta > 2 * interval) {
+			b->prev = 0;
+			b->time = (time / interval) * interval;
+		} else {
+			b->prev = b->curr;
+			b->time += interval;
+		}
+		b->curr = 0;
+	}
+
+	b->curr++;
+
+	slide = interval - (time - b->time);
+	rate = (slide * b->prev) / interval + b->curr;
+
+	if (!b->throttled) {
+		if (rate <= b->events)
+			return true;
+		b->throttled = time;
+		b->over = 0;
+		send_policy_throttle(ctx, e, op, EVENT_BUDGET_THROTTLE_START, time);
+	} else if (rate <= b->events && time - b->throttled >= interval) {
+		b->throttled = 0;
+		send_policy_throttle(ctx, e, op, EVENT_BUDGET_THROTTLE_STOP, time);
+		return true;
+	}
+
+	if (b->mode == EVENT_BUDGET_SAMPLE && b->sample && (b->over++ % b->sample) == 0) {
+		/* the event stands for sample events, on top of the post action sampling */
+		if (b->sample > 1)
+			e->sample_rate = (e->sample_rate ? e->sample_rate : 1) * b->sample;
+		return true;
+	}
+
+	policy_stats_budget_dropped();
+	return false;
+}
+#else
+FUNC_INLINE bool
+event_budget(void *ctx, struct msg_generic_kprobe *e, u8 op)
+{
+	return true;
+}
+#endif
+
+#endif /* __EVENT_BUDGET_H__ */
@@ -11,6 +11,7 @@
 #include "vmlinux.h"
 #include "policy_conf.h"
 #include "policy_stats.h"
+#include "event_budget.h"
 #include "generic_path.h"
 #include "bpf_ktime.h"
 #include "regs.h"
@@ -30,7 +31,7 @@ generic_start_process_filter(void *ctx, struct bpf_map_def *calls)
 		return 0;
 
 	/* setup index, check policy filter, and setup function id */
-	msg->idx = get_index(ctx);
+	msg->idx = sel_index(get_index(ctx));
 	config = map_lookup_elem(&config_map, &msg->idx);
 	if (!config)
 		return 0;
@@ -607,6 +608,8 @@ generic_process_init(struct msg_generic_kprobe *e, u8 op)
 	e->current.pad[3] = 0;
 
 	e->action = 0;
+	e->sample_rate = 0;
+	e->pad = 0;
 
 	/**
 	 * Per thread tracking rules TID is the calling thread:
@@ -935,9 +938,17 @@ do_action(void *ctx, __u32 i, struct selector_action *actions, bool *post, bool
 	case ACTION_POST: {
 		__u64 ratelimit_interval __maybe_unused = actions->act[++i];
 		__u64 ratelimit_scope __maybe_unused = actions->act[++i];
+		__u32 sample_rate __maybe_unused = actions->act[++i];
+		__u32 sample_scope __maybe_unused = actions->act[++i];
 #ifdef __LARGE_BPF_PROG
-		if (rate_limit(ratelimit_interval, ratelimit_scope, e))
+		if (rate_limit(ratelimit_interval, ratelimit_scope, e)) {
 			*post = false;
+		} else if (sample_rate > 1) {
+			if (sample_drop(sample_rate, sample_scope, e))
+				*post = false;
+			else
+				e->sample_rate = sample_rate;
+		}
 #endif /* __LARGE_BPF_PROG */
 		__u32 kernel_stack_trace = actions->act[++i];
 
@@ -1032,6 +1043,16 @@ do_action(void *ctx, __u32 i, struct selector_action *actions, bool *post, bool
 		value = actions->act[++i];
 		do_set_action(ctx, e, index, value);
 		break;
+	case ACTION_SET_STATE:
+		e->action_arg_id = actions->act[++i];
+		index = actions->act[++i];
+		value = actions->act[++i];
+#ifdef __LARGE_BPF_PROG
+		/* index and value are the steps set and cleared */
+		set_state(e, index, value);
+		e->common.flags |= MSG_COMMON_FLAG_SEQUENCE_STEP;
+#endif
+		break;
 	default:
 		break;
 	}
@@ -1122,6 +1143,77 @@ generic_actions(void *ctx, struct bpf_map_def *calls)
 	return postit;
 }
 
+#if defined(__LARGE_BPF_PROG) && (defined(GENERIC_KPROBE) || defined(GENERIC_TRACEPOINT) || defined(GENERIC_RAWTP))
+/* omit_args removes from the event the arguments that are only used by the
+ * selectors (omitArgs in the policy), and accounts the bytes saved in the
+ * policy stats. Arguments are stored in order in e->args, so the arguments
+ * kept after an omitted one are moved back over it. Moves always go towards
+ * lower offsets, which probe_read copying forward handles even when source
+ * and destination overlap. A move copies at most 4 * 0xfff bytes: if a kept
+ * argument is larger, the event is cut after the bytes moved so that its size
+ * never covers stale data. Such an event is over the maximum event size, and
+ * is truncated on output anyway.
+ */
+FUNC_INLINE void
+omit_args(struct msg_generic_kprobe *e)
+{
+	struct event_config *config;
+	long start, end, len, n, dst = 0, saved = 0, cut = -1;
+	int i, j;
+
+	config = map_lookup_elem(&config_map, &e->idx);
+	if (!config || !config->omit_args)
+		return;
+
+#pragma unroll
+	for (i = 0; i < MAX_POSSIBLE_ARGS; i++) {
+		if (config->idx[i] == -1)
+			break;
+		start = e->argsoff[i];
+		if (i < MAX_POSSIBLE_ARGS - 1 && config->idx[i + 1] != -1)
+			end = e->argsoff[i + 1];
+		else
+			end = e->common.size;
+		len = end > start ? end - start : 0;
+
+		if (config->omit_args & (1 << i)) {
+			saved += len;
+			continue;
+		}
+		if (!saved) {
+			dst = end;
+			continue;
+		}
+#pragma unroll
+		for (j = 0; j < 4; j++) {
+			if (len <= 0)
+				break;
+			n = len < 0xfff ? len : 0xfff;
+			asm volatile("%[n] &= 0xfff;\n"
+				     : [n] "+r"(n));
+			probe_read(args_off(e, dst), n, args_off(e, start));
+			dst += n;
+			start += n;
+			len -= n;
+		}
+		if (len > 0) {
+			cut = dst;
+			break;
+		}
+	}
+
+	if (saved) {
+		e->common.size = cut >= 0 ? cut : e->common.size - saved;
+		policy_stats_omitted(saved);
+	}
+}
+#else
+FUNC_INLINE void
+omit_args(struct msg_generic_kprobe *e)
+{
+}
+#endif
+
 FUNC_INLINE long
 generic_output(void *ctx, u8 op)
 {
@@ -1159,6 +1251,10 @@ generic_output(void *ctx, u8 op)
 			get_current_subj_caps(&enter->caps, task);
 	}
 #endif
+	/* policy over its event budget, make sure the return probe does not post either */
+	if (!event_budget(ctx, e, op))
+		return filter_args_reject(e->func_id);
+	omit_args(e);
 #endif // !GENERIC_KRETPROBE && !GENERIC_URETPROBE
 
 	total = e->common.size + generic_kprobe_common_size();
@@ -1188,7 +1284,7 @@ FUNC_INLINE int generic_retprobe(void *ctx, struct bpf_map_def *calls, unsigned
 	if (!e)
 		return 0;
 
-	e->idx = get_index(ctx);
+	e->idx = sel_index(get_index(ctx));
 
 	config = map_lookup_elem(&config_map, &e->idx);
 	if (!config)
@@ -1266,6 +1362,10 @@ FUNC_INLINE int generic_retprobe(void *ctx, struct bpf_map_def *calls, unsigned
 	e->current.pad[2] = 0;
 	e->current.pad[3] = 0;
 
+	/* the sampling rate of the entry event applies to the merged event */
+	e->sample_rate = 0;
+	e->pad = 0;
+
 	e->func_id = config->func_id;
 	e->common.size = size;
 
@@ -1365,6 +1465,13 @@ FUNC_INLINE int filter_args(struct msg_generic_kprobe *e, int selidx, bool is_en
 	if (e->sel.active[selidx]) {
 		int pass = selector_arg_offset(f, e, selidx, is_entry);
 
+#ifdef __LARGE_BPF_PROG
+		if (pass && !state_match(e, selidx))
+			pass = 0;
+		/* a selector with a matchThreshold only matches once the threshold is reached */
+		if (pass && is_entry && !threshold_reached(e, selidx))
+			pass = 0;
+#endif
 		if (pass)
 			return pass;
 	}
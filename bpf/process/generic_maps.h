@@ -5,6 +5,7 @@
 #define __GENERIC_MAPS_H__
 
 #include "lib/data_msg.h"
+#include "selector_slots.h"
 
 struct {
 	__uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
@@ -40,17 +41,23 @@ struct filter_map_value {
 	unsigned char buf[FILTER_SIZE];
 };
 
-/* Arrays of size 1 will be rewritten to direct loads in verifier */
+/* The maps below hold the selectors and config of each hook in each selector
+ * slot, see sel_index(), and are resized by the agent for multi kprobes.
+ * Arrays of size 1 are rewritten to direct loads by the verifier. Programs
+ * that can be updated in place (kprobes and tracepoints) have SEL_SLOTS
+ * entries and lose this optimization, trading an extra lookup for selector
+ * updates without reload. The other programs keep a single entry.
+ */
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY);
-	__uint(max_entries, 1);
+	__uint(max_entries, SEL_SLOTS);
 	__type(key, int);
 	__type(value, struct filter_map_value);
 } filter_map SEC(".maps");
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY);
-	__uint(max_entries, 1);
+	__uint(max_entries, SEL_SLOTS);
 	__type(key, __u32);
 	__type(value, struct event_config);
 } config_map SEC(".maps");
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Tetragon */
+
+#ifndef __SELECTOR_SLOTS_H__
+#define __SELECTOR_SLOTS_H__
+
+#include "bpf_helpers.h"
+
+/* The programs of the hooks whose policy can be updated in place keep two
+ * versions (slots) of their selectors and config: the one in use and the one
+ * the agent writes the new version of the policy to. Once it is written, the
+ * agent switches the program to the new slot with a single update of
+ * sel_slot_map, so that each event is filtered either with the previous or
+ * with the new version, never with a mix of both. The slot is read once when
+ * the event starts and kept in its index (e->idx) for the tail calls.
+ *
+ * In a slot, the selectors and config of the hook at index are stored at
+ * index * SEL_SLOTS + slot in filter_map and config_map, and the per selector
+ * maps (argfilter_maps, string_maps_*, tg_mb_sel_opts...) use the entries
+ * starting at slot times their number of entries per slot.
+ *
+ * NB: values below should match the ones in pkg/sensors/tracing/selectors.go
+ */
+#if defined(GENERIC_KPROBE) || defined(GENERIC_KRETPROBE) || defined(GENERIC_TRACEPOINT) || defined(GENERIC_RAWTP)
+#define SEL_SLOTS 2
+
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, __u32);
+} sel_slot_map SEC(".maps");
+
+FUNC_INLINE __u32 get_sel_slot(void)
+{
+	__u32 zero = 0, *slot;
+
+	slot = map_lookup_elem(&sel_slot_map, &zero);
+	return slot ? *slot % SEL_SLOTS : 0;
+}
+#else
+#define SEL_SLOTS      1
+#define get_sel_slot() 0
+#endif
+
+/* index of the selectors and config of the hook at index in the slot in use */
+#define sel_index(index) ((index) * SEL_SLOTS + get_sel_slot())
+/* slot of the selectors the event is filtered with */
+#define sel_slot(e) ((e)->idx % SEL_SLOTS)
+
+#endif /* __SELECTOR_SLOTS_H__ */
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#ifndef __STATE_MAPS_H__
+#define __STATE_MAPS_H__
+
+struct state_sel_opts {
+	__u32 reached; /* steps the process must have reached */
+	__u32 not_reached; /* steps the process must not have reached */
+};
+
+// This map is used by the matchState selectors to retrieve their options
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, MAX_SELECTORS * SEL_SLOTS);
+	__type(key, __u32); /* slot * MAX_SELECTORS + selector id */
+	__type(value, struct state_sel_opts);
+} tg_state_sel_opts SEC(".maps");
+
+/* Steps of the policy sequences reached by processes, one bit per step. */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 1); // Agent is resizing this if the policy has sequences
+	__type(key, struct msg_execve_key);
+	__type(value, __u32);
+} tg_state_map SEC(".maps");
+
+#endif /* __STATE_MAPS_H__ */
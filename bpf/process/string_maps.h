@@ -4,6 +4,9 @@
 #ifndef STRING_MAPS_H__
 #define STRING_MAPS_H__
 
+#include "selector_slots.h"
+
+/* per selector slot */
 #define STRING_MAPS_OUTER_MAX_ENTRIES 8
 
 /*
@@ -62,18 +65,18 @@
 #define STRING_MAPS_HEAP_MASK (8192 - 1)
 #define STRING_MAPS_COPY_MASK 4095
 
-#define DEFINE_ARRAY_OF_STRING_MAPS(N)                                   \
-	struct {                                                         \
-		__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);                \
-		__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES);      \
-		__type(key, __u32);                                      \
-		__array(                                                 \
-			values, struct {                                 \
-				__uint(type, BPF_MAP_TYPE_HASH);         \
-				__uint(max_entries, 1);                  \
-				__type(key, __u8[STRING_MAPS_SIZE_##N]); \
-				__type(value, __u8);                     \
-			});                                              \
+#define DEFINE_ARRAY_OF_STRING_MAPS(N)                                          \
+	struct {                                                                \
+		__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);                       \
+		__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS); \
+		__type(key, __u32);                                             \
+		__array(                                                        \
+			values, struct {                                        \
+				__uint(type, BPF_MAP_TYPE_HASH);                \
+				__uint(max_entries, 1);                         \
+				__type(key, __u8[STRING_MAPS_SIZE_##N]);        \
+				__type(value, __u8);                            \
+			});                                                     \
 	} string_maps_##N SEC(".maps");
 
 DEFINE_ARRAY_OF_STRING_MAPS(0)
@@ -107,7 +110,7 @@ struct string_prefix_lpm_trie {
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES);
+	__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
 	__type(key, __u32);
 	__array(
 		values, struct {
@@ -141,7 +144,7 @@ struct string_postfix_lpm_trie {
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES);
+	__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
 	__type(key, __u32);
 	__array(
 		values, struct {
@@ -160,4 +163,29 @@ struct {
 	__type(value, struct string_postfix_lpm_trie);
 } string_postfix_maps_heap SEC(".maps");
 
+/* Glob and Regex values are compiled by user space into a DFA. Each inner
+ * array is indexed by state << 8 | byte and holds the next state, with
+ * STRING_PATTERN_ACCEPT set if that state is accepting. State 0 is the dead
+ * state and state 1 the start state. The dead state is never walked from, so
+ * its first entry has STRING_PATTERN_ACCEPT set if the empty string matches.
+ */
+#define STRING_PATTERN_MAX_LENGTH  255
+#define STRING_PATTERN_ACCEPT	   0x8000
+#define STRING_PATTERN_STATE_MASK  0x7fff
+#define STRING_PATTERN_START_STATE 1
+#define STRING_PATTERN_EMPTY_KEY   0
+
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
+	__uint(max_entries, STRING_MAPS_OUTER_MAX_ENTRIES * SEL_SLOTS);
+	__type(key, __u32);
+	__array(
+		values, struct {
+			__uint(type, BPF_MAP_TYPE_ARRAY);
+			__uint(max_entries, 1);
+			__type(key, __u32);
+			__type(value, __u16);
+		});
+} string_pattern_maps SEC(".maps");
+
 #endif // STRING_MAPS_H__
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#ifndef __THRESHOLD_MAPS_H__
+#define __THRESHOLD_MAPS_H__
+
+/* Threshold scope. */
+#define THRESHOLD_SCOPE_PROCESS 0
+#define THRESHOLD_SCOPE_CGROUP	1
+#define THRESHOLD_SCOPE_GLOBAL	2
+
+struct threshold_sel_opts {
+	__u64 window; /* in milliseconds, 0 if the selector has no threshold */
+	__u32 count;
+	__u32 scope;
+};
+
+struct threshold_key {
+	__u64 func_id;
+	__u64 id; /* tgid, cgroup id, or 0 depending on the scope */
+	__u32 selidx;
+	__u32 pad;
+};
+
+struct threshold_value {
+	__u64 start; /* start of the current window */
+	__u32 prev; /* number of matches in the previous window */
+	__u32 curr; /* number of matches in the current window */
+};
+
+// This map is used by the matchThreshold selectors to retrieve their options
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, MAX_SELECTORS * SEL_SLOTS);
+	__type(key, __u32); /* slot * MAX_SELECTORS + selector id */
+	__type(value, struct threshold_sel_opts);
+} tg_threshold_sel_opts SEC(".maps");
+
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 1); // Agent is resizing this if the feature is needed during kprobe load
+	__type(key, struct threshold_key);
+	__type(value, struct threshold_value);
+} threshold_map SEC(".maps");
+
+#endif /* __THRESHOLD_MAPS_H__ */
@@ -124,6 +124,7 @@ enum {
 	ACTION_NOTIFY_ENFORCER = 12,
 	ACTION_CLEANUP_ENFORCER_NOTIFICATION = 13,
 	ACTION_SET = 14,
+	ACTION_SET_STATE = 15,
 };
 
 enum {
@@ -216,7 +217,11 @@ struct event_config {
 	 */
 	__u32 policy_id;
 	__u32 flags;
-	__u32 pad;
+	/* omit_args is the mask of the arguments (by config index) that are
+	 * only used by selectors and are removed from the event before it is
+	 * sent, see omit_args() in generic_calls.h.
+	 */
+	__u32 omit_args;
 	struct config_btf_arg btf_arg[EVENT_CONFIG_MAX_ARG][MAX_BTF_ARG_DEPTH];
 	struct config_usdt_arg usdt_arg[EVENT_CONFIG_MAX_USDT_ARG];
 	struct config_reg_arg reg_arg[EVENT_CONFIG_MAX_REG_ARG];
@@ -890,9 +895,58 @@ filter_char_buf_postfix(struct selector_arg_filter *filter, char *arg_str, uint
 	return !!pass;
 }
 
+#ifdef __LARGE_BPF_PROG
+/* filter_char_buf_pattern: walks the DFA compiled from the Glob or Regex values
+ * over the argument. The argument is copied into the prefix heap first so that
+ * it can be read one byte at a time. An empty argument matches if the start
+ * state is accepting, which is recorded in the STRING_PATTERN_EMPTY_KEY entry.
+ */
+FUNC_LOCAL long
+filter_char_buf_pattern(struct selector_arg_filter *filter, char *arg_str, uint arg_len)
+{
+	__u32 map_idx = *(__u32 *)&filter->value;
+	struct string_prefix_lpm_trie *arg;
+	__u16 state = STRING_PATTERN_START_STATE;
+	__u16 *next;
+	void *dfa;
+	__u32 key;
+	int zero = 0;
+	uint i;
+
+	dfa = map_lookup_elem(&string_pattern_maps, &map_idx);
+	if (!dfa || arg_len > STRING_PATTERN_MAX_LENGTH)
+		return 0;
+
+	if (!arg_len) {
+		key = STRING_PATTERN_EMPTY_KEY;
+		next = map_lookup_elem(dfa, &key);
+		return next && (*next & STRING_PATTERN_ACCEPT);
+	}
+
+	arg = (struct string_prefix_lpm_trie *)map_lookup_elem(&string_prefix_maps_heap, &zero);
+	if (!arg)
+		return 0;
+
+	probe_read(arg->data, arg_len & (STRING_PREFIX_MAX_LENGTH - 1), arg_str);
+
+	for (i = 0; i < STRING_PATTERN_MAX_LENGTH; i++) {
+		if (i >= arg_len)
+			break;
+		key = ((__u32)(state & STRING_PATTERN_STATE_MASK) << 8) | arg->data[i & (STRING_PREFIX_MAX_LENGTH - 1)];
+		next = map_lookup_elem(dfa, &key);
+		if (!next || !(*next & STRING_PATTERN_STATE_MASK))
+			return 0;
+		state = *next;
+	}
+
+	return !!(state & STRING_PATTERN_ACCEPT);
+}
+#endif
+
 FUNC_INLINE bool is_not_operator(__u32 op)
 {
-	return (op == op_filter_neq || op == op_filter_str_notprefix || op == op_filter_str_notpostfix || op == op_filter_notin);
+	return (op == op_filter_neq || op == op_filter_str_notprefix || op == op_filter_str_notpostfix || op == op_filter_notin ||
+		op == op_filter_str_notglob || op == op_filter_str_notregex);
 }
 
 FUNC_LOCAL long
@@ -916,6 +970,14 @@ filter_char_buf(struct selector_arg_filter *filter, char *args, int value_off)
 	case op_filter_str_notpostfix:
 		match = filter_char_buf_postfix(filter, arg_str, len);
 		break;
+#ifdef __LARGE_BPF_PROG
+	case op_filter_str_glob:
+	case op_filter_str_notglob:
+	case op_filter_str_regex:
+	case op_filter_str_notregex:
+		match = filter_char_buf_pattern(filter, arg_str, len);
+		break;
+#endif
 	}
 
 	return is_not_operator(filter->op) ? !match : match;
@@ -955,6 +1017,14 @@ filter_file_buf(struct selector_arg_filter *filter, struct string_buf *args)
 	case op_filter_str_notpostfix:
 		match = filter_char_buf_postfix(filter, args->buf, args->len);
 		break;
+#ifdef __LARGE_BPF_PROG
+	case op_filter_str_glob:
+	case op_filter_str_notglob:
+	case op_filter_str_regex:
+	case op_filter_str_notregex:
+		match = filter_char_buf_pattern(filter, args->buf, args->len);
+		break;
+#endif
 	}
 
 	return is_not_operator(filter->op) ? !match : match;
@@ -1846,19 +1916,34 @@ struct match_binaries_sel_opts {
 	__u32 op;
 	__u32 map_id;
 	__u32 mbset_id;
+	__u32 max_depth;
 };
 
+/* The matchBinaries, matchParentBinaries, and matchAncestorBinaries selectors
+ * share the maps below, each kind using MAX_SELECTORS entries of the selector
+ * slot starting at kind * MAX_SELECTORS.
+ */
+#define MB_KIND_CURRENT	  0
+#define MB_KIND_PARENT	  1
+#define MB_KIND_ANCESTORS 2
+#define MB_KINDS	  3
+
+/* key of the matchBinaries maps for the selector, kind and selector slot */
+#define MB_SEL_KEY(slot, kind, selidx) (((slot) * MB_KINDS + (kind)) * MAX_SELECTORS + (selidx))
+
+#define MATCH_ANCESTORS_MAX_DEPTH 16
+
 // This map is used by the matchBinaries selectors to retrieve their options
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY);
-	__uint(max_entries, MAX_SELECTORS);
-	__type(key, __u32); /* selector id */
+	__uint(max_entries, MAX_SELECTORS * MB_KINDS * SEL_SLOTS);
+	__type(key, __u32); /* see MB_SEL_KEY */
 	__type(value, struct match_binaries_sel_opts);
 } tg_mb_sel_opts SEC(".maps");
 
 struct {
 	__uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-	__uint(max_entries, MAX_SELECTORS); // only one matchBinaries per selector
+	__uint(max_entries, MAX_SELECTORS * MB_KINDS * SEL_SLOTS); // only one matchBinaries per selector
 	__type(key, __u32);
 	__array(
 		values, struct {
@@ -1869,9 +1954,13 @@ struct {
 		});
 } tg_mb_paths SEC(".maps");
 
-FUNC_INLINE int match_binaries(__u32 selidx, struct execve_map_value *current)
+/* match_binary: compares the binary of a process against the values of the
+ * selector stored at key. Returns 1 on match, 0 otherwise, and -1 if the
+ * comparison could not be performed. Negation is left to the caller.
+ */
+FUNC_INLINE long
+match_binary(__u32 key, struct match_binaries_sel_opts *selector_options, struct execve_map_value *current)
 {
-	bool match = 0;
 	void *path_map;
 	__u8 *found_key;
 #ifdef __LARGE_BPF_PROG
@@ -1882,23 +1971,119 @@ FUNC_INLINE int match_binaries(__u32 selidx, struct execve_map_value *current)
 	int zero = 0;
 #endif /* __LARGE_BPF_PROG */
 
+	if (current->bin.path_length < 0) {
+		// something wrong happened when copying the filename to execve_map
+		return -1;
+	}
+
+	switch (selector_options->op) {
+	case op_filter_in:
+	case op_filter_notin:
+		path_map = map_lookup_elem(&tg_mb_paths, &key);
+		if (!path_map)
+			return -1;
+		found_key = map_lookup_elem(path_map, current->bin.path);
+		break;
+#ifdef __LARGE_BPF_PROG
+	case op_filter_str_prefix:
+	case op_filter_str_notprefix:
+		path_map = map_lookup_elem(&string_prefix_maps, &selector_options->map_id);
+		if (!path_map)
+			return -1;
+		// prepare the key on the stack to perform lookup in the LPM_TRIE
+		memset(&prefix_key, 0, sizeof(prefix_key));
+		prefix_key.prefixlen = current->bin.path_length * 8; // prefixlen is in bits
+		if (probe_read(prefix_key.data, current->bin.path_length & (STRING_PREFIX_MAX_LENGTH - 1), current->bin.path) < 0)
+			return -1;
+		found_key = map_lookup_elem(path_map, &prefix_key);
+		break;
+	case op_filter_str_postfix:
+	case op_filter_str_notpostfix:
+		path_map = map_lookup_elem(&string_postfix_maps, &selector_options->map_id);
+		if (!path_map)
+			return -1;
+		if (current->bin.path_length < STRING_POSTFIX_MAX_MATCH_LENGTH)
+			postfix_len = current->bin.path_length;
+		postfix_key = (struct string_postfix_lpm_trie *)map_lookup_elem(&string_postfix_maps_heap, &zero);
+		if (!postfix_key)
+			return -1;
+		postfix_key->prefixlen = postfix_len * 8; // prefixlen is in bits
+		if (!current->bin.reversed) {
+			file_copy_reverse((__u8 *)current->bin.end_r, postfix_len, (__u8 *)current->bin.end, current->bin.path_length - postfix_len);
+			current->bin.reversed = true;
+		}
+		if (postfix_len < STRING_POSTFIX_MAX_MATCH_LENGTH)
+			if (probe_read(postfix_key->data, postfix_len, current->bin.end_r) < 0)
+				return -1;
+		found_key = map_lookup_elem(path_map, postfix_key);
+		break;
+#endif /* __LARGE_BPF_PROG */
+	default:
+		// should not happen
+		return -1;
+	}
+
+	return !!found_key;
+}
+
+#ifdef __LARGE_BPF_PROG
+/* match_lineage_binaries: handles the matchParentBinaries (kind
+ * MB_KIND_PARENT) and matchAncestorBinaries (kind MB_KIND_ANCESTORS)
+ * selectors by walking the parents of the process in the execve map. The
+ * selector matches if the binary of any of the first max_depth ancestors
+ * matches, or, for negated operators, if none of them does.
+ */
+FUNC_INLINE int
+match_lineage_binaries(__u32 selidx, __u32 slot, __u32 kind, struct execve_map_value *current)
+{
+	struct match_binaries_sel_opts *selector_options;
+	struct execve_map_value *parent = current;
+	__u32 key = MB_SEL_KEY(slot, kind, selidx);
+	long match = 0;
+	__u32 i;
+
+	selector_options = map_lookup_elem(&tg_mb_sel_opts, &key);
+	if (!selector_options || selector_options->op == op_filter_none)
+		return 1; // no selector <=> match
+
+	for (i = 0; i < MATCH_ANCESTORS_MAX_DEPTH; i++) {
+		if (i >= selector_options->max_depth)
+			break;
+		parent = execve_map_get_noinit(parent->pkey.pid);
+		if (!parent || parent->key.ktime != current->pkey.ktime)
+			break;
+		match = match_binary(key, selector_options, parent);
+		if (match > 0)
+			break;
+		match = 0;
+		current = parent;
+	}
+
+	return is_not_operator(selector_options->op) ? !match : match;
+}
+#endif /* __LARGE_BPF_PROG */
+
+FUNC_INLINE int match_binaries(__u32 selidx, __u32 slot, struct execve_map_value *current)
+{
 	struct match_binaries_sel_opts *selector_options;
+	__u32 key = MB_SEL_KEY(slot, MB_KIND_CURRENT, selidx);
+	long match;
+
+#ifdef __LARGE_BPF_PROG
+	if (!match_lineage_binaries(selidx, slot, MB_KIND_PARENT, current))
+		return 0;
+	if (!match_lineage_binaries(selidx, slot, MB_KIND_ANCESTORS, current))
+		return 0;
+#endif /* __LARGE_BPF_PROG */
 
 	// retrieve the selector_options for the matchBinaries, if it's NULL it
 	// means there is not matchBinaries in this selector.
-	selector_options = map_lookup_elem(&tg_mb_sel_opts, &selidx);
+	selector_options = map_lookup_elem(&tg_mb_sel_opts, &key);
 	if (selector_options) {
 		if (selector_options->op == op_filter_none)
 			return 1; // matchBinaries selector is empty <=> match
 
-		if (current->bin.path_length < 0) {
-			// something wrong happened when copying the filename to execve_map
-			return 0;
-		}
-
-		switch (selector_options->op) {
-		case op_filter_in:
-		case op_filter_notin:
+		if (selector_options->op == op_filter_in || selector_options->op == op_filter_notin) {
 			update_mb_task(current);
 
 			/* Check if we match the selector's bit in ->mb_bitset, which means that the
@@ -1907,56 +2092,13 @@ FUNC_INLINE int match_binaries(__u32 selidx, struct execve_map_value *current)
 			 * parent matched.
 			 */
 			if (selector_options->mbset_id != MBSET_INVALID_ID &&
-			    (current->bin.mb_bitset & (1UL << selector_options->mbset_id))) {
-				found_key = (u8 *)0xbadc0ffee;
-				break;
-			}
-
-			path_map = map_lookup_elem(&tg_mb_paths, &selidx);
-			if (!path_map)
-				return 0;
-			found_key = map_lookup_elem(path_map, current->bin.path);
-			break;
-#ifdef __LARGE_BPF_PROG
-		case op_filter_str_prefix:
-		case op_filter_str_notprefix:
-			path_map = map_lookup_elem(&string_prefix_maps, &selector_options->map_id);
-			if (!path_map)
-				return 0;
-			// prepare the key on the stack to perform lookup in the LPM_TRIE
-			memset(&prefix_key, 0, sizeof(prefix_key));
-			prefix_key.prefixlen = current->bin.path_length * 8; // prefixlen is in bits
-			if (probe_read(prefix_key.data, current->bin.path_length & (STRING_PREFIX_MAX_LENGTH - 1), current->bin.path) < 0)
-				return 0;
-			found_key = map_lookup_elem(path_map, &prefix_key);
-			break;
-		case op_filter_str_postfix:
-		case op_filter_str_notpostfix:
-			path_map = map_lookup_elem(&string_postfix_maps, &selector_options->map_id);
-			if (!path_map)
-				return 0;
-			if (current->bin.path_length < STRING_POSTFIX_MAX_MATCH_LENGTH)
-				postfix_len = current->bin.path_length;
-			postfix_key = (struct string_postfix_lpm_trie *)map_lookup_elem(&string_postfix_maps_heap, &zero);
-			if (!postfix_key)
-				return 0;
-			postfix_key->prefixlen = postfix_len * 8; // prefixlen is in bits
-			if (!current->bin.reversed) {
-				file_copy_reverse((__u8 *)current->bin.end_r, postfix_len, (__u8 *)current->bin.end, current->bin.path_length - postfix_len);
-				current->bin.reversed = true;
-			}
-			if (postfix_len < STRING_POSTFIX_MAX_MATCH_LENGTH)
-				if (probe_read(postfix_key->data, postfix_len, current->bin.end_r) < 0)
-					return 0;
-			found_key = map_lookup_elem(path_map, postfix_key);
-			break;
-#endif /* __LARGE_BPF_PROG */
-		default:
-			// should not happen
-			return 0;
+			    (current->bin.mb_bitset & (1UL << selector_options->mbset_id)))
+				return selector_options->op == op_filter_in;
 		}
 
-		match = !!found_key;
+		match = match_binary(key, selector_options, current);
+		if (match < 0)
+			return 0;
 		return is_not_operator(selector_options->op) ? !match : match;
 	}
 
@@ -2331,6 +2473,140 @@ rate_limit(__u64 ratelimit_interval, __u64 ratelimit_scope, struct msg_generic_k
 	map_update_elem(&ratelimit_map, key, &curr_time, 0);
 	return false;
 }
+
+#define ACTION_SAMPLE_SCOPE_RANDOM  0
+#define ACTION_SAMPLE_SCOPE_PROCESS 1
+
+/* sample_drop returns true if the event is not one of the 1 out of every
+ * sample_rate events posted by a sampling post action. With the process scope
+ * the decision only depends on the process, so all the events of a process are
+ * either posted or dropped.
+ */
+FUNC_INLINE bool
+sample_drop(__u32 sample_rate, __u32 sample_scope, struct msg_generic_kprobe *e)
+{
+	__u64 hash;
+
+	switch (sample_scope) {
+	case ACTION_SAMPLE_SCOPE_PROCESS:
+		hash = (e->current.ktime ^ e->current.pid) * 0x9e3779b97f4a7c15ULL;
+		return (__u32)(hash >> 32) % sample_rate != 0;
+	default:
+		return get_prandom_u32() % sample_rate != 0;
+	}
+}
+#endif
+
+#include "process/threshold_maps.h"
+#include "process/state_maps.h"
+
+#ifdef __LARGE_BPF_PROG
+/* state_match: checks the matchState filters of the selector against the
+ * sequence steps reached by the process.
+ */
+FUNC_INLINE bool
+state_match(struct msg_generic_kprobe *e, __u32 selidx)
+{
+	struct state_sel_opts *opts;
+	__u32 *state, reached = 0;
+	__u32 key;
+
+	key = sel_slot(e) * MAX_SELECTORS + selidx;
+	opts = map_lookup_elem(&tg_state_sel_opts, &key);
+	if (!opts || (!opts->reached && !opts->not_reached))
+		return true;
+
+	state = map_lookup_elem(&tg_state_map, &e->current);
+	if (state)
+		reached = *state;
+	return (reached & opts->reached) == opts->reached && !(reached & opts->not_reached);
+}
+
+/* set_state: updates the sequence steps reached by the process */
+FUNC_INLINE void
+set_state(struct msg_generic_kprobe *e, __u32 set, __u32 clear)
+{
+	__u32 *state;
+
+	state = map_lookup_elem(&tg_state_map, &e->current);
+	if (state) {
+		*state = (*state & ~clear) | set;
+		return;
+	}
+	if (set)
+		map_update_elem(&tg_state_map, &e->current, &set, BPF_ANY);
+}
+#endif
+
+#ifdef __LARGE_BPF_PROG
+/* threshold_reached: counts the matches of a selector with a matchThreshold
+ * and reports whether the threshold count has been reached within the window.
+ * The number of matches in the sliding window is estimated from the matches
+ * in the current and previous fixed windows. Once the threshold is reached,
+ * counting starts again.
+ */
+FUNC_INLINE bool
+threshold_reached(struct msg_generic_kprobe *e, __u32 selidx)
+{
+	struct threshold_value *value, new_value = {};
+	struct threshold_key key = {};
+	struct threshold_sel_opts *opts;
+	__u64 now, elapsed, estimate;
+	__u32 opts_key;
+
+	opts_key = sel_slot(e) * MAX_SELECTORS + selidx;
+	opts = map_lookup_elem(&tg_threshold_sel_opts, &opts_key);
+	if (!opts || !opts->window || opts->count <= 1)
+		return true;
+
+	key.func_id = e->func_id;
+	key.selidx = selidx;
+	switch (opts->scope) {
+	case THRESHOLD_SCOPE_PROCESS:
+		key.id = e->current.pid;
+		break;
+	case THRESHOLD_SCOPE_CGROUP:
+		key.id = tg_get_current_cgroup_id();
+		break;
+	case THRESHOLD_SCOPE_GLOBAL:
+		break;
+	default:
+		return false;
+	}
+
+	now = tg_get_ktime() / 1000000;
+	value = map_lookup_elem(&threshold_map, &key);
+	if (!value) {
+		new_value.start = now;
+		new_value.curr = 1;
+		map_update_elem(&threshold_map, &key, &new_value, 0);
+		return false;
+	}
+
+	elapsed = now - value->start;
+	if (elapsed >= 2 * opts->window) {
+		value->start = now;
+		value->prev = 0;
+		value->curr = 0;
+		elapsed = 0;
+	} else if (elapsed >= opts->window) {
+		value->start += opts->window;
+		value->prev = value->curr;
+		value->curr = 0;
+		elapsed -= opts->window;
+	}
+	value->curr++;
+
+	/* weight the previous window by the part of it the sliding window still covers */
+	estimate = value->curr + (__u64)value->prev * (opts->window - elapsed) / opts->window;
+	if (estimate < opts->count)
+		return false;
+
+	value->start = now;
+	value->prev = 0;
+	value->curr = 0;
+	return true;
+}
 #endif
 
 #ifdef __LARGE_BPF_PROG
@@ -46,6 +46,11 @@ enum {
 	// range
 	op_in_range = 31,
 	op_notin_range = 32,
+	// pattern ops
+	op_filter_str_glob = 33,
+	op_filter_str_notglob = 34,
+	op_filter_str_regex = 35,
+	op_filter_str_notregex = 36,
 };
 
 #endif // __OPERATIONS_H__
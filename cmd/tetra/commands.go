@@ -8,6 +8,8 @@ import (
 
 	"github.com/cilium/tetragon/cmd/tetra/explain"
 	"github.com/cilium/tetragon/cmd/tetra/getevents"
+	"github.com/cilium/tetragon/cmd/tetra/ps"
+	"github.com/cilium/tetragon/cmd/tetra/replay"
 	"github.com/cilium/tetragon/cmd/tetra/rthooks"
 	"github.com/cilium/tetragon/cmd/tetra/sensors"
 	"github.com/cilium/tetragon/cmd/tetra/stacktracetree"
@@ -16,15 +18,19 @@ import (
 )
 
 // addBaseCommands adds commands that build and make sense on all platform:
-// getevents, version, sensors, stacktracetree, status, rthooks
+// getevents, replay, version, sensors, stacktracetree, status, rthooks, ps,
+// pstree
 func addBaseCommands(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(getevents.New())
+	rootCmd.AddCommand(replay.New())
 	rootCmd.AddCommand(version.New())
 	rootCmd.AddCommand(sensors.New())
 	rootCmd.AddCommand(stacktracetree.New())
 	rootCmd.AddCommand(status.New())
 	rootCmd.AddCommand(rthooks.New())
 	rootCmd.AddCommand(explain.New())
+	rootCmd.AddCommand(ps.New())
+	rootCmd.AddCommand(ps.NewTree())
 
 	// bugtool technically builds on darwin and windows but makes no sense since
 	// it's supposed to be run on the machine running Tetragon, using
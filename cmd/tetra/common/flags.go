@@ -24,6 +24,12 @@ const (
 	KeyRetries       = "retries"        // int
 	KeyNamespace     = "namespace"      // string
 	KeyLogLevel      = "loglevel"       // string
+
+	KeyTLS           = "tls"             // bool
+	KeyTLSCAFile     = "tls-ca-file"     // string
+	KeyTLSCertFile   = "tls-cert-file"   // string
+	KeyTLSKeyFile    = "tls-key-file"    // string
+	KeyTLSServerName = "tls-server-name" // string
 )
 
 const (
@@ -35,6 +41,9 @@ var (
 	ServerAddress string
 	Timeout       time.Duration
 	Retries       int
+
+	// TLS configures the connection to the gRPC server
+	TLS TLSConfig
 )
 
 func readActiveServerAddressFromFile(fname string) (string, error) {
@@ -15,11 +15,13 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/filters"
 )
 
 type Opts struct {
@@ -44,21 +46,54 @@ type Opts struct {
 	CelExpression []string
 	Reconnect     bool
 	ReconnectWait time.Duration
+	ResumeFrom    uint64
+	ResumeID      string
+
+	Pids                []uint
+	PidSet              []uint
+	Labels              []string
+	ParentProcesses     []string
+	AncestorProcesses   []string
+	Arguments           []string
+	ContainerIDs        []string
+	CapsEffective       []string
+	DestWorkloads       []string
+	AllowList           string
+	DenyList            string
+	InputFiles          []string
+	InputFormat         string
+	IncludeRotatedFiles bool
+	Since               string
+	Until               string
 }
 
 var Options Opts
 
 // GetEncoder returns an encoder for an event stream based on configuration options.
-var GetEncoder = func(w io.Writer, colorMode encoder.ColorMode, timestamps bool, compact bool, tty string, stackTraces bool, imaHash bool) encoder.EventEncoder {
+var GetEncoder = func(w io.Writer, colorMode encoder.ColorMode, timestamps bool, output string, tty string, stackTraces bool, imaHash bool) encoder.EventEncoder {
 	if tty != "" {
 		return encoder.NewTtyEncoder(w, tty)
 	}
-	if compact {
+	switch output {
+	case "compact":
 		return encoder.NewCompactEncoder(w, colorMode, timestamps, stackTraces, imaHash)
+	case encoder.FormatProtobuf:
+		return encoder.NewProtobufEncoder(w)
+	case encoder.FormatCBOR:
+		return encoder.NewCBOREncoder(w)
 	}
 	return encoder.NewProtojsonEncoder(w)
 }
 
+// ValidateOutput checks the value of the output flag.
+func ValidateOutput(output string) error {
+	switch output {
+	case "json", "compact", encoder.FormatProtobuf, encoder.FormatCBOR:
+		return nil
+	}
+	return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+}
+
 // GetFilter returns a filter for an event stream based on configuration options.
 var GetFilter = func() *tetragon.Filter {
 	if Options.Host {
@@ -98,11 +133,79 @@ var GetFilter = func() *tetragon.Filter {
 	if len(Options.CelExpression) > 0 {
 		filter.CelExpression = Options.CelExpression
 	}
+	for _, pid := range Options.Pids {
+		filter.Pid = append(filter.Pid, uint32(pid))
+	}
+	for _, pid := range Options.PidSet {
+		filter.PidSet = append(filter.PidSet, uint32(pid))
+	}
+	if len(Options.Labels) > 0 {
+		filter.Labels = Options.Labels
+	}
+	if len(Options.ParentProcesses) > 0 {
+		filter.ParentBinaryRegex = Options.ParentProcesses
+	}
+	if len(Options.AncestorProcesses) > 0 {
+		filter.AncestorBinaryRegex = Options.AncestorProcesses
+	}
+	if len(Options.Arguments) > 0 {
+		filter.ArgumentsRegex = Options.Arguments
+	}
+	if len(Options.ContainerIDs) > 0 {
+		filter.ContainerId = Options.ContainerIDs
+	}
+	if len(Options.CapsEffective) > 0 {
+		var caps []tetragon.CapabilitiesType
+		for _, c := range Options.CapsEffective {
+			caps = append(caps, tetragon.CapabilitiesType(tetragon.CapabilitiesType_value[c]))
+		}
+		filter.Capabilities = &tetragon.CapFilter{
+			Effective: &tetragon.CapFilterSet{Any: caps},
+		}
+	}
+	if len(Options.DestWorkloads) > 0 {
+		filter.DestinationWorkload = Options.DestWorkloads
+	}
 
 	return &filter
 }
 
-func getRequest(includeFields, excludeFields []string, filter *tetragon.Filter) *tetragon.GetEventsRequest {
+// getFilterLists returns the allow and deny lists of the request. The filter
+// built from the individual filter flags is added to the filters of
+// --allow-list, unless it is empty and --allow-list is set, as an empty filter
+// matches all events.
+func getFilterLists() ([]*tetragon.Filter, []*tetragon.Filter, error) {
+	allowList, err := filters.ParseFilterList(Options.AllowList, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid value for %q flag: %w", "allow-list", err)
+	}
+	denyList, err := filters.ParseFilterList(Options.DenyList, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid value for %q flag: %w", "deny-list", err)
+	}
+	if filter := GetFilter(); len(allowList) == 0 || proto.Size(filter) > 0 {
+		allowList = append(allowList, filter)
+	}
+	return allowList, denyList, nil
+}
+
+// parseTime parses the value of the --since and --until flags, either an
+// RFC3339 timestamp or a duration relative to now.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC3339 timestamp nor a duration", value)
+	}
+	return now.Add(-d), nil
+}
+
+func getRequest(includeFields, excludeFields []string, allowList, denyList []*tetragon.Filter) *tetragon.GetEventsRequest {
 	var fieldFilters []*tetragon.FieldFilter
 	if len(includeFields) > 0 {
 		fieldFilters = append(fieldFilters, &tetragon.FieldFilter{
@@ -125,17 +228,35 @@ func getRequest(includeFields, excludeFields []string, filter *tetragon.Filter)
 
 	return &tetragon.GetEventsRequest{
 		FieldFilters: fieldFilters,
-		AllowList:    []*tetragon.Filter{filter},
+		AllowList:    allowList,
+		DenyList:     denyList,
 	}
 }
 
-func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) error {
-	request := getRequest(Options.IncludeFields, Options.ExcludeFields, GetFilter())
+// resumeCursor identifies the last received event of a stream.
+type resumeCursor struct {
+	sequence   uint64
+	instanceID string
+}
+
+// getEvents prints the events of client. If cursor is not nil, the stream
+// resumes after the event identified by cursor (unless its sequence is zero),
+// and cursor is updated with every received event.
+func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient, cursor *resumeCursor) error {
+	allowList, denyList, err := getFilterLists()
+	if err != nil {
+		return err
+	}
+	request := getRequest(Options.IncludeFields, Options.ExcludeFields, allowList, denyList)
+	if cursor != nil {
+		request.ResumeFrom = cursor.sequence
+		request.ResumeInstanceId = cursor.instanceID
+	}
 	stream, err := client.GetEvents(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to call GetEvents: %w", err)
 	}
-	eventEncoder := GetEncoder(os.Stdout, encoder.ColorMode(Options.Color), Options.Timestamps, Options.Output == "compact", Options.TTYEncode, Options.StackTraces, Options.ImaHash)
+	eventEncoder := GetEncoder(os.Stdout, encoder.ColorMode(Options.Color), Options.Timestamps, Options.Output, Options.TTYEncode, Options.StackTraces, Options.ImaHash)
 	for {
 		res, err := stream.Recv()
 		if err != nil {
@@ -144,6 +265,10 @@ func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) e
 			}
 			return nil
 		}
+		if cursor != nil && res.Sequence != 0 {
+			cursor.sequence = res.Sequence
+			cursor.instanceID = res.InstanceId
+		}
 		if err = eventEncoder.Encode(res); err != nil {
 			return fmt.Errorf("failed to encode event %#v: %w", res, err)
 		}
@@ -167,15 +292,33 @@ redirection of events to the stdin. Examples:
   tetra getevents -F parent
 
   # Include only process and parent.pod fields
-  tetra getevents -f process,parent.pod`,
+  tetra getevents -f process,parent.pod
+
+  # Query exported events, including rotated and compressed files, of the
+  # last two hours
+  tetra getevents --input-file /var/log/tetragon/tetragon.log --since 2h --labels app=web
+
+  # Use the filter syntax of the --export-allowlist agent flag
+  tetra getevents --input-file tetragon.log --allow-list '{"pid_set":[1234]}'`,
 		PreRunE: func(_ *cobra.Command, _ []string) error {
-			if Options.Output != "json" && Options.Output != "compact" {
-				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, Options.Output)
+			if err := ValidateOutput(Options.Output); err != nil {
+				return err
 			}
 			if Options.Color != "auto" && Options.Color != "always" && Options.Color != "never" {
 				return fmt.Errorf("invalid value for %q flag: %s", "color", Options.Color)
 			}
 
+			for _, v := range Options.CapsEffective {
+				if _, found := tetragon.CapabilitiesType_value[v]; !found {
+					return fmt.Errorf("invalid value for %q flag: %s", "caps-effective", v)
+				}
+			}
+			if Options.InputFormat != formatAuto {
+				if err := encoder.ValidateFormat(Options.InputFormat); err != nil {
+					return fmt.Errorf("invalid value for %q flag: %w", "input-format", err)
+				}
+			}
+
 			for _, v := range Options.EventTypes {
 				if _, found := tetragon.EventType_value[v]; !found {
 					var supportedEventTypes string
@@ -195,13 +338,52 @@ redirection of events to the stdin. Examples:
 			return nil
 		},
 		RunE: func(_ *cobra.Command, _ []string) error {
+			now := time.Now()
+			since, err := parseTime(Options.Since, now)
+			if err != nil {
+				return fmt.Errorf("invalid value for %q flag: %w", "since", err)
+			}
+			until, err := parseTime(Options.Until, now)
+			if err != nil {
+				return fmt.Errorf("invalid value for %q flag: %w", "until", err)
+			}
+
+			if len(Options.InputFiles) > 0 {
+				// read events from export files
+				files, err := expandInputFiles(Options.InputFiles, Options.IncludeRotatedFiles, since)
+				if err != nil {
+					return err
+				}
+				decoder := newFilesDecoder(files, Options.InputFormat)
+				defer decoder.Close()
+				client := newDecoderClient(decoder, common.Debug)
+				client.setTimeRange(since, until)
+				return getEvents(context.Background(), client, nil)
+			}
+
 			fi, _ := os.Stdin.Stat()
 			if fi.Mode()&os.ModeNamedPipe != 0 {
 				// read events from stdin
-				return getEvents(context.Background(), newIOReaderClient(os.Stdin, common.Debug))
+				format := Options.InputFormat
+				if format == formatAuto {
+					format = encoder.FormatJSON
+				}
+				decoder, err := encoder.NewDecoder(os.Stdin, format)
+				if err != nil {
+					return err
+				}
+				client := newDecoderClient(decoder, common.Debug)
+				client.setTimeRange(since, until)
+				return getEvents(context.Background(), client, nil)
+			}
+
+			if !since.IsZero() || !until.IsZero() {
+				return errors.New("--since and --until can only be used when reading events from files or stdin")
 			}
 
 			reconnect := Options.Reconnect
+			// On reconnection, resume after the last received event.
+			cursor := resumeCursor{sequence: Options.ResumeFrom, instanceID: Options.ResumeID}
 			tryGetEvents := func() error {
 				// connect to server
 				c, err := common.NewClientWithDefaultContextAndAddress()
@@ -209,7 +391,7 @@ redirection of events to the stdin. Examples:
 					return fmt.Errorf("failed create gRPC client: %w", err)
 				}
 				defer c.Close()
-				ret := getEvents(c.SignalCtx, c.Client)
+				ret := getEvents(c.SignalCtx, c.Client, &cursor)
 				if ctxErr := c.SignalCtx.Err(); ctxErr != nil && errors.Is(ctxErr, context.Canceled) {
 					// we got a signal, so we should not try to reconnect
 					reconnect = false
@@ -230,7 +412,7 @@ redirection of events to the stdin. Examples:
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&Options.Output, common.KeyOutput, "o", "json", "Output format. json or compact")
+	flags.StringVarP(&Options.Output, common.KeyOutput, "o", "json", "Output format. json, compact, protobuf (length-delimited) or cbor")
 	flags.StringVar(&Options.Color, "color", "auto", "Colorize compact output. auto, always, or never")
 	flags.StringSliceVarP(&Options.IncludeFields, "include-fields", "f", nil, "Include only fields in events")
 	flags.StringSliceVarP(&Options.EventTypes, "event-types", "e", nil, "Include only events of given types")
@@ -260,5 +442,25 @@ redirection of events to the stdin. Examples:
 	flags.StringSliceVar(&Options.CelExpression, "cel-expression", nil, "Get events satisfying the CEL expression")
 	flags.BoolVar(&Options.Reconnect, "reconnect", false, "Keep trying to connect even if an error occurred")
 	flags.DurationVar(&Options.ReconnectWait, "reconnect-wait", 2*time.Second, "wait time before attempting to reconnect")
+	flags.Uint64Var(&Options.ResumeFrom, "resume-from", 0, "Resume after the event with this sequence number, replaying the recent events kept by the agent")
+	flags.StringVar(&Options.ResumeID, "resume-instance-id", "", "Agent instance id of the event given with --resume-from")
+
+	flags.UintSliceVar(&Options.Pids, "pids", nil, "Get events by process PIDs")
+	flags.UintSliceVar(&Options.PidSet, "pid-set", nil, "Get events by process PIDs and their descendants")
+	flags.StringSliceVar(&Options.Labels, "labels", nil, "Get events by pod label selectors")
+	flags.StringSliceVar(&Options.ParentProcesses, "parent-processes", nil, "Get events by parent process name regex")
+	flags.StringSliceVar(&Options.AncestorProcesses, "ancestor-processes", nil, "Get events by ancestor process name regex")
+	flags.StringSliceVar(&Options.Arguments, "arguments", nil, "Get events by process arguments regex")
+	flags.StringSliceVar(&Options.ContainerIDs, "container-ids", nil, "Get events by container ID prefix")
+	flags.StringSliceVar(&Options.CapsEffective, "caps-effective", nil, "Get events by processes having any of the effective capabilities (e.g. CAP_SYS_ADMIN)")
+	flags.StringSliceVar(&Options.DestWorkloads, "destination-workloads", nil, "Get events by the workload of the destination of network arguments, as workload or namespace/workload")
+	flags.StringVar(&Options.AllowList, "allow-list", "", "Allow list of filters, in the format of the agent --export-allowlist flag")
+	flags.StringVar(&Options.DenyList, "deny-list", "", "Deny list of filters, in the format of the agent --export-denylist flag")
+
+	flags.StringSliceVar(&Options.InputFiles, "input-file", nil, "Read events from export files instead of connecting to the server")
+	flags.StringVar(&Options.InputFormat, "input-format", formatAuto, "Format of the input events. auto (from the file extension), json, protobuf or cbor")
+	flags.BoolVar(&Options.IncludeRotatedFiles, "include-rotated", true, "Also read the rotated (and possibly compressed) backups of the input files, oldest first")
+	flags.StringVar(&Options.Since, "since", "", "Only print events after this time, as an RFC3339 timestamp or a duration relative to now (input files and stdin only)")
+	flags.StringVar(&Options.Until, "until", "", "Only print events before this time, as an RFC3339 timestamp or a duration relative to now (input files and stdin only)")
 	return &cmd
 }
@@ -5,8 +5,12 @@ package getevents
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -172,3 +176,97 @@ func Test_GetEvents_FilterFields(t *testing.T) {
 		}
 	})
 }
+
+// writeRotatedExport splits testdata/events.json into a compressed backup, an
+// uncompressed backup and the current export file, named like lumberjack does.
+func writeRotatedExport(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(testutils.RepoRootPath("testdata/events.json"))
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(bytes.TrimSpace(data), []byte("\n"))
+	require.Len(t, lines, 3)
+
+	dir := t.TempDir()
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err = w.Write(lines[0])
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	// The backups are listed in reverse order to check that they are sorted.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tetragon-2022-10-18T03-16-22.000.log"), lines[1], 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tetragon-2022-10-18T03-16-21.000.log.gz"), gz.Bytes(), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tetragon.log"), lines[2], 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.log"), lines[2], 0o600))
+	return filepath.Join(dir, "tetragon.log")
+}
+
+func outputPids(t *testing.T, output []byte) []uint32 {
+	t.Helper()
+	var pids []uint32
+	for line := range bytes.SplitSeq(bytes.TrimSpace(output), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var res tetragon.GetEventsResponse
+		require.NoError(t, json.Unmarshal(line, &res))
+		pids = append(pids, res.GetProcessExec().GetProcess().GetPid().GetValue())
+	}
+	return pids
+}
+
+func Test_GetEvents_InputFile(t *testing.T) {
+	exportFile := writeRotatedExport(t)
+
+	t.Run("Rotated", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{"--input-file", exportFile})
+		output := testutils.RedirectStdoutExecuteCmd(t, cmd)
+		assert.Equal(t, []uint32{68790, 68862, 68920}, outputPids(t, output))
+	})
+
+	t.Run("NoRotated", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{"--input-file", exportFile, "--include-rotated=false"})
+		output := testutils.RedirectStdoutExecuteCmd(t, cmd)
+		assert.Len(t, outputPids(t, output), 1)
+	})
+
+	t.Run("TimeRange", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{"--input-file", exportFile, "--since", "2022-10-18T03:16:22Z", "--until", "2022-10-18T03:16:23Z"})
+		output := testutils.RedirectStdoutExecuteCmd(t, cmd)
+		assert.Len(t, outputPids(t, output), 1)
+	})
+
+	t.Run("Pids", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{"--input-file", exportFile, "--pids", "68790"})
+		output := testutils.RedirectStdoutExecuteCmd(t, cmd)
+		assert.Equal(t, []uint32{68790}, outputPids(t, output))
+	})
+
+	t.Run("AllowDenyList", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{"--input-file", exportFile,
+			"--allow-list", `{"namespace":["default"]}`,
+			"--deny-list", `{"pid":[68790]}`})
+		output := testutils.RedirectStdoutExecuteCmd(t, cmd)
+		assert.NotContains(t, outputPids(t, output), uint32(68790))
+		assert.NotEmpty(t, outputPids(t, output))
+	})
+}
+
+func Test_parseTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	ts, err := parseTime("2h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-2*time.Hour), ts)
+	ts, err = parseTime("2024-01-01T00:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ts)
+	ts, err = parseTime("", now)
+	require.NoError(t, err)
+	assert.True(t, ts.IsZero())
+	_, err = parseTime("yesterday", now)
+	require.Error(t, err)
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package getevents
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/encoder"
+)
+
+const (
+	// backupTimeFormat is the timestamp format used by lumberjack to name
+	// rotated export files: <name>-<timestamp><ext>[.gz].
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	compressSuffix   = ".gz"
+	formatAuto       = "auto"
+)
+
+// inputFile is an export file, with the time at which it was rotated for
+// rotated backups.
+type inputFile struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// rotatedFiles returns the rotated backups of the export file at path, oldest
+// first, followed by path itself if it exists.
+func rotatedFiles(path string) ([]inputFile, error) {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []inputFile
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		ts, ok := strings.CutPrefix(strings.TrimSuffix(name, compressSuffix), prefix)
+		if !ok {
+			continue
+		}
+		ts, ok = strings.CutSuffix(ts, ext)
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, ts)
+		if err != nil {
+			continue
+		}
+		files = append(files, inputFile{path: filepath.Join(dir, name), rotatedAt: t})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].rotatedAt.Before(files[j].rotatedAt)
+	})
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, inputFile{path: path})
+	} else if len(files) == 0 {
+		return nil, err
+	}
+	return files, nil
+}
+
+// expandInputFiles returns the files to read for the given paths, in order.
+// When includeRotated is true, the rotated backups of each path are read
+// before it. Backups rotated before since are skipped, since all their
+// events are older.
+func expandInputFiles(paths []string, includeRotated bool, since time.Time) ([]inputFile, error) {
+	var files []inputFile
+	for _, path := range paths {
+		if !includeRotated {
+			files = append(files, inputFile{path: path})
+			continue
+		}
+		rotated, err := rotatedFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range rotated {
+			if !f.rotatedAt.IsZero() && !since.IsZero() && f.rotatedAt.Before(since) {
+				continue
+			}
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// filesDecoder implements encoder.EventDecoder by decoding a list of files
+// in order.
+type filesDecoder struct {
+	files  []inputFile
+	format string
+	cur    io.Closer
+	dec    encoder.EventDecoder
+	name   string
+}
+
+func newFilesDecoder(files []inputFile, format string) *filesDecoder {
+	return &filesDecoder{files: files, format: format}
+}
+
+func (d *filesDecoder) next() error {
+	if len(d.files) == 0 {
+		return io.EOF
+	}
+	f := d.files[0]
+	d.files = d.files[1:]
+	format := d.format
+	if format == formatAuto {
+		format = encoder.FormatFromFilename(f.path)
+	}
+	r, closer, err := encoder.OpenExportFile(f.path)
+	if err != nil {
+		return err
+	}
+	dec, err := encoder.NewDecoder(r, format)
+	if err != nil {
+		closer.Close()
+		return err
+	}
+	d.cur, d.dec, d.name = closer, dec, f.path
+	return nil
+}
+
+func (d *filesDecoder) Decode() (*tetragon.GetEventsResponse, error) {
+	for {
+		if d.dec == nil {
+			if err := d.next(); err != nil {
+				return nil, err
+			}
+		}
+		ev, err := d.dec.Decode()
+		if err == nil {
+			return ev, nil
+		}
+		if !errors.Is(err, io.EOF) {
+			if errors.Is(err, encoder.ErrInvalidEvent) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", d.name, err)
+		}
+		d.Close()
+	}
+}
+
+func (d *filesDecoder) Close() error {
+	if d.cur == nil {
+		return nil
+	}
+	err := d.cur.Close()
+	d.cur, d.dec = nil, nil
+	return err
+}
@@ -4,16 +4,17 @@
 package getevents
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/encoder"
 	"github.com/cilium/tetragon/pkg/event"
 	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/filters"
@@ -21,33 +22,52 @@ import (
 
 // ioReaderClient implements tetragon.FineGuidanceSensors_GetEventsClient.
 // ioReaderObserver implements tetragon.FineGuidanceSensorsClient interface. It reads Tetragon events
+// from an encoder.EventDecoder and applies the filters of the GetEvents
+// request locally, as the server would.
 type ioReaderClient struct {
-	scanner      *bufio.Scanner
+	decoder      encoder.EventDecoder
 	allowlist    filters.FilterFuncs
+	denylist     filters.FilterFuncs
 	fieldFilters []*fieldfilters.FieldFilter
-	unmarshaller protojson.UnmarshalOptions
+	since        time.Time
+	until        time.Time
 	debug        bool
 	grpc.ClientStream
 }
 
 func newIOReaderClient(reader io.Reader, debug bool) *ioReaderClient {
+	return newDecoderClient(encoder.NewProtojsonDecoder(reader), debug)
+}
+
+func newDecoderClient(decoder encoder.EventDecoder, debug bool) *ioReaderClient {
 	return &ioReaderClient{
-		scanner:      bufio.NewScanner(reader),
-		unmarshaller: protojson.UnmarshalOptions{DiscardUnknown: true},
-		debug:        debug,
+		decoder: decoder,
+		debug:   debug,
 	}
 }
 
+// setTimeRange restricts the events returned to those with a time within
+// [since, until). A zero time leaves the corresponding bound open.
+func (i *ioReaderClient) setTimeRange(since, until time.Time) {
+	i.since = since
+	i.until = until
+}
+
 func (i *ioReaderClient) GetEvents(ctx context.Context, in *tetragon.GetEventsRequest, _ ...grpc.CallOption) (tetragon.FineGuidanceSensors_GetEventsClient, error) {
 	allowlist, err := filters.BuildFilterList(ctx, in.AllowList, filters.Filters)
 	if err != nil {
 		return nil, err
 	}
+	denylist, err := filters.BuildFilterList(ctx, in.DenyList, filters.Filters)
+	if err != nil {
+		return nil, err
+	}
 	ffs, err := fieldfilters.FieldFiltersFromGetEventsRequest(in)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create field filters: %w", err)
 	}
 	i.allowlist = allowlist
+	i.denylist = denylist
 	i.fieldFilters = ffs
 	if i.debug {
 		fmt.Fprintf(os.Stderr, "DEBUG: GetEvents request: %+v\n", in)
@@ -63,6 +83,10 @@ func (i *ioReaderClient) AddTracingPolicy(_ context.Context, _ *tetragon.AddTrac
 	panic("stub")
 }
 
+func (i *ioReaderClient) UpdateTracingPolicy(_ context.Context, _ *tetragon.UpdateTracingPolicyRequest, _ ...grpc.CallOption) (*tetragon.UpdateTracingPolicyResponse, error) {
+	panic("stub")
+}
+
 func (i *ioReaderClient) DeleteTracingPolicy(_ context.Context, _ *tetragon.DeleteTracingPolicyRequest, _ ...grpc.CallOption) (*tetragon.DeleteTracingPolicyResponse, error) {
 	panic("stub")
 }
@@ -104,15 +128,21 @@ func (i *ioReaderClient) GetVersion(_ context.Context, _ *tetragon.GetVersionReq
 }
 
 func (i *ioReaderClient) Recv() (*tetragon.GetEventsResponse, error) {
-	for i.scanner.Scan() {
-		res := &tetragon.GetEventsResponse{}
-		line := i.scanner.Bytes()
-		err := i.unmarshaller.Unmarshal(line, res)
-		if err != nil && i.debug {
-			fmt.Fprintf(os.Stderr, "DEBUG: failed unmarshal: %s: %s\n", line, err)
+	for {
+		res, err := i.decoder.Decode()
+		if err != nil {
+			if errors.Is(err, encoder.ErrInvalidEvent) {
+				if i.debug {
+					fmt.Fprintf(os.Stderr, "DEBUG: failed unmarshal: %s\n", err)
+				}
+				continue
+			}
+			return nil, err
+		}
+		if !i.inTimeRange(res) {
 			continue
 		}
-		if !filters.Apply(i.allowlist, nil, &event.Event{Event: res}) {
+		if !filters.Apply(i.allowlist, i.denylist, &event.Event{Event: res}) {
 			continue
 		}
 		for _, filter := range i.fieldFilters {
@@ -123,10 +153,28 @@ func (i *ioReaderClient) Recv() (*tetragon.GetEventsResponse, error) {
 		}
 		return res, nil
 	}
-	if err := i.scanner.Err(); err != nil {
-		return nil, err
+}
+
+func (i *ioReaderClient) inTimeRange(res *tetragon.GetEventsResponse) bool {
+	if i.since.IsZero() && i.until.IsZero() {
+		return true
 	}
-	return nil, io.EOF
+	if res.GetTime() == nil {
+		return false
+	}
+	t := res.GetTime().AsTime()
+	if !i.since.IsZero() && t.Before(i.since) {
+		return false
+	}
+	return i.until.IsZero() || t.Before(i.until)
+}
+
+func (i *ioReaderClient) ListProcesses(_ context.Context, _ *tetragon.ListProcessesRequest, _ ...grpc.CallOption) (*tetragon.ListProcessesResponse, error) {
+	panic("stub")
+}
+
+func (i *ioReaderClient) GetProcessTree(_ context.Context, _ *tetragon.GetProcessTreeRequest, _ ...grpc.CallOption) (*tetragon.GetProcessTreeResponse, error) {
+	panic("stub")
 }
 
 func (i *ioReaderClient) RuntimeHook(_ context.Context, _ *tetragon.RuntimeHookRequest, _ ...grpc.CallOption) (*tetragon.RuntimeHookResponse, error) {
@@ -47,5 +47,10 @@ func New() *cobra.Command {
 	flags.StringVar(&common.ServerAddress, common.KeyServerAddress, "", "gRPC server address")
 	flags.DurationVar(&common.Timeout, common.KeyTimeout, 30*time.Second, "Connection timeout")
 	flags.IntVar(&common.Retries, common.KeyRetries, 1, "Connection retries with exponential backoff")
+	flags.BoolVar(&common.TLS.Enabled, common.KeyTLS, false, "Connect to the gRPC server with TLS. Implied by the other TLS flags")
+	flags.StringVar(&common.TLS.CAFile, common.KeyTLSCAFile, "", "PEM encoded CA certificate to verify the gRPC server certificate, instead of the system ones")
+	flags.StringVar(&common.TLS.CertFile, common.KeyTLSCertFile, "", "PEM encoded client certificate, for servers requiring mutual TLS")
+	flags.StringVar(&common.TLS.KeyFile, common.KeyTLSKeyFile, "", "PEM encoded key of the client certificate")
+	flags.StringVar(&common.TLS.ServerName, common.KeyTLSServerName, "", "Name to verify the gRPC server certificate against, instead of the server address host")
 	return rootCmd
 }
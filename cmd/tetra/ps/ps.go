@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ps
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/cmd/tetra/common"
+)
+
+type listFlags struct {
+	output      string
+	namespace   string
+	pod         string
+	container   string
+	binaryRegex string
+	execIDs     []string
+	all         bool
+}
+
+func (f *listFlags) add(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVarP(&f.output, common.KeyOutput, "o", "text", "Output format. text or json")
+	flags.StringVarP(&f.namespace, common.KeyNamespace, "n", "", "Only show processes in pods of this namespace")
+	flags.StringVar(&f.pod, "pod", "", "Only show processes in pods with this name")
+	flags.StringVar(&f.container, "container", "", "Only show processes in containers with an ID starting with this prefix")
+	flags.StringVar(&f.binaryRegex, "binary", "", "Only show processes with a binary matching this regular expression")
+	flags.StringSliceVar(&f.execIDs, "exec-id", nil, "Only show the processes with these exec IDs")
+	flags.BoolVarP(&f.all, "all", "a", false, "Also show the processes that exited")
+}
+
+func (f *listFlags) validate() error {
+	if f.output != "json" && f.output != "text" {
+		return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, f.output)
+	}
+	return nil
+}
+
+func (f *listFlags) request() *tetragon.ListProcessesRequest {
+	return &tetragon.ListProcessesRequest{
+		PodNamespace:  f.namespace,
+		PodName:       f.pod,
+		ContainerId:   f.container,
+		BinaryRegex:   f.binaryRegex,
+		ExecIds:       f.execIDs,
+		IncludeExited: f.all,
+	}
+}
+
+type jsonMarshaler interface {
+	MarshalJSON() ([]byte, error)
+}
+
+func printJSON(cmd *cobra.Command, m jsonMarshaler) error {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to generate json: %w", err)
+	}
+	cmd.Println(string(b))
+	return nil
+}
+
+// New returns the ps command.
+func New() *cobra.Command {
+	var flags listFlags
+	ret := &cobra.Command{
+		Use:   "ps",
+		Short: "List processes",
+		Long: `List the processes of the process cache of the agent.
+
+Processes can be filtered by pod, container, binary or exec ID. Processes that
+exited are kept in the process cache while they have descendants or events
+referencing them, use --all to list them.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return flags.validate()
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			res, err := c.Client.ListProcesses(c.Ctx, flags.request())
+			if err != nil {
+				return fmt.Errorf("failed to list processes: %w", err)
+			}
+
+			if flags.output == "json" {
+				return printJSON(cmd, res)
+			}
+			PrintProcesses(cmd.OutOrStdout(), res.Processes)
+			return nil
+		},
+	}
+	flags.add(ret)
+	return ret
+}
+
+// NewTree returns the pstree command.
+func NewTree() *cobra.Command {
+	var flags listFlags
+	ret := &cobra.Command{
+		Use:   "pstree [exec_id]",
+		Short: "Show process trees",
+		Long: `Show the process tree of a process, with its ancestors and descendants.
+
+Without exec ID, show the trees of the processes matching the filters, for
+example the processes of a pod. Container boundaries are marked with the pod
+and container of the processes.`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return flags.validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			if len(args) == 1 {
+				res, err := c.Client.GetProcessTree(c.Ctx, &tetragon.GetProcessTreeRequest{
+					ExecId:        args[0],
+					IncludeExited: flags.all,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to get process tree: %w", err)
+				}
+				if flags.output == "json" {
+					return printJSON(cmd, res)
+				}
+				PrintTree(cmd.OutOrStdout(), treeProcesses(res))
+				return nil
+			}
+
+			res, err := c.Client.ListProcesses(c.Ctx, flags.request())
+			if err != nil {
+				return fmt.Errorf("failed to list processes: %w", err)
+			}
+			if flags.output == "json" {
+				return printJSON(cmd, res)
+			}
+			PrintTree(cmd.OutOrStdout(), res.Processes)
+			return nil
+		},
+	}
+	flags.add(ret)
+	return ret
+}
+
+// treeProcesses returns the processes of a process tree, from its oldest
+// ancestor.
+func treeProcesses(res *tetragon.GetProcessTreeResponse) []*tetragon.ProcessEntry {
+	ret := make([]*tetragon.ProcessEntry, 0, len(res.Ancestors)+1+len(res.Descendants))
+	for i := len(res.Ancestors) - 1; i >= 0; i-- {
+		ret = append(ret, res.Ancestors[i])
+	}
+	ret = append(ret, res.Process)
+	return append(ret, res.Descendants...)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ps
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func command(proc *tetragon.Process) string {
+	if proc.Arguments == "" {
+		return proc.Binary
+	}
+	return proc.Binary + " " + proc.Arguments
+}
+
+func podName(pod *tetragon.Pod) string {
+	if pod == nil {
+		return "-"
+	}
+	return pod.Namespace + "/" + pod.Name
+}
+
+func containerName(pod *tetragon.Pod) string {
+	if name := pod.GetContainer().GetName(); name != "" {
+		return name
+	}
+	return "-"
+}
+
+func state(e *tetragon.ProcessEntry) string {
+	if e.Exited {
+		return "exited"
+	}
+	return "running"
+}
+
+// PrintProcesses prints a table of processes.
+func PrintProcesses(output io.Writer, entries []*tetragon.ProcessEntry) {
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "PID\tUID\tPOD\tCONTAINER\tSTATE\tEXEC_ID\tCOMMAND")
+	for _, e := range entries {
+		proc := e.Process
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			proc.GetPid().GetValue(),
+			proc.GetUid().GetValue(),
+			podName(proc.Pod),
+			containerName(proc.Pod),
+			state(e),
+			proc.ExecId,
+			command(proc),
+		)
+	}
+	w.Flush()
+}
+
+// podKey identifies the container of a process, to mark the container
+// boundaries in process trees.
+func podKey(pod *tetragon.Pod) string {
+	if pod == nil {
+		return ""
+	}
+	return pod.Namespace + "/" + pod.Name + "/" + pod.GetContainer().GetName()
+}
+
+// PrintTree prints the processes as trees. The roots of the trees are the
+// processes whose parent is not part of the processes. Processes are
+// annotated with their pod and container when they differ from the ones of
+// their parent.
+func PrintTree(output io.Writer, entries []*tetragon.ProcessEntry) {
+	execIDs := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		execIDs[e.Process.ExecId] = struct{}{}
+	}
+	children := map[string][]*tetragon.ProcessEntry{}
+	var roots []*tetragon.ProcessEntry
+	for _, e := range entries {
+		parentID := e.Process.ParentExecId
+		if _, ok := execIDs[parentID]; ok && parentID != e.Process.ExecId {
+			children[parentID] = append(children[parentID], e)
+		} else {
+			roots = append(roots, e)
+		}
+	}
+
+	var printEntry func(e *tetragon.ProcessEntry, parentPod, prefix, childPrefix string)
+	printEntry = func(e *tetragon.ProcessEntry, parentPod, prefix, childPrefix string) {
+		proc := e.Process
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%s%d %s", prefix, proc.GetPid().GetValue(), command(proc))
+		if key := podKey(proc.Pod); key != parentPod && key != "" {
+			fmt.Fprintf(&sb, " [%s]", key)
+		}
+		if e.Exited {
+			sb.WriteString(" (exited)")
+		}
+		fmt.Fprintln(output, sb.String())
+
+		kids := children[proc.ExecId]
+		// each process is printed once, even with inconsistent parent IDs
+		delete(children, proc.ExecId)
+		for i, c := range kids {
+			if i == len(kids)-1 {
+				printEntry(c, podKey(proc.Pod), childPrefix+"└─ ", childPrefix+"   ")
+			} else {
+				printEntry(c, podKey(proc.Pod), childPrefix+"├─ ", childPrefix+"│  ")
+			}
+		}
+	}
+	for _, e := range roots {
+		printEntry(e, "", "", "")
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ps
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func testEntries() []*tetragon.ProcessEntry {
+	pod := &tetragon.Pod{
+		Namespace: "default",
+		Name:      "web",
+		Container: &tetragon.Container{Id: "abcdef", Name: "nginx"},
+	}
+	entry := func(pid uint32, execID, parentID, binary, args string, pod *tetragon.Pod, exited bool) *tetragon.ProcessEntry {
+		return &tetragon.ProcessEntry{
+			Process: &tetragon.Process{
+				ExecId:       execID,
+				ParentExecId: parentID,
+				Pid:          &wrapperspb.UInt32Value{Value: pid},
+				Uid:          &wrapperspb.UInt32Value{Value: 0},
+				Binary:       binary,
+				Arguments:    args,
+				Pod:          pod,
+			},
+			Exited: exited,
+		}
+	}
+	return []*tetragon.ProcessEntry{
+		entry(10, "containerd", "init", "/usr/bin/containerd", "", nil, false),
+		entry(20, "nginx", "containerd", "/usr/sbin/nginx", "-g daemon off;", pod, false),
+		entry(21, "worker", "nginx", "/usr/sbin/nginx", "", pod, false),
+		entry(22, "sh", "nginx", "/bin/sh", "", pod, true),
+		entry(23, "ls", "sh", "/bin/ls", "-l", pod, false),
+		entry(30, "sshd", "init", "/usr/sbin/sshd", "", nil, false),
+	}
+}
+
+func TestPrintProcesses(t *testing.T) {
+	var out bytes.Buffer
+	PrintProcesses(&out, testEntries()[:2])
+	assert.Equal(t, `PID   UID   POD           CONTAINER   STATE     EXEC_ID      COMMAND
+10    0     -             -           running   containerd   /usr/bin/containerd
+20    0     default/web   nginx       running   nginx        /usr/sbin/nginx -g daemon off;
+`, out.String())
+}
+
+func TestPrintTree(t *testing.T) {
+	var out bytes.Buffer
+	PrintTree(&out, testEntries())
+	assert.Equal(t, `10 /usr/bin/containerd
+└─ 20 /usr/sbin/nginx -g daemon off; [default/web/nginx]
+   ├─ 21 /usr/sbin/nginx
+   └─ 22 /bin/sh (exited)
+      └─ 23 /bin/ls -l
+30 /usr/sbin/sshd
+`, out.String())
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/cmd/tetra/getevents"
+	"github.com/cilium/tetragon/pkg/encoder"
+)
+
+const formatAuto = "auto"
+
+type Opts struct {
+	InputFormat string
+	Output      string
+	Color       string
+	Timestamps  bool
+	Speed       float64
+}
+
+var Options Opts
+
+func New() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "replay FILE...",
+		Short: "Replay events from export files",
+		Long: `This command reads events from export files written in the json, protobuf
+or cbor format and prints them in the requested output format. Use "-" to read
+from the standard input. Examples:
+
+  # Print a protobuf export file in the compact format
+  tetra replay -o compact /var/log/tetragon/tetragon.pb
+
+  # Convert a CBOR export file to JSON
+  tetra replay --input-format cbor events.bin > events.json
+
+  # Replay events at the pace at which they were generated
+  tetra replay --speed 1 -o compact tetragon.cbor`,
+		Args: cobra.MinimumNArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if Options.InputFormat != formatAuto {
+				if err := encoder.ValidateFormat(Options.InputFormat); err != nil {
+					return fmt.Errorf("invalid value for %q flag: %w", "input-format", err)
+				}
+			}
+			if err := getevents.ValidateOutput(Options.Output); err != nil {
+				return err
+			}
+			if Options.Speed < 0 {
+				return fmt.Errorf("invalid value for %q flag: %v", "speed", Options.Speed)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enc := getevents.GetEncoder(cmd.OutOrStdout(), encoder.ColorMode(Options.Color), Options.Timestamps, Options.Output, "", true, true)
+			p := player{speed: Options.Speed, encoder: enc}
+			for _, file := range args {
+				if err := p.replayFile(cmd.Context(), cmd.InOrStdin(), file); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&Options.InputFormat, "input-format", formatAuto, "Format of the input files. auto (from the file extension), json, protobuf or cbor")
+	flags.StringVarP(&Options.Output, common.KeyOutput, "o", "json", "Output format. json, compact, protobuf (length-delimited) or cbor")
+	flags.StringVar(&Options.Color, "color", "auto", "Colorize compact output. auto, always, or never")
+	flags.BoolVar(&Options.Timestamps, "timestamps", false, "Include timestamps in compact output")
+	flags.Float64Var(&Options.Speed, "speed", 0, "Replay speed relative to the time between events, 0 replays as fast as possible")
+	return &cmd
+}
+
+type player struct {
+	speed   float64
+	encoder encoder.EventEncoder
+	last    time.Time
+}
+
+func (p *player) replayFile(ctx context.Context, stdin io.Reader, file string) error {
+	var r io.Reader = stdin
+	format := Options.InputFormat
+	if file != "-" {
+		f, closer, err := encoder.OpenExportFile(file)
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+		r = f
+		if format == formatAuto {
+			format = encoder.FormatFromFilename(file)
+		}
+	} else if format == formatAuto {
+		format = encoder.FormatJSON
+	}
+
+	dec, err := encoder.NewDecoder(r, format)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if err := p.wait(ctx, ev); err != nil {
+			return err
+		}
+		if err := p.encoder.Encode(ev); err != nil {
+			return fmt.Errorf("failed to encode event %#v: %w", ev, err)
+		}
+	}
+}
+
+// wait delays the event by the time elapsed since the previous event, scaled
+// by the replay speed.
+func (p *player) wait(ctx context.Context, ev *tetragon.GetEventsResponse) error {
+	if p.speed == 0 || ev.GetTime() == nil {
+		return nil
+	}
+	t := ev.GetTime().AsTime()
+	defer func() { p.last = t }()
+	if p.last.IsZero() || !t.After(p.last) {
+		return nil
+	}
+	timer := time.NewTimer(time.Duration(float64(t.Sub(p.last)) / p.speed))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
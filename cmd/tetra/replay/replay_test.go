@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/testutils"
+)
+
+func replay(t *testing.T, args ...string) []byte {
+	t.Helper()
+	Options = Opts{}
+	cmd := New()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return out.Bytes()
+}
+
+func decodeAll(t *testing.T, data []byte, format string) []*tetragon.GetEventsResponse {
+	t.Helper()
+	dec, err := encoder.NewDecoder(bytes.NewReader(data), format)
+	require.NoError(t, err)
+	var events []*tetragon.GetEventsResponse
+	for {
+		ev, err := dec.Decode()
+		if err != nil {
+			break
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+func TestReplayConvert(t *testing.T) {
+	input := testutils.RepoRootPath("testdata/events.json")
+	data, err := os.ReadFile(input)
+	require.NoError(t, err)
+	want := decodeAll(t, data, encoder.FormatJSON)
+	require.NotEmpty(t, want)
+
+	dir := t.TempDir()
+	for _, format := range []string{encoder.FormatProtobuf, encoder.FormatCBOR} {
+		// Convert the JSON events, then replay the binary file back to JSON.
+		binFile := filepath.Join(dir, "events."+map[string]string{
+			encoder.FormatProtobuf: "pb",
+			encoder.FormatCBOR:     "cbor",
+		}[format])
+		require.NoError(t, os.WriteFile(binFile, replay(t, "-o", format, input), 0o600))
+
+		got := decodeAll(t, replay(t, binFile), encoder.FormatJSON)
+		require.Len(t, got, len(want), format)
+		for i := range want {
+			assert.True(t, proto.Equal(want[i], got[i]), format)
+		}
+	}
+}
+
+func TestReplayGzip(t *testing.T) {
+	input := testutils.RepoRootPath("testdata/events.json")
+	data, err := os.ReadFile(input)
+	require.NoError(t, err)
+	want := decodeAll(t, data, encoder.FormatJSON)
+
+	// Rotated export files are compressed, and their format is guessed
+	// from the extension before .gz.
+	pb := replay(t, "-o", encoder.FormatProtobuf, input)
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err = w.Write(pb)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	gzFile := filepath.Join(t.TempDir(), "events.pb.gz")
+	require.NoError(t, os.WriteFile(gzFile, gz.Bytes(), 0o600))
+
+	got := decodeAll(t, replay(t, gzFile), encoder.FormatJSON)
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.True(t, proto.Equal(want[i], got[i]))
+	}
+}
+
+func TestReplayCompact(t *testing.T) {
+	out := replay(t, "-o", "compact", "--color", "never", testutils.RepoRootPath("testdata/events.json"))
+	assert.Contains(t, string(out), "🚀 process")
+}
+
+func TestReplayInvalidFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--input-format", "xml", "f"},
+		{"-o", "yaml", "f"},
+		{"--speed", "-1", "f"},
+		{},
+	} {
+		Options = Opts{}
+		cmd := New()
+		cmd.SetArgs(args)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		assert.Error(t, cmd.Execute(), args)
+	}
+}
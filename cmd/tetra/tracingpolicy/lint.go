@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracingpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	ebtf "github.com/cilium/ebpf/btf"
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/pkg/ksyms"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/tracingpolicy/lint"
+)
+
+const (
+	lintOutputText = "text"
+	lintOutputJSON = "json"
+
+	defaultKernelBTF = "/sys/kernel/btf/vmlinux"
+)
+
+// fileDiagnostic is a diagnostic along with the file it was found in.
+type fileDiagnostic struct {
+	File string `json:"file"`
+	lint.Diagnostic
+}
+
+// lintOptions loads the kernel information used by the linter. Files given
+// explicitly must be readable, while default ones are skipped with a note
+// if they are not, for example when linting without privileges.
+func lintOptions(cmd *cobra.Command, btfPath, errInjPath string) (*lint.Options, error) {
+	opts := &lint.Options{}
+	if btfPath != "" {
+		spec, err := ebtf.LoadSpec(btfPath)
+		switch {
+		case err == nil:
+			opts.BTF = spec
+			if ks, err := ksyms.KernelSymbols(); err == nil {
+				opts.Ksyms = ks
+			}
+		case cmd.Flags().Changed("btf"):
+			return nil, fmt.Errorf("failed to load BTF from %s: %w", btfPath, err)
+		default:
+			cmd.PrintErrf("note: skipping BTF checks: %v\n", err)
+		}
+	}
+	if errInjPath != "" {
+		list, err := lint.ReadErrorInjectionList(errInjPath)
+		switch {
+		case err == nil:
+			opts.ErrorInjection = list
+		case cmd.Flags().Changed("error-injection-list"):
+			return nil, fmt.Errorf("failed to read error injection list %s: %w", errInjPath, err)
+		default:
+			cmd.PrintErrf("note: skipping error injection checks: %v\n", err)
+		}
+	}
+	return opts, nil
+}
+
+func tpLintCmd() *cobra.Command {
+	var (
+		btfPath    string
+		errInjPath string
+		output     string
+		smallProgs bool
+	)
+	ret := &cobra.Command{
+		Use:   "lint <yaml_file>...",
+		Short: "check tracing policy YAML files without loading them",
+		Long: `Check tracing policies without loading them. Policies are validated against
+the TracingPolicy schema, their selectors are parsed the way the agent does
+when loading them, kprobe hooks are checked against the kernel BTF, and
+functions using the Override action against the kernel error injection list.
+
+Diagnostics are reported with their position in the YAML file. The command
+exits with an error if any error is found, so it can be used in CI, where
+"-o json" provides machine-readable output and "--btf" the BTF of the target
+kernel.`,
+		Args: cobra.MinimumNArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if output != lintOutputText && output != lintOutputJSON {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Check selectors for the program size of the target kernel,
+			// instead of probing the local one.
+			option.Config.ForceSmallProgs = smallProgs
+			option.Config.ForceLargeProgs = !smallProgs
+
+			opts, err := lintOptions(cmd, btfPath, errInjPath)
+			if err != nil {
+				return err
+			}
+
+			diags := []fileDiagnostic{}
+			errs := 0
+			for _, file := range args {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read yaml file %s: %w", file, err)
+				}
+				for _, d := range lint.Lint(data, opts) {
+					if d.Severity == lint.SeverityError {
+						errs++
+					}
+					diags = append(diags, fileDiagnostic{File: file, Diagnostic: d})
+				}
+			}
+
+			w := cmd.OutOrStdout()
+			if output == lintOutputJSON {
+				enc := json.NewEncoder(w)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(diags); err != nil {
+					return err
+				}
+			} else {
+				for _, d := range diags {
+					fmt.Fprintf(w, "%s:%s\n", d.File, d.Diagnostic)
+				}
+			}
+			if errs > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%d errors found", errs)
+			}
+			return nil
+		},
+	}
+
+	flags := ret.Flags()
+	flags.StringVar(&btfPath, "btf", defaultKernelBTF, "BTF file of the target kernel, empty to skip BTF checks")
+	flags.StringVar(&errInjPath, "error-injection-list", lint.DefaultErrorInjectionList, "Error injection list of the target kernel, empty to skip the check")
+	flags.StringVarP(&output, common.KeyOutput, "o", lintOutputText, "Output format. text or json")
+	flags.BoolVar(&smallProgs, "small-programs", false, "Check selectors for kernels without large BPF programs support (before 5.3)")
+	return ret
+}
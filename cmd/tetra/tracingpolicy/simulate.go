@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracingpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/pkg/arch"
+	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+const (
+	simulateFormatAuto = "auto"
+	simulateOutputText = "text"
+	simulateOutputJSON = "json"
+)
+
+// simHook is a kprobe or tracepoint hook of the simulated policy.
+type simHook struct {
+	name      string
+	args      []v1alpha1.KProbeArg
+	selectors []v1alpha1.KProbeSelector
+}
+
+// simUndetermined is a selector that could not be evaluated against an event.
+type simUndetermined struct {
+	Selector int    `json:"selector"`
+	Reason   string `json:"reason"`
+}
+
+// simResult is the result of the evaluation of an event that matched a hook
+// of the policy.
+type simResult struct {
+	Time         string            `json:"time,omitempty"`
+	Hook         string            `json:"hook"`
+	Binary       string            `json:"binary,omitempty"`
+	PID          uint32            `json:"pid,omitempty"`
+	Matched      bool              `json:"matched"`
+	Selector     *int              `json:"selector,omitempty"`
+	Actions      []string          `json:"actions,omitempty"`
+	Undetermined []simUndetermined `json:"undetermined,omitempty"`
+	Error        string            `json:"error,omitempty"`
+}
+
+type simSummary struct {
+	Events       int `json:"events"`
+	HookEvents   int `json:"hook_events"`
+	Matched      int `json:"matched"`
+	NotEvaluable int `json:"not_evaluable"`
+}
+
+type simulator struct {
+	kprobes     map[string][]simHook
+	tracepoints map[string][]simHook
+	summary     simSummary
+}
+
+// normalizeCall returns the name used to match a kprobe call of a policy
+// with the function name of a recorded event, so that "sys_openat",
+// "__x64_sys_openat" and, for syscalls, "openat" are equivalent.
+func normalizeCall(call string) string {
+	_, name := arch.CutSyscallPrefix(call)
+	return strings.TrimPrefix(name, "sys_")
+}
+
+func newSimulator(spec *v1alpha1.TracingPolicySpec) (*simulator, error) {
+	s := &simulator{
+		kprobes:     map[string][]simHook{},
+		tracepoints: map[string][]simHook{},
+	}
+	lists := map[string]*v1alpha1.ListSpec{}
+	for i := range spec.Lists {
+		lists[spec.Lists[i].Name] = &spec.Lists[i]
+	}
+	for i := range spec.KProbes {
+		kp := &spec.KProbes[i]
+		calls := []string{kp.Call}
+		if name, ok := strings.CutPrefix(kp.Call, "list:"); ok {
+			list, ok := lists[name]
+			if !ok {
+				return nil, fmt.Errorf("kprobe %d: list %q not found", i, name)
+			}
+			if len(list.Values) == 0 {
+				return nil, fmt.Errorf("kprobe %d: list %q has no values, generated lists are not supported", i, name)
+			}
+			calls = list.Values
+		}
+		for _, call := range calls {
+			key := normalizeCall(call)
+			s.kprobes[key] = append(s.kprobes[key], simHook{name: call, args: kp.Args, selectors: kp.Selectors})
+		}
+	}
+	for i := range spec.Tracepoints {
+		tp := &spec.Tracepoints[i]
+		key := tp.Subsystem + "/" + tp.Event
+		s.tracepoints[key] = append(s.tracepoints[key], simHook{name: key, args: tp.Args, selectors: tp.Selectors})
+	}
+	if len(s.kprobes) == 0 && len(s.tracepoints) == 0 {
+		return nil, errors.New("policy has no kprobe or tracepoint hooks")
+	}
+	return s, nil
+}
+
+// evaluate returns the results of the hooks of the policy matching the event.
+func (s *simulator) evaluate(ev *tetragon.GetEventsResponse) []simResult {
+	var hooks []simHook
+	var rec *selectors.RecordedEvent
+	s.summary.Events++
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		hooks = s.kprobes[normalizeCall(e.ProcessKprobe.FunctionName)]
+		rec = selectors.RecordedKprobe(e.ProcessKprobe)
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		hooks = s.tracepoints[e.ProcessTracepoint.Subsys+"/"+e.ProcessTracepoint.Event]
+		rec = selectors.RecordedTracepoint(e.ProcessTracepoint)
+	default:
+		return nil
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+	s.summary.HookEvents++
+
+	var results []simResult
+	for _, hook := range hooks {
+		res := simResult{
+			Hook:   hook.name,
+			Binary: rec.Process.GetBinary(),
+			PID:    rec.Process.GetPid().GetValue(),
+		}
+		if ev.GetTime() != nil {
+			res.Time = ev.GetTime().AsTime().Format("2006-01-02T15:04:05.000000000Z07:00")
+		}
+		match, err := selectors.MatchSelectors(hook.selectors, hook.args, rec)
+		if err != nil {
+			res.Error = err.Error()
+			s.summary.NotEvaluable++
+			results = append(results, res)
+			continue
+		}
+		for _, u := range match.Undetermined {
+			res.Undetermined = append(res.Undetermined, simUndetermined{Selector: u.Index, Reason: u.Err.Error()})
+		}
+		switch {
+		case match.Matched:
+			res.Matched = true
+			res.Actions = []string{"Post"}
+			if idx := match.Selector; idx >= 0 {
+				res.Selector = &idx
+				if actions := hook.selectors[idx].MatchActions; len(actions) > 0 {
+					res.Actions = nil
+					for _, a := range actions {
+						res.Actions = append(res.Actions, a.Action)
+					}
+				}
+			}
+			s.summary.Matched++
+		case !match.Determined():
+			s.summary.NotEvaluable++
+		default:
+			continue
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func (s *simulator) run(r io.Reader, format string, out func(simResult) error) error {
+	dec, err := encoder.NewDecoder(r, format)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, res := range s.evaluate(ev) {
+			if err := out(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeTextResult(w io.Writer, res simResult) error {
+	var undetermined []string
+	for _, u := range res.Undetermined {
+		undetermined = append(undetermined, fmt.Sprintf("selector %d: %s", u.Selector, u.Reason))
+	}
+	var status string
+	switch {
+	case res.Error != "":
+		status = "error    " + res.Error
+	case !res.Matched:
+		status = "unknown  " + strings.Join(undetermined, "; ")
+	case res.Selector != nil:
+		status = fmt.Sprintf("matched  selector %d, actions %s", *res.Selector, strings.Join(res.Actions, ","))
+	default:
+		status = "matched  actions " + strings.Join(res.Actions, ",")
+	}
+	if res.Matched && len(undetermined) > 0 {
+		status += " (unless an earlier selector matched: " + strings.Join(undetermined, "; ") + ")"
+	}
+	_, err := fmt.Fprintf(w, "%s %s %s (pid %d): %s\n", res.Time, res.Hook, res.Binary, res.PID, status)
+	return err
+}
+
+func tpSimulateCmd() *cobra.Command {
+	var (
+		eventFiles  []string
+		inputFormat string
+		output      string
+	)
+	ret := &cobra.Command{
+		Use:   "simulate <yaml_file>",
+		Short: "evaluate the selectors of a tracing policy against recorded events",
+		Long: `Evaluate the selectors of the kprobe and tracepoint hooks of a tracing policy
+against recorded events, without loading the policy, and report the events that
+match along with the actions that would be taken.
+
+The recorded events must have been generated by a policy hooking the same
+functions with the same arguments. matchBinaries, matchArgs, matchPIDs,
+matchNamespaces and matchCapabilities are evaluated. Selectors that cannot be
+evaluated, for example because they use matchData or the event lacks namespace
+information, are skipped: events are reported as unknown if no other selector
+matches them, and matched events list the skipped selectors that precede the
+matching one, since their actions would apply instead if they matched.
+
+  # Check which recorded events a new policy would kill
+  tetra tracingpolicy simulate policy.yaml --events /var/log/tetragon/tetragon.log`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if inputFormat != simulateFormatAuto {
+				if err := encoder.ValidateFormat(inputFormat); err != nil {
+					return fmt.Errorf("invalid value for %q flag: %w", "input-format", err)
+				}
+			}
+			if output != simulateOutputText && output != simulateOutputJSON {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tp, err := tracingpolicy.FromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", args[0], err)
+			}
+			sim, err := newSimulator(tp.TpSpec())
+			if err != nil {
+				return fmt.Errorf("failed to simulate %s: %w", args[0], err)
+			}
+
+			w := cmd.OutOrStdout()
+			jsonEnc := json.NewEncoder(w)
+			out := func(res simResult) error {
+				if output == simulateOutputJSON {
+					return jsonEnc.Encode(res)
+				}
+				return writeTextResult(w, res)
+			}
+			for _, file := range eventFiles {
+				var r io.Reader = cmd.InOrStdin()
+				format := inputFormat
+				if file != "-" {
+					f, err := os.Open(file)
+					if err != nil {
+						return err
+					}
+					defer f.Close()
+					r = f
+					if format == simulateFormatAuto {
+						format = encoder.FormatFromFilename(file)
+					}
+				} else if format == simulateFormatAuto {
+					format = encoder.FormatJSON
+				}
+				if err := sim.run(r, format, out); err != nil {
+					return fmt.Errorf("failed to read %s: %w", file, err)
+				}
+			}
+
+			if output == simulateOutputJSON {
+				return jsonEnc.Encode(map[string]simSummary{"summary": sim.summary})
+			}
+			_, err = fmt.Fprintf(w, "%d events, %d for the hooks of the policy: %d matched, %d could not be evaluated\n",
+				sim.summary.Events, sim.summary.HookEvents, sim.summary.Matched, sim.summary.NotEvaluable)
+			return err
+		},
+	}
+
+	flags := ret.Flags()
+	flags.StringSliceVar(&eventFiles, "events", nil, "Export files to read events from, \"-\" for the standard input")
+	flags.StringVar(&inputFormat, "input-format", simulateFormatAuto, "Format of the event files. auto (from the file extension), json, protobuf or cbor")
+	flags.StringVarP(&output, common.KeyOutput, "o", simulateOutputText, "Output format. text or json")
+	ret.MarkFlagRequired("events")
+	return ret
+}
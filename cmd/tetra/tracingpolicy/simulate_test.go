@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracingpolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const simulatePolicy = `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "sensitive-files"
+spec:
+  kprobes:
+  - call: "sys_openat"
+    syscall: true
+    args:
+    - index: 0
+      type: int
+    - index: 1
+      type: "string"
+    selectors:
+    - matchBinaries:
+      - operator: "In"
+        values:
+        - "/usr/bin/vi"
+      matchData:
+      - index: 0
+        operator: "Equal"
+        values:
+        - "1"
+    - matchBinaries:
+      - operator: "In"
+        values:
+        - "/usr/bin/cat"
+      matchArgs:
+      - index: 1
+        operator: "Prefix"
+        values:
+        - "/etc/shadow"
+      matchActions:
+      - action: Sigkill
+    - matchArgs:
+      - index: 1
+        operator: "Prefix"
+        values:
+        - "/etc/"
+`
+
+const simulateEvents = `{"process_kprobe":{"process":{"pid":10,"binary":"/usr/bin/cat"},"function_name":"__x64_sys_openat","args":[{"int_arg":-100},{"string_arg":"/etc/shadow"}]},"time":"2024-01-01T00:00:00Z"}
+{"process_kprobe":{"process":{"pid":11,"binary":"/usr/bin/vi"},"function_name":"__x64_sys_openat","args":[{"int_arg":-100},{"string_arg":"/etc/shadow"}]},"time":"2024-01-01T00:00:01Z"}
+{"process_kprobe":{"process":{"pid":12,"binary":"/usr/bin/cat"},"function_name":"__x64_sys_write","args":[{"int_arg":1}]},"time":"2024-01-01T00:00:02Z"}
+{"process_exec":{"process":{"pid":13,"binary":"/usr/bin/cat"}},"time":"2024-01-01T00:00:03Z"}
+{"process_kprobe":{"process":{"pid":14,"binary":"/usr/bin/vi"},"function_name":"__x64_sys_openat","args":[{"int_arg":-100},{"string_arg":"/tmp/x"}]},"time":"2024-01-01T00:00:04Z"}
+`
+
+func simulate(t *testing.T, args ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	policy := filepath.Join(dir, "policy.yaml")
+	events := filepath.Join(dir, "events.json")
+	require.NoError(t, os.WriteFile(policy, []byte(simulatePolicy), 0o644))
+	require.NoError(t, os.WriteFile(events, []byte(simulateEvents), 0o644))
+
+	cmd := tpSimulateCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(append([]string{policy, "--events", events}, args...))
+	require.NoError(t, cmd.Execute())
+	return out.String()
+}
+
+func TestSimulateText(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(simulate(t)), "\n")
+	require.Len(t, lines, 4)
+	// matchBinaries rules out the first selector, whatever matchData gives
+	assert.Contains(t, lines[0], "sys_openat /usr/bin/cat (pid 10): matched  selector 1, actions Sigkill")
+	assert.NotContains(t, lines[0], "unless")
+	// the first selector cannot be evaluated for vi, but the third one matches
+	assert.Contains(t, lines[1], "sys_openat /usr/bin/vi (pid 11): matched  selector 2, actions Post (unless an earlier selector matched: selector 0:")
+	assert.Contains(t, lines[2], "sys_openat /usr/bin/vi (pid 14): unknown  selector 0:")
+	assert.Equal(t, "5 events, 3 for the hooks of the policy: 2 matched, 1 could not be evaluated", lines[3])
+}
+
+func TestSimulateJSON(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(simulate(t, "-o", "json")))
+	var res simResult
+	require.NoError(t, dec.Decode(&res))
+	assert.True(t, res.Matched)
+	assert.Equal(t, uint32(10), res.PID)
+	require.NotNil(t, res.Selector)
+	assert.Equal(t, 1, *res.Selector)
+	assert.Equal(t, []string{"Sigkill"}, res.Actions)
+	assert.Empty(t, res.Undetermined)
+
+	res = simResult{}
+	require.NoError(t, dec.Decode(&res))
+	assert.True(t, res.Matched)
+	require.NotNil(t, res.Selector)
+	assert.Equal(t, 2, *res.Selector)
+	require.Len(t, res.Undetermined, 1)
+	assert.Equal(t, 0, res.Undetermined[0].Selector)
+
+	res = simResult{}
+	require.NoError(t, dec.Decode(&res))
+	assert.False(t, res.Matched)
+	assert.Empty(t, res.Error)
+	require.Len(t, res.Undetermined, 1)
+	assert.Contains(t, res.Undetermined[0].Reason, "matchData")
+
+	var summary map[string]simSummary
+	require.NoError(t, dec.Decode(&summary))
+	assert.Equal(t, simSummary{Events: 5, HookEvents: 3, Matched: 2, NotEvaluable: 1}, summary["summary"])
+}
@@ -107,6 +107,53 @@ func tpAddCmd() *cobra.Command {
 	return ret
 }
 
+func tpUpdateCmd() *cobra.Command {
+	var mode string
+	ret := &cobra.Command{
+		Use:   "update <yaml_file>",
+		Short: "update a loaded tracing policy",
+		Long: `Replace a loaded tracing policy with a new version of it, with the same name.
+
+If only the selectors of the policy changed, its programs remain attached while
+the selectors are updated. Otherwise, the new version is loaded before the
+previous one is unloaded. If the new version fails to load, the previous one is
+kept.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			yamlb, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read yaml file %s: %w", args[0], err)
+			}
+
+			if mode != "" {
+				yamlb, err = tracingpolicy.PolicyYAMLSetMode(yamlb, mode)
+				if err != nil {
+					return fmt.Errorf("failed to apply mode %q to yaml file %s: %w", mode, args[0], err)
+				}
+			}
+
+			_, err = c.Client.UpdateTracingPolicy(c.Ctx, &tetragon.UpdateTracingPolicyRequest{
+				Yaml: string(yamlb),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update tracing policy: %w", err)
+			}
+			cmd.Printf("tracing policy %q updated\n", args[0])
+
+			return nil
+		},
+	}
+	flags := ret.Flags()
+	flags.StringVarP(&mode, "mode", "m", "", "Tracing policy mode (enforce|monitor)")
+	return ret
+}
+
 func tpDelCmd() *cobra.Command {
 	var namespace string
 	ret := &cobra.Command{
@@ -270,11 +317,14 @@ func New() *cobra.Command {
 	tpCmd.AddCommand(
 		tpModifyCmd(),
 		tpAddCmd(),
+		tpUpdateCmd(),
 		tpDelCmd(),
 		tpEnableCmd(),
 		tpDisableCmd(),
 		tpListCmd(),
 		tpSetModeCmd(),
+		tpSimulateCmd(),
+		tpLintCmd(),
 		generate.New(),
 	)
 
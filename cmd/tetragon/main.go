@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
 	"net"
@@ -31,6 +32,8 @@ import (
 	"github.com/cilium/tetragon/pkg/bugtool"
 	"github.com/cilium/tetragon/pkg/cgrouprate"
 	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/diskbuffer"
+	"github.com/cilium/tetragon/pkg/dnscache"
 	"github.com/cilium/tetragon/pkg/encoder"
 	"github.com/cilium/tetragon/pkg/exporter"
 	"github.com/cilium/tetragon/pkg/fieldfilters"
@@ -69,6 +72,7 @@ import (
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"sigs.k8s.io/yaml"
 
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 )
@@ -428,12 +432,32 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 				}
 			}
 			podAccessor = controllerManager
+			if option.Config.EnableDestinationEnrichment {
+				err = controllerManager.AddDestinationInformers(ctx, option.Config.EnablePodInfo)
+				if err != nil {
+					return err
+				}
+			}
 			k8sNode, err := controllerManager.GetNode()
 			if err != nil {
 				log.Warn("Failed to get local Kubernetes node info. node_labels field will be empty", logfields.Error, err)
 			} else {
 				node.SetNodeLabels(k8sNode.Labels)
 			}
+			// Policies with a node selector are loaded or unloaded when
+			// the labels of the node change.
+			err = controllerManager.WatchNodeLabels(ctx, func(labels map[string]string) {
+				if !node.SetNodeLabels(labels) {
+					return
+				}
+				log.Info("Node labels changed, updating tracing policies with a node selector")
+				if err := observer.GetSensorManager().NodeLabelsChanged(ctx); err != nil {
+					log.Warn("Failed to update tracing policies after a node labels change", logfields.Error, err)
+				}
+			})
+			if err != nil {
+				log.Warn("Failed to watch local Kubernetes node, node labels will not be updated", logfields.Error, err)
+			}
 		} else {
 			podAccessor = watcher.NewFakeK8sWatcher(nil)
 		}
@@ -441,6 +465,9 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 		log.Info("Disabling Kubernetes API")
 		podAccessor = watcher.NewFakeK8sWatcher(nil)
 	}
+	if option.Config.EnableDestinationEnrichment && !option.InClusterControlPlaneEnabled() {
+		log.Warn("Destination enrichment requires the in-cluster Kubernetes API, network arguments will not be enriched")
+	}
 
 	pcGCInterval := option.Config.ProcessCacheGCInterval
 	if pcGCInterval <= 0 {
@@ -473,6 +500,12 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 		return err
 	}
 
+	if option.Config.EnablePassiveDNSCache {
+		if err := dnscache.Start(ctx, option.Config.PassiveDNSCacheSize); err != nil {
+			return fmt.Errorf("failed to start passive DNS cache: %w", err)
+		}
+	}
+
 	// Load initial sensor before we start the server,
 	// so it's there before we allow to load policies.
 	if err = loadInitialSensor(ctx); err != nil {
@@ -495,11 +528,25 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if err = Serve(ctx, option.Config.ServerAddress, pm.Server); err != nil {
 		return err
 	}
+	if option.Config.ExportBufferDirectory != "" && option.Config.ExportFilename == "" {
+		return fmt.Errorf("%s requires %s, use the buffer option of %s for other exporters",
+			option.KeyExportBufferDirectory, option.KeyExportFilename, option.KeyExporters)
+	}
+	namedExporters, err := getNamedExporterConfigs()
+	if err != nil {
+		return err
+	}
 	if option.Config.ExportFilename != "" {
 		if err = startExporter(ctx, pm.Server); err != nil {
 			return err
 		}
 	}
+	if err = startSinkExporters(ctx, pm.Server); err != nil {
+		return err
+	}
+	if err = startNamedExporters(ctx, pm.Server, namedExporters); err != nil {
+		return err
+	}
 
 	if option.Config.HealthServerAddress != "" {
 		health.StartHealthServer(ctx, option.Config.HealthServerAddress, option.Config.HealthServerInterval)
@@ -526,6 +573,16 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if err = procevents.GetRunningProcs(); err != nil {
 		return err
 	}
+	if option.Config.ProcessCacheCheckpointFile != "" {
+		procevents.StartCheckpoints(ctx, option.Config.ProcessCacheCheckpointInterval)
+		// deferred after the sensors removal so that it runs first, while
+		// the execve map is still pinned
+		defer func() {
+			if err := procevents.WriteCheckpoint(); err != nil {
+				log.Warn("Failed to checkpoint the process cache", logfields.Error, err)
+			}
+		}()
+	}
 
 	if err := cgrouprate.NewCgroupRate(ctx, pm, &option.Config.CgroupRate); err != nil {
 		return err
@@ -665,52 +722,46 @@ func getObserverDir() string {
 	return bpf.MapPrefixPath()
 }
 
-func startExporter(ctx context.Context, server *server.Server) error {
-	allowList, denyList, err := getExportFilters()
-	if err != nil {
-		return err
-	}
-	fieldFilters, err := getFieldFilters()
-	if err != nil {
-		return err
-	}
+// newExportFileWriter creates the rotating writer of a JSON export file and,
+// if rotationInterval is positive, starts rotating it periodically.
+func newExportFileWriter(ctx context.Context, cfg *exporter.FileConfig, rotationInterval time.Duration) (*lumberjack.Logger, error) {
 	writer := &lumberjack.Logger{
-		Filename:   option.Config.ExportFilename,
-		MaxSize:    option.Config.ExportFileMaxSizeMB,
-		MaxBackups: option.Config.ExportFileMaxBackups,
-		Compress:   option.Config.ExportFileCompress,
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		Compress:   cfg.Compress,
 	}
 
-	perms, err := fileutils.RegularFilePerms(option.Config.ExportFilePerm)
+	perms, err := fileutils.RegularFilePerms(cfg.Perm)
 	if err != nil {
 		log.Warn(fmt.Sprintf("Failed to parse export file permission '%s', failing back to %v",
-			option.KeyExportFilePerm, perms), logfields.Error, err)
+			cfg.Perm, perms), logfields.Error, err)
 	}
 	writer.FileMode = perms
 
-	finfo, err := os.Stat(filepath.Clean(option.Config.ExportFilename))
+	finfo, err := os.Stat(filepath.Clean(cfg.Filename))
 	if err == nil && finfo.IsDir() {
 		// Error if exportFilename points to a directory
-		return errors.New("passed export JSON logs file point to a directory")
+		return nil, errors.New("passed export JSON logs file point to a directory")
 	}
-	logFile := filepath.Base(option.Config.ExportFilename)
-	logsDir, err := filepath.Abs(filepath.Dir(filepath.Clean(option.Config.ExportFilename)))
+	logFile := filepath.Base(cfg.Filename)
+	logsDir, err := filepath.Abs(filepath.Dir(filepath.Clean(cfg.Filename)))
 	if err != nil {
-		log.Warn(fmt.Sprintf("Failed to get absolute path of exported JSON logs '%s'", option.Config.ExportFilename), logfields.Error, err)
+		log.Warn(fmt.Sprintf("Failed to get absolute path of exported JSON logs '%s'", cfg.Filename), logfields.Error, err)
 		// Do not fail; we let lumberjack handle this. We want to
 		// log the rotate logs operation.
-		logsDir = filepath.Dir(option.Config.ExportFilename)
+		logsDir = filepath.Dir(cfg.Filename)
 	}
 
-	if option.Config.ExportFileRotationInterval < 0 {
+	if rotationInterval < 0 {
 		// Passed an invalid interval let's error out
-		return fmt.Errorf("frequency '%s' at which to rotate JSON export files is negative", option.Config.ExportFileRotationInterval.String())
-	} else if option.Config.ExportFileRotationInterval > 0 {
+		return nil, fmt.Errorf("frequency '%s' at which to rotate JSON export files is negative", rotationInterval.String())
+	} else if rotationInterval > 0 {
 		log.Info("Periodically rotating JSON export files",
 			"directory", logsDir,
-			"frequency", option.Config.ExportFileRotationInterval.String())
+			"frequency", rotationInterval.String())
 		go func() {
-			ticker := time.NewTicker(option.Config.ExportFileRotationInterval)
+			ticker := time.NewTicker(rotationInterval)
 			for {
 				select {
 				case <-ctx.Done():
@@ -718,16 +769,41 @@ func startExporter(ctx context.Context, server *server.Server) error {
 				case <-ticker.C:
 					log.Info("Rotating JSON logs export", "file", logFile, "directory", logsDir)
 					if rotationErr := writer.Rotate(); rotationErr != nil {
-						log.Warn("Failed to rotate JSON export file", "file", option.Config.ExportFilename, logfields.Error, rotationErr)
+						log.Warn("Failed to rotate JSON export file", "file", cfg.Filename, logfields.Error, rotationErr)
 					}
 				}
 			}
 		}()
 	}
+	return writer, nil
+}
+
+func startExporter(ctx context.Context, server *server.Server) error {
+	allowList, denyList, err := getExportFilters()
+	if err != nil {
+		return err
+	}
+	fieldFilters, err := getFieldFilters()
+	if err != nil {
+		return err
+	}
+	writer, err := newExportFileWriter(ctx, &exporter.FileConfig{
+		Filename:   option.Config.ExportFilename,
+		MaxSizeMB:  option.Config.ExportFileMaxSizeMB,
+		MaxBackups: option.Config.ExportFileMaxBackups,
+		Compress:   option.Config.ExportFileCompress,
+		Perm:       option.Config.ExportFilePerm,
+	}, option.Config.ExportFileRotationInterval)
+	if err != nil {
+		return err
+	}
 
 	// Track how many bytes are written to the event export location
 	encoderWriter := exporter.NewExportedBytesTotalWriter(writer)
-	encoder := encoder.NewProtojsonEncoder(encoderWriter)
+	encoder, err := encoder.NewEncoder(encoderWriter, option.Config.ExportFileFormat)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", option.KeyExportFileFormat, err)
+	}
 	var rateLimiter *ratelimit.RateLimiter
 	if option.Config.ExportRateLimit >= 0 {
 		rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, option.Config.ExportRateLimit, encoder)
@@ -741,17 +817,176 @@ func startExporter(ctx context.Context, server *server.Server) error {
 	}
 	req := tetragon.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions, FieldFilters: fieldFilters}
 	log.Info("Configured field filters", "fieldFilters", fieldFilters)
-	log.Info("Starting JSON exporter", "logger", writer, "request", &req)
+	var buffer *diskbuffer.Queue
+	if option.Config.ExportBufferDirectory != "" {
+		buffer, err = exporter.OpenExportBuffer(option.Config.ExportBufferDirectory,
+			option.Config.ExportBufferMaxSizeMB, option.Config.ExportBufferMaxAge)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", option.KeyExportBufferDirectory, err)
+		}
+	}
+	log.Info("Starting JSON exporter", "logger", writer, "format", option.Config.ExportFileFormat, "request", &req)
 	exporter := exporter.NewExporter(ctx, &req, server, encoder, writer, rateLimiter)
+	if buffer != nil {
+		exporter.WithDiskBuffer(buffer)
+	}
 	return exporter.Start()
 }
 
+func startSinkExporters(ctx context.Context, server *server.Server) error {
+	sinksConfig := viper.GetString(option.KeyExportSinks)
+	if sinksConfig == "" {
+		return nil
+	}
+	configs, err := exporter.ParseSinkConfigs(sinksConfig)
+	if err != nil {
+		return err
+	}
+	for i := range configs {
+		cfg := &configs[i]
+		req, err := cfg.Request(viper.GetBool(option.KeyEnablePidSetFilter))
+		if err != nil {
+			return err
+		}
+		opts, err := cfg.Options()
+		if err != nil {
+			return err
+		}
+		redaction, err := cfg.Redaction()
+		if err != nil {
+			return fmt.Errorf("export sink %q: %w", cfg.Name, err)
+		}
+		sink, err := exporter.NewSink(cfg)
+		if err != nil {
+			return err
+		}
+		encoder := exporter.NewSinkEncoder(ctx, sink, opts)
+		log.Info("Starting export sink", "sink", cfg.Name, "type", cfg.Type, "endpoint", cfg.Endpoint, "request", req)
+		exp := exporter.NewExporter(ctx, req, server, encoder, encoder, nil).WithRedactionFilters(redaction)
+		if err := exp.Start(); err != nil {
+			encoder.Close()
+			return fmt.Errorf("failed to start export sink %q: %w", cfg.Name, err)
+		}
+	}
+	return nil
+}
+
+// getExportersConfig returns the named exporters configuration as YAML. The
+// configuration is a string when set on the command line or in a config-dir
+// file, and a list when set in a YAML configuration file.
+func getExportersConfig() (string, error) {
+	switch v := viper.Get(option.KeyExporters).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s configuration: %w", option.KeyExporters, err)
+		}
+		return string(data), nil
+	}
+}
+
+// getNamedExporterConfigs parses and validates the named exporters, before
+// any exporter is started.
+func getNamedExporterConfigs() ([]exporter.ExporterConfig, error) {
+	exportersConfig, err := getExportersConfig()
+	if err != nil || exportersConfig == "" {
+		return nil, err
+	}
+	configs, err := exporter.ParseExporterConfigs(exportersConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := exporter.CheckExportFilename(configs, option.Config.ExportFilename); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", option.KeyExporters, err)
+	}
+	if err := exporter.CheckExportBufferDirectory(configs, option.Config.ExportBufferDirectory); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", option.KeyExporters, err)
+	}
+	return configs, nil
+}
+
+func startNamedExporters(ctx context.Context, server *server.Server, configs []exporter.ExporterConfig) error {
+	for i := range configs {
+		cfg := &configs[i]
+		req, err := cfg.Request(viper.GetBool(option.KeyEnablePidSetFilter))
+		if err != nil {
+			return err
+		}
+		redaction, err := cfg.Redaction()
+		if err != nil {
+			return fmt.Errorf("exporter %q: %w", cfg.Name, err)
+		}
+
+		var enc exporter.ExportEncoder
+		var closer io.Closer
+		if cfg.File != nil {
+			writer, err := newExportFileWriter(ctx, cfg.File, cfg.File.RotationIntervalDuration())
+			if err != nil {
+				return fmt.Errorf("exporter %q: %w", cfg.Name, err)
+			}
+			enc, err = encoder.NewEncoder(exporter.NewNamedExportedBytesTotalWriter(writer, cfg.Name), cfg.File.Format)
+			if err != nil {
+				return fmt.Errorf("exporter %q: %w", cfg.Name, err)
+			}
+			closer = writer
+		} else {
+			opts, err := cfg.Sink.Options()
+			if err != nil {
+				return err
+			}
+			opts.Blocking = cfg.Buffer != nil
+			opts.Exporter = cfg.Name
+			sink, err := exporter.NewSink(cfg.Sink)
+			if err != nil {
+				return err
+			}
+			sinkEncoder := exporter.NewSinkEncoder(ctx, sink, opts)
+			enc, closer = sinkEncoder, sinkEncoder
+		}
+
+		var rateLimiter *ratelimit.RateLimiter
+		if cfg.RateLimit > 0 {
+			rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, cfg.RateLimit, enc)
+		}
+		buffer, err := cfg.OpenBuffer()
+		if err != nil {
+			closer.Close()
+			return err
+		}
+		log.Info("Starting exporter", "exporter", cfg.Name, "request", req)
+		exp := exporter.NewNamedExporter(ctx, cfg.Name, req, server, enc, closer, rateLimiter).WithRedactionFilters(redaction)
+		if buffer != nil {
+			exp.WithDiskBuffer(buffer)
+		}
+		if err := exp.Start(); err != nil {
+			closer.Close()
+			return err
+		}
+	}
+	return nil
+}
+
 func Serve(ctx context.Context, listenAddr string, srv *server.Server) error {
 	// we use an empty listen address to effectively disable the gRPC server
 	if len(listenAddr) == 0 {
 		return nil
 	}
-	grpcServer := grpc.NewServer()
+	opts, err := server.ServerOptions(server.TLSConfig{
+		CertFile:      option.Config.ServerTLSCertFile,
+		KeyFile:       option.Config.ServerTLSKeyFile,
+		ClientCAFiles: option.Config.ServerTLSClientCAFiles,
+	}, server.AuthzConfig{
+		AdminIdentities:    option.Config.ServerAdminIdentities,
+		ReadOnlyIdentities: option.Config.ServerReadOnlyIdentities,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure gRPC server: %w", err)
+	}
+	grpcServer := grpc.NewServer(opts...)
 	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, srv)
 	proto, addr, err := server.SplitListenAddr(listenAddr)
 	if err != nil {
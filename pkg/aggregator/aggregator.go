@@ -4,10 +4,13 @@
 package aggregator
 
 import (
+	"context"
 	"sort"
 	"strings"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
@@ -18,6 +21,9 @@ type Aggregator struct {
 	window time.Duration
 	events chan *tetragon.GetEventsResponse
 	cache  map[string]*tetragon.GetEventsResponse
+	// keys keeps the insertion order of the cache so that aggregated
+	// responses are flushed in the order they were first observed.
+	keys []string
 }
 
 func NewAggregator(
@@ -32,44 +38,157 @@ func NewAggregator(
 		window = options.WindowSize.AsDuration()
 	}
 	return &Aggregator{
-		server,
-		window,
-		make(chan *tetragon.GetEventsResponse, options.ChannelBufferSize),
-		make(map[string]*tetragon.GetEventsResponse),
+		server: server,
+		window: window,
+		events: make(chan *tetragon.GetEventsResponse, options.ChannelBufferSize),
+		cache:  make(map[string]*tetragon.GetEventsResponse),
 	}, nil
 }
 
-func (a *Aggregator) Start() {
-	// nolint Since Aggregator.Start is an endless function,
-	// this qualifies as an acceptable use of time.Tick
-	tick := time.Tick(a.window)
+// Start aggregates incoming events and flushes them at the end of every
+// window until ctx is done, when the pending aggregates are flushed one last
+// time.
+func (a *Aggregator) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.window)
+	defer ticker.Stop()
 	for {
 		select {
 		case event := <-a.events:
 			a.handleEvent(event)
-		case <-tick:
+		case <-ticker.C:
+			a.flush()
+		case <-ctx.Done():
 			a.flush()
+			return
 		}
 	}
 }
 
 func (a *Aggregator) flush() {
-	for _, event := range a.cache {
-		if err := a.server.Send(event); err != nil {
+	for _, key := range a.keys {
+		if err := a.server.Send(a.cache[key]); err != nil {
 			logger.GetLogger().Warn("Failed to send aggregated response", logfields.Error, err)
 		}
 	}
 	// clear the cache.
 	a.cache = make(map[string]*tetragon.GetEventsResponse)
+	a.keys = nil
 }
 
 func (a *Aggregator) handleEvent(event *tetragon.GetEventsResponse) {
-	switch event.Event.(type) {
-	default:
+	key, ok := aggregationKey(event)
+	if !ok {
 		if err := a.server.Send(event); err != nil {
 			logger.GetLogger().Warn("Failed to send unaggregated response", logfields.Error, err)
 		}
+		return
+	}
+
+	if cached, ok := a.cache[key]; ok {
+		cached.AggregationInfo.Count++
+		cached.AggregationInfo.LastTime = event.Time
+		return
+	}
+
+	aggregated := proto.Clone(event).(*tetragon.GetEventsResponse)
+	aggregated.AggregationInfo = &tetragon.AggregationInfo{
+		Count:     1,
+		FirstTime: event.Time,
+		LastTime:  event.Time,
+	}
+	a.cache[key] = aggregated
+	a.keys = append(a.keys, key)
+}
+
+// aggregationKey returns the key under which an event is aggregated. Events
+// sharing the same policy, hook, binary, pod and (normalized) arguments are
+// aggregated together. The second return value is false for event types that
+// are not aggregated.
+func aggregationKey(event *tetragon.GetEventsResponse) (string, bool) {
+	var parts []string
+	switch ev := event.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		e := ev.ProcessKprobe
+		parts = []string{"kprobe", e.PolicyName, e.FunctionName, e.Action.String()}
+		parts = append(parts, processKey(e.Process)...)
+		parts = append(parts, argsKey(e.Args)...)
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		e := ev.ProcessTracepoint
+		parts = []string{"tracepoint", e.PolicyName, e.Subsys + "/" + e.Event, e.Action.String()}
+		parts = append(parts, processKey(e.Process)...)
+		parts = append(parts, argsKey(e.Args)...)
+	case *tetragon.GetEventsResponse_ProcessLsm:
+		e := ev.ProcessLsm
+		parts = []string{"lsm", e.PolicyName, e.FunctionName, e.Action.String()}
+		parts = append(parts, processKey(e.Process)...)
+		parts = append(parts, argsKey(e.Args)...)
+	case *tetragon.GetEventsResponse_ProcessUprobe:
+		e := ev.ProcessUprobe
+		parts = []string{"uprobe", e.PolicyName, e.Path + ":" + e.Symbol, e.Action.String()}
+		parts = append(parts, processKey(e.Process)...)
+		parts = append(parts, argsKey(e.Args)...)
+	default:
+		return "", false
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+func processKey(process *tetragon.Process) []string {
+	return []string{
+		process.GetBinary(),
+		process.GetPod().GetNamespace(),
+		process.GetPod().GetName(),
+	}
+}
+
+func argsKey(args []*tetragon.KprobeArgument) []string {
+	ret := make([]string, 0, len(args))
+	for _, arg := range args {
+		ret = append(ret, normalizeArg(arg))
+	}
+	return ret
+}
+
+// normalizeArg returns a stable representation of an argument, ignoring the
+// fields that are expected to differ between otherwise identical events
+// (ephemeral source ports, socket cookies, packet lengths, etc.). Addresses
+// with known names are replaced by their names.
+func normalizeArg(arg *tetragon.KprobeArgument) string {
+	if arg == nil {
+		return ""
+	}
+	arg = proto.Clone(arg).(*tetragon.KprobeArgument)
+	switch a := arg.Arg.(type) {
+	case *tetragon.KprobeArgument_SockArg:
+		if a.SockArg != nil {
+			a.SockArg.Sport = 0
+			a.SockArg.Cookie = 0
+			a.SockArg.State = ""
+			// connections to any address of the same names are aggregated
+			a.SockArg.Daddr = getNameOrIp(a.SockArg.Daddr, a.SockArg.Hostnames)
+			a.SockArg.Hostnames = nil
+		}
+	case *tetragon.KprobeArgument_SockaddrArg:
+		if a.SockaddrArg != nil {
+			a.SockaddrArg.Addr = getNameOrIp(a.SockaddrArg.Addr, a.SockaddrArg.Hostnames)
+			a.SockaddrArg.Hostnames = nil
+		}
+	case *tetragon.KprobeArgument_SkbArg:
+		if a.SkbArg != nil {
+			a.SkbArg.Sport = 0
+			a.SkbArg.Hash = 0
+			a.SkbArg.Len = 0
+		}
+	case *tetragon.KprobeArgument_TruncatedBytesArg:
+		if a.TruncatedBytesArg != nil {
+			a.TruncatedBytesArg.OrigSize = 0
+		}
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(arg)
+	if err != nil {
+		return arg.String()
 	}
+	return string(b)
 }
 
 func getNameOrIp(ip string, names []string) string {
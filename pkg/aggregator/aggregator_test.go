@@ -4,12 +4,220 @@
 package aggregator
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
+type fakeServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	mu     sync.Mutex
+	events []*tetragon.GetEventsResponse
+}
+
+func (s *fakeServer) Send(event *tetragon.GetEventsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *fakeServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServer) sent() []*tetragon.GetEventsResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*tetragon.GetEventsResponse(nil), s.events...)
+}
+
+func kprobeEvent(binary, function string, ts int64, args ...*tetragon.KprobeArgument) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process:      &tetragon.Process{Binary: binary, Pod: &tetragon.Pod{Namespace: "default", Name: "pod"}},
+				FunctionName: function,
+				PolicyName:   "policy",
+				Args:         args,
+			},
+		},
+		Time: timestamppb.New(time.Unix(ts, 0)),
+	}
+}
+
+func sockArg(sport uint32, cookie uint64) *tetragon.KprobeArgument {
+	return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+		Daddr:  "10.0.0.1",
+		Dport:  443,
+		Sport:  sport,
+		Cookie: cookie,
+	}}}
+}
+
 func Test_getNameOrIp(t *testing.T) {
 	assert.Equal(t, "1.1.1.1", getNameOrIp("1.1.1.1", []string{}))
 	assert.Equal(t, "a.com,b.com,c.com", getNameOrIp("1.1.1.1", []string{"b.com", "c.com", "a.com"}))
 }
+
+func TestAggregationKey(t *testing.T) {
+	k1, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_connect", 1, sockArg(40000, 1)))
+	require.True(t, ok)
+	k2, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_connect", 2, sockArg(40001, 2)))
+	require.True(t, ok)
+	assert.Equal(t, k1, k2, "ephemeral socket fields must not be part of the key")
+
+	k3, ok := aggregationKey(kprobeEvent("/usr/bin/wget", "tcp_connect", 1, sockArg(40000, 1)))
+	require.True(t, ok)
+	assert.NotEqual(t, k1, k3)
+
+	k4, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_close", 1, sockArg(40000, 1)))
+	require.True(t, ok)
+	assert.NotEqual(t, k1, k4)
+
+	_, ok = aggregationKey(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{}},
+	})
+	assert.False(t, ok)
+}
+
+func TestAggregationKeyHostnames(t *testing.T) {
+	sock := func(daddr string, hostnames ...string) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+			Daddr:     daddr,
+			Dport:     443,
+			Hostnames: hostnames,
+		}}}
+	}
+	k1, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_connect", 1, sock("93.184.216.34", "example.com")))
+	require.True(t, ok)
+	k2, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_connect", 2, sock("93.184.216.35", "example.com")))
+	require.True(t, ok)
+	assert.Equal(t, k1, k2, "addresses of the same names must be aggregated")
+
+	k3, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "tcp_connect", 3, sock("93.184.216.35")))
+	require.True(t, ok)
+	assert.NotEqual(t, k1, k3)
+
+	sockaddr := func(addr string, hostnames ...string) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockaddrArg{SockaddrArg: &tetragon.KprobeSockaddr{
+			Addr:      addr,
+			Port:      443,
+			Hostnames: hostnames,
+		}}}
+	}
+	k4, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "security_socket_connect", 1, sockaddr("93.184.216.34", "b.com", "a.com")))
+	require.True(t, ok)
+	k5, ok := aggregationKey(kprobeEvent("/usr/bin/curl", "security_socket_connect", 2, sockaddr("93.184.216.35", "a.com", "b.com")))
+	require.True(t, ok)
+	assert.Equal(t, k4, k5)
+
+	// the aggregated event keeps the original arguments
+	arg := sock("93.184.216.34", "example.com")
+	normalizeArg(arg)
+	assert.Equal(t, "93.184.216.34", arg.GetSockArg().GetDaddr())
+	assert.Equal(t, []string{"example.com"}, arg.GetSockArg().GetHostnames())
+}
+
+func TestAggregatorFlush(t *testing.T) {
+	server := &fakeServer{ctx: context.Background()}
+	agg, err := NewAggregator(server, &tetragon.AggregationOptions{})
+	require.NoError(t, err)
+
+	agg.handleEvent(kprobeEvent("/usr/bin/curl", "tcp_connect", 1, sockArg(40000, 1)))
+	agg.handleEvent(kprobeEvent("/usr/bin/wget", "tcp_connect", 2))
+	agg.handleEvent(kprobeEvent("/usr/bin/curl", "tcp_connect", 3, sockArg(40001, 2)))
+	agg.handleEvent(kprobeEvent("/usr/bin/curl", "tcp_connect", 4, sockArg(40002, 3)))
+	exec := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{}},
+	}
+	agg.handleEvent(exec)
+
+	// Non-aggregated events are sent right away.
+	require.Len(t, server.sent(), 1)
+	assert.Same(t, exec, server.sent()[0])
+
+	agg.flush()
+	events := server.sent()[1:]
+	require.Len(t, events, 2)
+
+	curl := events[0]
+	assert.Equal(t, "/usr/bin/curl", curl.GetProcessKprobe().GetProcess().GetBinary())
+	assert.Equal(t, uint64(3), curl.GetAggregationInfo().GetCount())
+	assert.Equal(t, int64(1), curl.GetAggregationInfo().GetFirstTime().GetSeconds())
+	assert.Equal(t, int64(4), curl.GetAggregationInfo().GetLastTime().GetSeconds())
+	assert.Equal(t, int64(1), curl.GetTime().GetSeconds())
+
+	wget := events[1]
+	assert.Equal(t, "/usr/bin/wget", wget.GetProcessKprobe().GetProcess().GetBinary())
+	assert.Equal(t, uint64(1), wget.GetAggregationInfo().GetCount())
+
+	// The cache is empty after a flush.
+	agg.flush()
+	assert.Len(t, server.sent(), 3)
+}
+
+func TestAggregatorWindow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := &fakeServer{ctx: ctx}
+	window := 200 * time.Millisecond
+	agg, err := NewAggregator(server, &tetragon.AggregationOptions{
+		WindowSize:        durationpb.New(window),
+		ChannelBufferSize: 16,
+	})
+	require.NoError(t, err)
+	go agg.Start(ctx)
+
+	for i := range 5 {
+		agg.GetEventChannel() <- kprobeEvent("/usr/bin/curl", "tcp_connect", int64(i))
+	}
+	assert.Empty(t, server.sent(), "aggregated events must not be sent before the window ends")
+
+	require.Eventually(t, func() bool {
+		return len(server.sent()) == 1
+	}, 5*window, window/10)
+	assert.Equal(t, uint64(5), server.sent()[0].GetAggregationInfo().GetCount())
+
+	// Events received in the next window are counted separately.
+	agg.GetEventChannel() <- kprobeEvent("/usr/bin/curl", "tcp_connect", 10)
+	require.Eventually(t, func() bool {
+		return len(server.sent()) == 2
+	}, 5*window, window/10)
+	assert.Equal(t, uint64(1), server.sent()[1].GetAggregationInfo().GetCount())
+	assert.Equal(t, int64(10), server.sent()[1].GetAggregationInfo().GetFirstTime().GetSeconds())
+}
+
+func TestAggregatorStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &fakeServer{ctx: ctx}
+	agg, err := NewAggregator(server, &tetragon.AggregationOptions{
+		WindowSize: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		agg.Start(ctx)
+		close(done)
+	}()
+
+	// The channel is unbuffered, so both events are received before the
+	// context is canceled.
+	agg.GetEventChannel() <- kprobeEvent("/usr/bin/curl", "tcp_connect", 1)
+	agg.GetEventChannel() <- kprobeEvent("/usr/bin/curl", "tcp_connect", 2)
+	cancel()
+	<-done
+
+	require.Len(t, server.sent(), 1, "pending aggregates must be flushed when the context is done")
+	assert.Equal(t, uint64(2), server.sent()[0].GetAggregationInfo().GetCount())
+}
@@ -31,12 +31,13 @@ const (
 	MSG_OP_GENERIC_LSM        = 16
 
 	// MSG_OP_CLONE notifies user-space that a clone() event has occurred.
-	MSG_OP_CLONE        = 23
-	MSG_OP_DATA         = 24
-	MSG_OP_CGROUP       = 25
-	MSG_OP_LOADER       = 26
-	MSG_OP_THROTTLE     = 27
-	MSG_OP_GENERIC_USDT = 28
+	MSG_OP_CLONE           = 23
+	MSG_OP_DATA            = 24
+	MSG_OP_CGROUP          = 25
+	MSG_OP_LOADER          = 26
+	MSG_OP_THROTTLE        = 27
+	MSG_OP_GENERIC_USDT    = 28
+	MSG_OP_POLICY_THROTTLE = 29
 
 	// just for testing
 	MSG_OP_TEST = 254
@@ -80,6 +81,7 @@ var OpCodeStrings = map[OpCode]string{
 	MSG_OP_CGROUP:             "Cgroup",
 	MSG_OP_LOADER:             "Loader",
 	MSG_OP_THROTTLE:           "Throttle",
+	MSG_OP_POLICY_THROTTLE:    "PolicyThrottle",
 	MSG_OP_TEST:               "Test",
 }
 
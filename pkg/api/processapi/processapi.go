@@ -46,6 +46,7 @@ const (
 	MSG_COMMON_FLAG_IMA_HASH          = 0x8
 	MSG_COMMON_FLAG_PROCESS_NOT_FOUND = 0x10
 	MSG_COMMON_FLAG_ACTION_FAILED     = 0x20
+	MSG_COMMON_FLAG_SEQUENCE_STEP     = 0x40
 
 	BINARY_PATH_MAX_LEN = 256
 	MAX_ARG_LENGTH      = 256
@@ -58,6 +58,17 @@ type MsgGenericKprobe struct {
 	Tid           uint32 // The recorded TID that triggered the event
 	KernelStackID int64
 	UserStackID   int64
+	SampleRate    uint32 // Sampling rate of the post action, 0 if the event was not sampled
+	Pad           uint32
+}
+
+// MsgPolicyThrottle is sent when a policy goes over its event budget and when
+// it gets back under it.
+type MsgPolicyThrottle struct {
+	Common processapi.MsgCommon
+	FuncId uint64
+	HookOp uint32
+	Type   uint32
 }
 
 type MsgGenericKprobeArgPath struct {
@@ -655,7 +666,7 @@ type EventConfig struct {
 	ArgReturnAction int32                                            `align:"argreturnaction"`
 	PolicyID        uint32                                           `align:"policy_id"`
 	Flags           uint32                                           `align:"flags"`
-	Pad             uint32                                           `align:"pad"`
+	OmitArgs        uint32                                           `align:"omit_args"`
 	BTFArg          [EventConfigMaxArgs][MaxBTFArgDepth]ConfigBTFArg `align:"btf_arg"`
 	UsdtArg         [EventConfigMaxUsdtArgs]ConfigUsdtArg            `align:"usdt_arg"`
 	RegArg          [EventConfigMaxRegArgs]ConfigRegArg              `align:"reg_arg"`
@@ -19,4 +19,6 @@ type MsgGenericTracepoint struct {
 	Tid           uint32 // The recorded TID that triggered the event
 	KernelStackID int64
 	UserStackID   int64
+	SampleRate    uint32 // Sampling rate of the post action, 0 if the event was not sampled
+	Pad           uint32
 }
@@ -0,0 +1,546 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package diskbuffer implements a bounded, on-disk FIFO queue of events.
+//
+// Events are appended to segment files named after a monotonically
+// increasing id. Each record is the protobuf encoding of the event prefixed
+// by its length as a varint, which is the format of the protobuf export
+// encoder. Consumers acknowledge events once they are delivered, and the
+// position of the last acknowledged event is persisted in a cursor file, so
+// events that were not delivered before a restart are replayed (at least
+// once) when the queue is opened again. Whole segments are evicted, oldest
+// first, when the queue exceeds its size or age limits.
+package diskbuffer
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+const (
+	segmentSuffix = ".seg"
+	cursorFile    = "cursor"
+
+	DefaultMaxSize     = 1 << 30
+	DefaultSegmentSize = 16 << 20
+
+	// cursorFormat is the fixed width format of the cursor file, so that
+	// it can be overwritten in place.
+	cursorFormat = "%020d %020d\n"
+	// maxRecordSize bounds the size of a record so that a corrupted
+	// length cannot cause a huge allocation.
+	maxRecordSize = 64 << 20
+)
+
+// Eviction reasons passed to Options.OnEvict.
+const (
+	EvictSize    = "size"
+	EvictAge     = "age"
+	EvictCorrupt = "corrupt"
+)
+
+var ErrClosed = errors.New("disk buffer is closed")
+
+type Options struct {
+	// Dir is the directory holding the segments. It is created if it
+	// does not exist, and must not be shared between queues.
+	Dir string
+	// MaxSize is the maximum size of the queue in bytes. The oldest
+	// segments are evicted when it is exceeded. Defaults to 1GiB.
+	MaxSize int64
+	// MaxAge is the maximum age of buffered events. Segments whose last
+	// event is older are evicted. Zero means no age limit.
+	MaxAge time.Duration
+	// SegmentSize is the size at which a new segment is started. Defaults
+	// to 16MiB, or MaxSize/4 if smaller.
+	SegmentSize int64
+	// OnEvict, if set, is called with the number of unread events that
+	// were evicted and the reason (EvictSize, EvictAge or EvictCorrupt).
+	OnEvict func(events int, reason string)
+}
+
+type segment struct {
+	id        uint64
+	size      int64
+	events    int
+	lastWrite time.Time
+}
+
+// Queue is a bounded FIFO queue of events stored on disk. It is safe for
+// concurrent use by one producer and one consumer. Events returned by Pop
+// stay in the queue until they are acknowledged with Ack.
+type Queue struct {
+	opts Options
+
+	mu       sync.Mutex
+	closed   bool
+	segments []*segment
+	size     int64
+
+	writer *os.File
+	buf    []byte
+
+	// reader reads segments[0], starting at readOffset. readEvents is the
+	// number of events of segments[0] already returned by Pop.
+	reader     *bufio.Reader
+	readFile   *os.File
+	readOffset int64
+	readEvents int
+	// ackOffset and ackEvents are the position and number of events of
+	// segments[0] that were acknowledged. inflight holds the end offsets
+	// of the events returned by Pop and not acknowledged yet. Pop does not
+	// move past segments[0] until they are acknowledged, so that the
+	// segment is kept for a replay.
+	ackOffset int64
+	ackEvents int
+	inflight  []int64
+	// discard is the number of upcoming acknowledgements to ignore,
+	// because they are for events of evicted segments.
+	discard int
+
+	cursor *os.File
+	notify chan struct{}
+}
+
+// Open opens the queue stored in opts.Dir, creating it if needed. Events
+// left unconsumed by a previous instance are kept.
+func Open(opts Options) (*Queue, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("disk buffer directory must be set")
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+	opts.SegmentSize = min(opts.SegmentSize, max(opts.MaxSize/4, 1))
+	if err := os.MkdirAll(opts.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create disk buffer directory: %w", err)
+	}
+	q := &Queue{
+		opts:   opts,
+		notify: make(chan struct{}, 1),
+	}
+	cursor, err := os.OpenFile(filepath.Join(opts.Dir, cursorFile), os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open disk buffer cursor: %w", err)
+	}
+	q.cursor = cursor
+	if err := q.load(); err != nil {
+		q.closeFiles()
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *Queue) segmentPath(id uint64) string {
+	return filepath.Join(q.opts.Dir, fmt.Sprintf("%020d%s", id, segmentSuffix))
+}
+
+// load scans the existing segments, truncating a partially written last
+// record, and restores the read position from the cursor file.
+func (q *Queue) load() error {
+	entries, err := os.ReadDir(q.opts.Dir)
+	if err != nil {
+		return err
+	}
+	var ids []uint64
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), segmentSuffix)
+		if !ok {
+			continue
+		}
+		id, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	curID, curOffset, curOK := q.readCursor()
+	for _, id := range ids {
+		if curOK && id < curID {
+			// fully consumed before the restart
+			os.Remove(q.segmentPath(id))
+			continue
+		}
+		seg, err := q.scanSegment(id)
+		if err != nil {
+			return err
+		}
+		q.segments = append(q.segments, seg)
+		q.size += seg.size
+	}
+
+	if len(q.segments) > 0 && curOK && q.segments[0].id == curID {
+		if err := q.openReader(); err != nil {
+			return err
+		}
+		for q.readOffset < curOffset && q.readEvents < q.segments[0].events {
+			if _, err := q.readRecord(); err != nil {
+				return err
+			}
+		}
+		q.ackOffset, q.ackEvents = q.readOffset, q.readEvents
+	}
+	if len(q.segments) == 0 {
+		return q.newSegment(1)
+	}
+	return q.openWriter()
+}
+
+// scanSegment counts the records of a segment and truncates it after the last
+// complete record.
+func (q *Queue) scanSegment(id uint64) (*segment, error) {
+	path := q.segmentPath(id)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	seg := &segment{id: id, lastWrite: st.ModTime()}
+	r := bufio.NewReader(f)
+	for {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			break
+		}
+		if n > maxRecordSize {
+			break
+		}
+		if _, err := r.Discard(int(n)); err != nil {
+			break
+		}
+		seg.size += int64(protowire.SizeVarint(n)) + int64(n)
+		seg.events++
+	}
+	if seg.size < st.Size() {
+		if err := os.Truncate(path, seg.size); err != nil {
+			return nil, fmt.Errorf("failed to truncate corrupted disk buffer segment: %w", err)
+		}
+	}
+	return seg, nil
+}
+
+func (q *Queue) readCursor() (uint64, int64, bool) {
+	var id uint64
+	var offset int64
+	if _, err := fmt.Fscanf(io.NewSectionReader(q.cursor, 0, 64), "%d %d", &id, &offset); err != nil {
+		return 0, 0, false
+	}
+	return id, offset, true
+}
+
+// syncCursor persists the acknowledged position. The cursor has a fixed size
+// and is overwritten with a single write.
+func (q *Queue) syncCursor() error {
+	if len(q.segments) == 0 {
+		return nil
+	}
+	data := fmt.Sprintf(cursorFormat, q.segments[0].id, q.ackOffset)
+	if _, err := q.cursor.WriteAt([]byte(data), 0); err != nil {
+		return fmt.Errorf("failed to write disk buffer cursor: %w", err)
+	}
+	return nil
+}
+
+func (q *Queue) newSegment(id uint64) error {
+	q.segments = append(q.segments, &segment{id: id, lastWrite: time.Now()})
+	return q.openWriter()
+}
+
+func (q *Queue) openWriter() error {
+	if q.writer != nil {
+		q.writer.Close()
+	}
+	last := q.segments[len(q.segments)-1]
+	f, err := os.OpenFile(q.segmentPath(last.id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open disk buffer segment: %w", err)
+	}
+	q.writer = f
+	return nil
+}
+
+func (q *Queue) openReader() error {
+	f, err := os.Open(q.segmentPath(q.segments[0].id))
+	if err != nil {
+		return fmt.Errorf("failed to open disk buffer segment: %w", err)
+	}
+	q.readFile = f
+	q.reader = bufio.NewReader(f)
+	q.readOffset = 0
+	q.readEvents = 0
+	return nil
+}
+
+func (q *Queue) closeReader() {
+	if q.readFile != nil {
+		q.readFile.Close()
+	}
+	q.readFile, q.reader = nil, nil
+	q.readOffset, q.readEvents = 0, 0
+	q.ackOffset, q.ackEvents = 0, 0
+	q.discard += len(q.inflight)
+	q.inflight = nil
+}
+
+func (q *Queue) closeFiles() {
+	q.closeReader()
+	if q.writer != nil {
+		q.writer.Close()
+		q.writer = nil
+	}
+	if q.cursor != nil {
+		q.cursor.Close()
+		q.cursor = nil
+	}
+}
+
+// dropFirstSegment removes the oldest segment, returning its number of
+// unread events. Events of the segment that are in flight are not counted,
+// and their acknowledgements are ignored.
+func (q *Queue) dropFirstSegment() int {
+	seg := q.segments[0]
+	unread := seg.events
+	if q.reader != nil {
+		unread -= q.readEvents
+	}
+	q.closeReader()
+	q.segments = q.segments[1:]
+	q.size -= seg.size
+	os.Remove(q.segmentPath(seg.id))
+	if len(q.segments) == 0 {
+		q.newSegment(seg.id + 1)
+	}
+	q.syncCursor()
+	return unread
+}
+
+func (q *Queue) evict(reason string, unread int) {
+	if unread > 0 && q.opts.OnEvict != nil {
+		q.opts.OnEvict(unread, reason)
+	}
+}
+
+// evictOversize evicts the oldest segments while the queue is over its size
+// limit. The segment being written is never evicted.
+func (q *Queue) evictOversize() {
+	for len(q.segments) > 1 && q.size > q.opts.MaxSize {
+		q.evict(EvictSize, q.dropFirstSegment())
+	}
+}
+
+// evictExpired evicts the oldest segments while their last event is older than
+// the age limit.
+func (q *Queue) evictExpired(now time.Time) {
+	if q.opts.MaxAge <= 0 {
+		return
+	}
+	for q.segments[0].events > 0 && now.Sub(q.segments[0].lastWrite) > q.opts.MaxAge {
+		q.evict(EvictAge, q.dropFirstSegment())
+	}
+}
+
+// Push appends an event to the queue.
+func (q *Queue) Push(ev *tetragon.GetEventsResponse) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	size := proto.Size(ev)
+	buf := protowire.AppendVarint(q.buf[:0], uint64(size))
+	buf, err := proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(buf, ev)
+	if err != nil {
+		return err
+	}
+	q.buf = buf
+
+	now := time.Now()
+	q.evictExpired(now)
+	last := q.segments[len(q.segments)-1]
+	if last.size > 0 && last.size+int64(len(buf)) > q.opts.SegmentSize {
+		if err := q.newSegment(last.id + 1); err != nil {
+			return err
+		}
+		last = q.segments[len(q.segments)-1]
+	}
+	if _, err := q.writer.Write(buf); err != nil {
+		return fmt.Errorf("failed to write to disk buffer: %w", err)
+	}
+	last.size += int64(len(buf))
+	last.events++
+	last.lastWrite = now
+	q.size += int64(len(buf))
+	q.evictOversize()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// readRecord reads the next record of segments[0]. The caller must make sure
+// that the segment has unread events.
+func (q *Queue) readRecord() (*tetragon.GetEventsResponse, error) {
+	n, err := binary.ReadUvarint(q.reader)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxRecordSize {
+		return nil, fmt.Errorf("disk buffer record size %d too large", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(q.reader, data); err != nil {
+		return nil, err
+	}
+	q.readOffset += int64(protowire.SizeVarint(n)) + int64(n)
+	q.readEvents++
+	ev := &tetragon.GetEventsResponse{}
+	if err := proto.Unmarshal(data, ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// tryPop returns the next event, or nil if the queue is empty.
+func (q *Queue) tryPop() (*tetragon.GetEventsResponse, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil, ErrClosed
+	}
+	q.evictExpired(time.Now())
+	for {
+		first := q.segments[0]
+		if q.reader == nil {
+			if first.events == 0 {
+				return nil, nil
+			}
+			if err := q.openReader(); err != nil {
+				return nil, err
+			}
+		}
+		if q.readEvents < first.events {
+			ev, err := q.readRecord()
+			if err != nil {
+				// The rest of the segment cannot be read: skip it.
+				q.evict(EvictCorrupt, q.dropFirstSegment())
+				continue
+			}
+			q.inflight = append(q.inflight, q.readOffset)
+			return ev, nil
+		}
+		if len(q.segments) == 1 || len(q.inflight) > 0 {
+			// all the written events have been read, or the segment
+			// is kept until its events are acknowledged
+			return nil, nil
+		}
+		q.dropFirstSegment()
+	}
+}
+
+// Pop returns the oldest event of the queue that was not returned yet,
+// waiting for one to be pushed if there is none. It returns the context error
+// if ctx is done first. The event is replayed after a restart until it is
+// acknowledged with Ack.
+func (q *Queue) Pop(ctx context.Context) (*tetragon.GetEventsResponse, error) {
+	for {
+		ev, err := q.tryPop()
+		if ev != nil || err != nil {
+			return ev, err
+		}
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Ack acknowledges the delivery of the n oldest events returned by Pop and
+// not acknowledged yet, and persists the position after them.
+func (q *Queue) Ack(n int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	skip := min(n, q.discard)
+	q.discard -= skip
+	n = min(n-skip, len(q.inflight))
+	if n == 0 {
+		return nil
+	}
+	q.ackOffset = q.inflight[n-1]
+	q.ackEvents += n
+	q.inflight = q.inflight[n:]
+	err := q.syncCursor()
+	// Pop may wait for the acknowledgement to move to the next segment.
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// Len returns the number of events that were not acknowledged.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, seg := range q.segments {
+		n += seg.events
+	}
+	if q.reader != nil {
+		n -= q.ackEvents
+	}
+	return n
+}
+
+// Size returns the size of the queue on disk in bytes, including events
+// already read from a segment that is not fully consumed.
+func (q *Queue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// Close persists the acknowledged position and closes the queue. Events that
+// were not acknowledged are kept on disk.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+	err := q.syncCursor()
+	q.closeFiles()
+	return err
+}
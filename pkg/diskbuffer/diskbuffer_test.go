@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package diskbuffer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func testEvent(i int) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: fmt.Sprintf("/bin/%d", i)}},
+		},
+	}
+}
+
+func eventIndex(t *testing.T, ev *tetragon.GetEventsResponse) int {
+	var i int
+	_, err := fmt.Sscanf(ev.GetProcessExec().GetProcess().GetBinary(), "/bin/%d", &i)
+	require.NoError(t, err)
+	return i
+}
+
+// pop pops n events, acknowledging each of them if ack is set.
+func pop(t *testing.T, q *Queue, n int, ack bool) []int {
+	var ret []int
+	for range n {
+		ev, err := q.Pop(context.Background())
+		require.NoError(t, err)
+		ret = append(ret, eventIndex(t, ev))
+		if ack {
+			require.NoError(t, q.Ack(1))
+		}
+	}
+	return ret
+}
+
+func popAll(t *testing.T, q *Queue) []int {
+	var ret []int
+	for q.Len() > 0 {
+		ret = append(ret, pop(t, q, 1, true)...)
+	}
+	return ret
+}
+
+func seq(from, to int) []int {
+	var ret []int
+	for i := from; i < to; i++ {
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+func TestQueueOrder(t *testing.T) {
+	q, err := Open(Options{Dir: t.TempDir(), MaxSize: 1 << 20, SegmentSize: 256})
+	require.NoError(t, err)
+	defer q.Close()
+
+	for i := range 100 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	assert.Equal(t, 100, q.Len())
+	assert.Greater(t, len(q.segments), 1)
+	assert.Equal(t, seq(0, 50), pop(t, q, 50, true))
+
+	for i := 100; i < 120; i++ {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	assert.Equal(t, seq(50, 120), popAll(t, q))
+	// consumed segments are removed
+	assert.Len(t, q.segments, 1)
+}
+
+func TestQueuePopWaits(t *testing.T) {
+	q, err := Open(Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+	defer q.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Pop(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.Push(testEvent(1))
+	}()
+	ev, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, eventIndex(t, ev))
+}
+
+func TestQueueReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(Options{Dir: dir, SegmentSize: 256})
+	require.NoError(t, err)
+	for i := range 50 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	pop(t, q, 20, true)
+	require.NoError(t, q.Close())
+	_, err = q.Pop(context.Background())
+	require.ErrorIs(t, err, ErrClosed)
+
+	q, err = Open(Options{Dir: dir, SegmentSize: 256})
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, 30, q.Len())
+	require.NoError(t, q.Push(testEvent(50)))
+	assert.Equal(t, seq(20, 51), popAll(t, q))
+}
+
+func TestQueueAck(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(Options{Dir: dir, SegmentSize: 256})
+	require.NoError(t, err)
+	for i := range 50 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	first := q.segments[0].events
+	require.GreaterOrEqual(t, q.segments[1].events, 10)
+
+	// Pop does not move past a segment with events in flight, so that it
+	// is kept until they are delivered.
+	assert.Equal(t, seq(0, first), pop(t, q, first, false))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Pop(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 50, q.Len())
+
+	require.NoError(t, q.Ack(first))
+	assert.Equal(t, 50-first, q.Len())
+	assert.Equal(t, seq(first, first+10), pop(t, q, 10, false))
+	require.NoError(t, q.Ack(5))
+	require.NoError(t, q.Close())
+
+	// Events that were not acknowledged are replayed after a restart.
+	q, err = Open(Options{Dir: dir, SegmentSize: 256})
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, 50-first-5, q.Len())
+	assert.Equal(t, seq(first+5, 50), popAll(t, q))
+}
+
+func TestQueueTruncatedRecord(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(Options{Dir: dir})
+	require.NoError(t, err)
+	for i := range 3 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	require.NoError(t, q.Close())
+
+	// simulate a crash in the middle of a write
+	path := q.segmentPath(q.segments[0].id)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{100, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	q, err = Open(Options{Dir: dir})
+	require.NoError(t, err)
+	defer q.Close()
+	require.NoError(t, q.Push(testEvent(3)))
+	assert.Equal(t, seq(0, 4), popAll(t, q))
+}
+
+func TestQueueEvictSize(t *testing.T) {
+	evicted := map[string]int{}
+	q, err := Open(Options{
+		Dir:         t.TempDir(),
+		MaxSize:     1024,
+		SegmentSize: 256,
+		OnEvict:     func(n int, reason string) { evicted[reason] += n },
+	})
+	require.NoError(t, err)
+	defer q.Close()
+
+	for i := range 200 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	assert.LessOrEqual(t, q.Size(), int64(1024))
+	got := popAll(t, q)
+	require.NotEmpty(t, got)
+	// the newest events are kept, in order
+	assert.Equal(t, seq(200-len(got), 200), got)
+	assert.Equal(t, 200-len(got), evicted[EvictSize])
+
+	entries, err := os.ReadDir(q.opts.Dir)
+	require.NoError(t, err)
+	var segments int
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) == segmentSuffix {
+			segments++
+		}
+	}
+	assert.Equal(t, len(q.segments), segments)
+}
+
+func TestQueueEvictAge(t *testing.T) {
+	evicted := map[string]int{}
+	q, err := Open(Options{
+		Dir:     t.TempDir(),
+		MaxAge:  time.Minute,
+		OnEvict: func(n int, reason string) { evicted[reason] += n },
+	})
+	require.NoError(t, err)
+	defer q.Close()
+
+	for i := range 10 {
+		require.NoError(t, q.Push(testEvent(i)))
+	}
+	pop(t, q, 1, false)
+	q.segments[0].lastWrite = time.Now().Add(-2 * time.Minute)
+
+	require.NoError(t, q.Push(testEvent(10)))
+	assert.Equal(t, 9, evicted[EvictAge])
+	// the acknowledgement of the event in flight is ignored
+	require.NoError(t, q.Ack(1))
+	assert.Equal(t, 1, q.Len())
+	assert.Equal(t, []int{10}, popAll(t, q))
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package dnscache implements a passive DNS cache: it records the names that
+// the addresses of DNS responses observed on the node were resolved from, so
+// that network events can be annotated with the domain names of their
+// destination.
+package dnscache
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/cilium/tetragon/pkg/ipcache"
+)
+
+const (
+	// minTTL is the minimum time a name is kept for. Records with a lower
+	// TTL (often 0) would otherwise expire before the connection to the
+	// resolved address is even made.
+	minTTL = 30 * time.Second
+)
+
+var global atomic.Pointer[Cache]
+
+// Lookup returns the names ip was resolved from in the pod, if the passive
+// DNS cache is enabled. pod is the "namespace/name" of the pod, or empty for
+// the host.
+func Lookup(pod string, ip string) []string {
+	c := global.Load()
+	if c == nil {
+		return nil
+	}
+	return c.Lookup(pod, ip)
+}
+
+// podOf returns the "namespace/name" of the pod of addr, if it is known to
+// the IP cache of destination enrichment.
+func podOf(addr netip.Addr) string {
+	ep := ipcache.Get().Lookup(addr.String())
+	if ep.GetPodName() == "" {
+		return ""
+	}
+	return ep.GetNamespace() + "/" + ep.GetPodName()
+}
+
+type key struct {
+	// pod is the "namespace/name" of the pod the DNS client belongs to,
+	// empty for the host or when the pod is unknown
+	pod  string
+	addr netip.Addr
+}
+
+type name struct {
+	name    string
+	expires time.Time
+}
+
+// Cache is a size bounded cache of the names that addresses were resolved
+// from, per pod. Names expire after the TTL of their record.
+type Cache struct {
+	// mu serializes the updates of the names of a key
+	mu    sync.Mutex
+	names *lru.Cache[key, []name]
+	now   func() time.Time
+}
+
+// New creates a cache of at most size addresses.
+func New(size int) (*Cache, error) {
+	names, err := lru.New[key, []name](size)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{
+		names: names,
+		now:   time.Now,
+	}, nil
+}
+
+// Add records that addr was resolved from domain in the pod, for ttl.
+func (c *Cache) Add(pod string, domain string, addr netip.Addr, ttl time.Duration) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if domain == "" {
+		return
+	}
+	now := c.now()
+	expires := now.Add(max(ttl, minTTL))
+	k := key{pod: pod, addr: addr.Unmap()}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old, _ := c.names.Peek(k)
+	// values are shared with readers, so they are never modified in place
+	names := make([]name, 0, len(old)+1)
+	for _, n := range old {
+		if n.name != domain && n.expires.After(now) {
+			names = append(names, n)
+		}
+	}
+	names = append(names, name{name: domain, expires: expires})
+	c.names.Add(k, names)
+}
+
+func (c *Cache) lookup(k key, now time.Time) []string {
+	names, ok := c.names.Get(k)
+	if !ok {
+		return nil
+	}
+	var ret []string
+	for _, n := range names {
+		if n.expires.After(now) {
+			ret = append(ret, n.name)
+		}
+	}
+	return ret
+}
+
+// Lookup returns the sorted names ip was resolved from in the pod. The names
+// of the host are not returned for pods: they include the names resolved by
+// pods whose responses were seen with the address of the node as destination,
+// before being translated back by masquerading.
+func (c *Cache) Lookup(pod string, ip string) []string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	ret := c.lookup(key{pod: pod, addr: addr.Unmap()}, c.now())
+	slices.Sort(ret)
+	return ret
+}
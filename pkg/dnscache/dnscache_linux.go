@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+func htons(v uint16) uint16 {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return binary.NativeEndian.Uint16(b[:])
+}
+
+// openSocket opens a packet socket receiving the DNS responses of every
+// interface of the node, starting with their IP header.
+func openSocket() (*os.File, error) {
+	filter, err := socketFilter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to assemble socket filter: %w", err)
+	}
+	prog := make([]unix.SockFilter, 0, len(filter))
+	for _, ins := range filter {
+		prog = append(prog, unix.SockFilter{Code: ins.Op, Jt: ins.Jt, Jf: ins.Jf, K: ins.K})
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	err = unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &unix.SockFprog{
+		Len:    uint16(len(prog)),
+		Filter: &prog[0],
+	})
+	if err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to attach socket filter: %w", err)
+	}
+	return os.NewFile(uintptr(fd), "dns"), nil
+}
+
+const (
+	// maxReadErrors is the number of consecutive read errors after which the
+	// socket is given up on
+	maxReadErrors = 10
+)
+
+var (
+	// initial and maximum delay before reading again after a read error
+	readErrorBackoff    = 100 * time.Millisecond
+	maxReadErrorBackoff = 5 * time.Second
+)
+
+func (c *Cache) run(r io.Reader) {
+	buf := make([]byte, snapLen)
+	backoff := readErrorBackoff
+	errs := 0
+	for {
+		n, err := r.Read(buf)
+		if errors.Is(err, os.ErrClosed) {
+			return
+		} else if err != nil {
+			errs++
+			if errs >= maxReadErrors {
+				logger.GetLogger().Error("Failed to read DNS packets, the passive DNS cache is no longer updated",
+					"errors", errs, logfields.Error, err)
+				return
+			}
+			logger.GetLogger().Warn("Failed to read DNS packet, retrying",
+				"backoff", backoff, logfields.Error, err)
+			time.Sleep(backoff)
+			backoff = min(2*backoff, maxReadErrorBackoff)
+			continue
+		}
+		errs = 0
+		backoff = readErrorBackoff
+		c.handlePacket(buf[:n], podOf)
+	}
+}
+
+// Start starts the passive DNS cache of at most size addresses, fed by the
+// DNS responses received by the node, until ctx is done.
+func Start(ctx context.Context, size int) error {
+	c, err := New(size)
+	if err != nil {
+		return fmt.Errorf("failed to create DNS cache: %w", err)
+	}
+	f, err := openSocket()
+	if err != nil {
+		return err
+	}
+	global.Store(c)
+	go func() {
+		<-ctx.Done()
+		f.Close()
+	}()
+	go c.run(f)
+	return nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// errReader returns the errors of errs, then err forever.
+type errReader struct {
+	errs  []error
+	err   error
+	reads int
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	r.reads++
+	if len(r.errs) > 0 {
+		err := r.errs[0]
+		r.errs = r.errs[1:]
+		return 0, err
+	}
+	return 0, r.err
+}
+
+func TestRunReadErrors(t *testing.T) {
+	oldBackoff, oldMaxBackoff := readErrorBackoff, maxReadErrorBackoff
+	readErrorBackoff, maxReadErrorBackoff = time.Millisecond, 2*time.Millisecond
+	t.Cleanup(func() { readErrorBackoff, maxReadErrorBackoff = oldBackoff, oldMaxBackoff })
+
+	c, err := New(1)
+	require.NoError(t, err)
+
+	// the loop stops once the socket is closed, after retrying on errors
+	r := &errReader{errs: []error{unix.ENETDOWN, unix.ENOBUFS}, err: os.ErrClosed}
+	c.run(r)
+	assert.Equal(t, 3, r.reads)
+
+	// and gives up on persistent errors instead of spinning
+	r = &errReader{err: errors.New("read failed")}
+	c.run(r)
+	assert.Equal(t, maxReadErrors, r.reads)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	c, err := New(2)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+
+	addr := netip.MustParseAddr("93.184.216.34")
+	c.Add("default/web", "www.example.com.", addr, time.Minute)
+	c.Add("default/web", "Example.COM", addr, 5*time.Minute)
+	assert.Equal(t, []string{"example.com", "www.example.com"}, c.Lookup("default/web", "93.184.216.34"))
+	assert.Equal(t, []string{"example.com", "www.example.com"}, c.Lookup("default/web", "::ffff:93.184.216.34"))
+	assert.Nil(t, c.Lookup("default/web", "93.184.216.35"))
+	assert.Nil(t, c.Lookup("default/web", "invalid"))
+
+	// names of other pods and of the host are not visible
+	assert.Nil(t, c.Lookup("default/other", "93.184.216.34"))
+	c.Add("", "host.example.com", addr, time.Minute)
+	assert.Nil(t, c.Lookup("default/other", "93.184.216.34"))
+	assert.Equal(t, []string{"host.example.com"}, c.Lookup("", "93.184.216.34"))
+	assert.Equal(t, []string{"example.com", "www.example.com"}, c.Lookup("default/web", "93.184.216.34"))
+
+	// names expire after their TTL
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, []string{"example.com"}, c.Lookup("default/web", "93.184.216.34"))
+	assert.Nil(t, c.Lookup("", "93.184.216.34"))
+
+	// the TTL is at least minTTL
+	c.Add("default/web", "zero.example.com", netip.MustParseAddr("10.0.0.1"), 0)
+	now = now.Add(minTTL - time.Second)
+	assert.Equal(t, []string{"zero.example.com"}, c.Lookup("default/web", "10.0.0.1"))
+
+	// the least recently used address is evicted
+	assert.Equal(t, 2, c.names.Len())
+	c.Add("default/web", "new.example.com", netip.MustParseAddr("10.0.0.2"), time.Minute)
+	assert.Equal(t, 2, c.names.Len())
+	assert.Nil(t, c.Lookup("", "93.184.216.34"))
+}
+
+func TestLookupDisabled(t *testing.T) {
+	assert.Nil(t, Lookup("", "93.184.216.34"))
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"context"
+	"errors"
+)
+
+func Start(_ context.Context, _ int) error {
+	return errors.New("passive DNS cache is not supported on windows")
+}
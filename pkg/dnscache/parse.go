@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"time"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	dnsPort   = 53
+	protoUDP  = 17
+	ipv4Len   = 20
+	ipv6Len   = 40
+	udpLen    = 8
+	snapLen   = 65535
+	ipv4Flags = 6
+)
+
+var errNotResponse = errors.New("not a successful DNS response")
+
+// socketFilter returns a classic BPF program accepting the UDP packets with a
+// source port of 53. It expects packets starting with the IP header, as read
+// from a SOCK_DGRAM packet socket. IPv6 packets with extension headers and
+// IPv4 fragments other than the first one are dropped.
+func socketFilter() ([]bpf.RawInstruction, error) {
+	return bpf.Assemble([]bpf.Instruction{
+		// IP version
+		bpf.LoadAbsolute{Off: 0, Size: 1},
+		bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xf0},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x40, SkipFalse: 7},
+		// IPv4: UDP, first fragment, source port at the end of the header
+		bpf.LoadAbsolute{Off: 9, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: protoUDP, SkipFalse: 11},
+		bpf.LoadAbsolute{Off: ipv4Flags, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpBitsSet, Val: 0x1fff, SkipTrue: 9},
+		bpf.LoadMemShift{Off: 0},
+		bpf.LoadIndirect{Off: 0, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: dnsPort, SkipTrue: 5, SkipFalse: 6},
+		// IPv6: UDP next header, source port after the header
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x60, SkipFalse: 5},
+		bpf.LoadAbsolute{Off: 6, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: protoUDP, SkipFalse: 3},
+		bpf.LoadAbsolute{Off: ipv6Len, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: dnsPort, SkipFalse: 1},
+		bpf.RetConstant{Val: snapLen},
+		bpf.RetConstant{Val: 0},
+	})
+}
+
+// parsePacket returns the destination address and the UDP payload of a DNS
+// response packet, starting with the IP header.
+func parsePacket(pkt []byte) (netip.Addr, []byte, bool) {
+	if len(pkt) < 1 {
+		return netip.Addr{}, nil, false
+	}
+	var dst netip.Addr
+	var udp []byte
+	switch pkt[0] >> 4 {
+	case 4:
+		ihl := int(pkt[0]&0x0f) * 4
+		if ihl < ipv4Len || len(pkt) < ihl+udpLen || pkt[9] != protoUDP {
+			return netip.Addr{}, nil, false
+		}
+		dst = netip.AddrFrom4([4]byte(pkt[16:20]))
+		udp = pkt[ihl:]
+	case 6:
+		if len(pkt) < ipv6Len+udpLen || pkt[6] != protoUDP {
+			return netip.Addr{}, nil, false
+		}
+		dst = netip.AddrFrom16([16]byte(pkt[24:40])).Unmap()
+		udp = pkt[ipv6Len:]
+	default:
+		return netip.Addr{}, nil, false
+	}
+	if binary.BigEndian.Uint16(udp[0:2]) != dnsPort {
+		return netip.Addr{}, nil, false
+	}
+	return dst, udp[udpLen:], true
+}
+
+type answer struct {
+	addr netip.Addr
+	ttl  time.Duration
+}
+
+// parseResponse returns the name of the question of a successful DNS
+// response, and the A and AAAA records of its answers.
+func parseResponse(msg []byte) (string, []answer, error) {
+	var p dnsmessage.Parser
+	hdr, err := p.Start(msg)
+	if err != nil {
+		return "", nil, err
+	}
+	if !hdr.Response || hdr.RCode != dnsmessage.RCodeSuccess {
+		return "", nil, errNotResponse
+	}
+	q, err := p.Question()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := p.SkipAllQuestions(); err != nil {
+		return "", nil, err
+	}
+
+	var answers []answer
+	for {
+		h, err := p.AnswerHeader()
+		if errors.Is(err, dnsmessage.ErrSectionDone) {
+			break
+		} else if err != nil {
+			return "", nil, err
+		}
+		ttl := time.Duration(h.TTL) * time.Second
+		switch h.Type {
+		case dnsmessage.TypeA:
+			r, err := p.AResource()
+			if err != nil {
+				return "", nil, err
+			}
+			answers = append(answers, answer{addr: netip.AddrFrom4(r.A), ttl: ttl})
+		case dnsmessage.TypeAAAA:
+			r, err := p.AAAAResource()
+			if err != nil {
+				return "", nil, err
+			}
+			answers = append(answers, answer{addr: netip.AddrFrom16(r.AAAA).Unmap(), ttl: ttl})
+		default:
+			// CNAME records are not recorded: addresses are attributed to
+			// the name that was asked for
+			if err := p.SkipAnswer(); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	return q.Name.String(), answers, nil
+}
+
+// handlePacket records the answers of the DNS response pkt in the cache, for
+// the pod its destination address belongs to.
+func (c *Cache) handlePacket(pkt []byte, podOf func(netip.Addr) string) {
+	dst, msg, ok := parsePacket(pkt)
+	if !ok {
+		return
+	}
+	domain, answers, err := parseResponse(msg)
+	if err != nil || len(answers) == 0 {
+		return
+	}
+	pod := podOf(dst)
+	for _, a := range answers {
+		c.Add(pod, domain, a.addr, a.ttl)
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dnscache
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func dnsResponse(t *testing.T, name string, rcode dnsmessage.RCode, addrs ...netip.Addr) []byte {
+	t.Helper()
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, RCode: rcode})
+	require.NoError(t, b.StartQuestions())
+	require.NoError(t, b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(name),
+		Type:  dnsmessage.TypeA,
+		Class: dnsmessage.ClassINET,
+	}))
+	require.NoError(t, b.StartAnswers())
+	hdr := dnsmessage.ResourceHeader{
+		Name:  dnsmessage.MustNewName(name),
+		Class: dnsmessage.ClassINET,
+		TTL:   300,
+	}
+	require.NoError(t, b.CNAMEResource(hdr, dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("cdn.example.net.")}))
+	for _, addr := range addrs {
+		if addr.Is4() {
+			require.NoError(t, b.AResource(hdr, dnsmessage.AResource{A: addr.As4()}))
+		} else {
+			require.NoError(t, b.AAAAResource(hdr, dnsmessage.AAAAResource{AAAA: addr.As16()}))
+		}
+	}
+	msg, err := b.Finish()
+	require.NoError(t, err)
+	return msg
+}
+
+func udp(sport uint16, payload []byte) []byte {
+	hdr := make([]byte, udpLen)
+	binary.BigEndian.PutUint16(hdr[0:2], sport)
+	binary.BigEndian.PutUint16(hdr[2:4], 40000)
+	binary.BigEndian.PutUint16(hdr[4:6], uint16(udpLen+len(payload)))
+	return append(hdr, payload...)
+}
+
+func ipv4Packet(proto byte, flags uint16, dst netip.Addr, payload []byte) []byte {
+	hdr := make([]byte, ipv4Len)
+	hdr[0] = 0x45
+	binary.BigEndian.PutUint16(hdr[2:4], uint16(ipv4Len+len(payload)))
+	binary.BigEndian.PutUint16(hdr[ipv4Flags:ipv4Flags+2], flags)
+	hdr[8] = 64
+	hdr[9] = proto
+	copy(hdr[12:16], []byte{10, 96, 0, 10})
+	dst4 := dst.As4()
+	copy(hdr[16:20], dst4[:])
+	return append(hdr, payload...)
+}
+
+func ipv6Packet(next byte, dst netip.Addr, payload []byte) []byte {
+	hdr := make([]byte, ipv6Len)
+	hdr[0] = 0x60
+	binary.BigEndian.PutUint16(hdr[4:6], uint16(len(payload)))
+	hdr[6] = next
+	hdr[7] = 64
+	dst16 := dst.As16()
+	copy(hdr[24:40], dst16[:])
+	return append(hdr, payload...)
+}
+
+func TestSocketFilter(t *testing.T) {
+	raw, err := socketFilter()
+	require.NoError(t, err)
+	insns, ok := bpf.Disassemble(raw)
+	require.True(t, ok)
+	vm, err := bpf.NewVM(insns)
+	require.NoError(t, err)
+
+	pod4 := netip.MustParseAddr("10.0.0.1")
+	pod6 := netip.MustParseAddr("fd00::1")
+	msg := []byte("dns")
+	tests := []struct {
+		name   string
+		pkt    []byte
+		accept bool
+	}{
+		{"ipv4 dns", ipv4Packet(protoUDP, 0, pod4, udp(dnsPort, msg)), true},
+		{"ipv4 dns don't fragment", ipv4Packet(protoUDP, 0x4000, pod4, udp(dnsPort, msg)), true},
+		{"ipv4 other port", ipv4Packet(protoUDP, 0, pod4, udp(5353, msg)), false},
+		{"ipv4 tcp", ipv4Packet(6, 0, pod4, udp(dnsPort, msg)), false},
+		{"ipv4 fragment", ipv4Packet(protoUDP, 0x0010, pod4, udp(dnsPort, msg)), false},
+		{"ipv6 dns", ipv6Packet(protoUDP, pod6, udp(dnsPort, msg)), true},
+		{"ipv6 other port", ipv6Packet(protoUDP, pod6, udp(80, msg)), false},
+		{"ipv6 extension header", ipv6Packet(0, pod6, udp(dnsPort, msg)), false},
+		{"arp", []byte{0x00, 0x01, 0x08, 0x00}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := vm.Run(tt.pkt)
+			if tt.accept {
+				require.NoError(t, err)
+				assert.Equal(t, snapLen, n)
+			} else {
+				assert.Zero(t, n)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	addr4 := netip.MustParseAddr("93.184.216.34")
+	addr6 := netip.MustParseAddr("2606:2800:220:1::1")
+	name, answers, err := parseResponse(dnsResponse(t, "www.example.com.", dnsmessage.RCodeSuccess, addr4, addr6))
+	require.NoError(t, err)
+	assert.Equal(t, "www.example.com.", name)
+	assert.Equal(t, []answer{
+		{addr: addr4, ttl: 300 * time.Second},
+		{addr: addr6, ttl: 300 * time.Second},
+	}, answers)
+
+	_, _, err = parseResponse(dnsResponse(t, "www.example.com.", dnsmessage.RCodeNameError))
+	require.ErrorIs(t, err, errNotResponse)
+
+	_, _, err = parseResponse([]byte("garbage"))
+	require.Error(t, err)
+}
+
+func TestHandlePacket(t *testing.T) {
+	c, err := New(16)
+	require.NoError(t, err)
+
+	web := netip.MustParseAddr("10.0.0.1")
+	host := netip.MustParseAddr("192.168.1.1")
+	podOf := func(addr netip.Addr) string {
+		if addr == web {
+			return "default/web"
+		}
+		return ""
+	}
+	addr := netip.MustParseAddr("93.184.216.34")
+	msg := dnsResponse(t, "www.example.com.", dnsmessage.RCodeSuccess, addr)
+
+	c.handlePacket(ipv4Packet(protoUDP, 0, web, udp(dnsPort, msg)), podOf)
+	assert.Equal(t, []string{"www.example.com"}, c.Lookup("default/web", "93.184.216.34"))
+	assert.Nil(t, c.Lookup("", "93.184.216.34"))
+
+	msg = dnsResponse(t, "example.org.", dnsmessage.RCodeSuccess, addr)
+	c.handlePacket(ipv6Packet(protoUDP, netip.AddrFrom16(host.As16()), udp(dnsPort, msg)), podOf)
+	assert.Equal(t, []string{"example.org"}, c.Lookup("", "93.184.216.34"))
+
+	// truncated packets are ignored
+	c.handlePacket(ipv4Packet(protoUDP, 0, web, udp(dnsPort, msg))[:ipv4Len+4], podOf)
+	c.handlePacket(nil, podOf)
+}
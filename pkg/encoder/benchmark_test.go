@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/sryoya/protorand"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+const benchmarkEvents = 256
+
+func benchmarkEventSet(b *testing.B) []*tetragon.GetEventsResponse {
+	pr := protorand.New()
+	pr.Seed(1337)
+	evs := make([]*tetragon.GetEventsResponse, 0, benchmarkEvents)
+	for range benchmarkEvents {
+		msg, err := pr.Gen(&tetragon.GetEventsResponse{})
+		require.NoError(b, err)
+		evs = append(evs, msg.(*tetragon.GetEventsResponse))
+	}
+	return append(evs, testEvents()...)
+}
+
+func BenchmarkEncode(b *testing.B) {
+	evs := benchmarkEventSet(b)
+	for _, format := range Formats {
+		b.Run(format, func(b *testing.B) {
+			enc, err := NewEncoder(io.Discard, format)
+			require.NoError(b, err)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := range b.N {
+				if err := enc.Encode(evs[i%len(evs)]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	evs := benchmarkEventSet(b)
+	for _, format := range Formats {
+		b.Run(format, func(b *testing.B) {
+			var buf bytes.Buffer
+			enc, err := NewEncoder(&buf, format)
+			require.NoError(b, err)
+			for _, ev := range evs {
+				require.NoError(b, enc.Encode(ev))
+			}
+			data := buf.Bytes()
+			b.SetBytes(int64(len(data) / len(evs)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			var dec EventDecoder
+			for i := range b.N {
+				if i%len(evs) == 0 {
+					dec, _ = NewDecoder(bytes.NewReader(data), format)
+				}
+				if _, err := dec.Decode(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
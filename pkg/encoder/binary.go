@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// Export formats supported by NewEncoder and NewDecoder.
+const (
+	// FormatJSON is one protojson encoded event per line.
+	FormatJSON = "json"
+	// FormatProtobuf is a stream of varint length-delimited protobuf
+	// encoded events, as written by protodelim.
+	FormatProtobuf = "protobuf"
+	// FormatCBOR is a CBOR sequence (RFC 8742) of events. See CBOREncoder
+	// for the mapping of events to CBOR.
+	FormatCBOR = "cbor"
+)
+
+// Formats lists the supported export formats.
+var Formats = []string{FormatJSON, FormatProtobuf, FormatCBOR}
+
+// ValidateFormat returns an error if format is not a supported export format.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatProtobuf, FormatCBOR:
+		return nil
+	}
+	return fmt.Errorf("unknown format %q, supported formats are %s", format, strings.Join(Formats, ", "))
+}
+
+// NewEncoder returns an encoder writing events to w in the given format.
+func NewEncoder(w io.Writer, format string) (EventEncoder, error) {
+	switch format {
+	case FormatJSON:
+		return NewProtojsonEncoder(w), nil
+	case FormatProtobuf:
+		return NewProtobufEncoder(w), nil
+	case FormatCBOR:
+		return NewCBOREncoder(w), nil
+	}
+	return nil, ValidateFormat(format)
+}
+
+// FormatFromFilename guesses the format of an export file from its
+// extension (ignoring a trailing ".gz"), defaulting to FormatJSON.
+func FormatFromFilename(name string) string {
+	switch filepath.Ext(strings.TrimSuffix(name, ".gz")) {
+	case ".pb", ".binpb", ".protobuf":
+		return FormatProtobuf
+	case ".cbor":
+		return FormatCBOR
+	}
+	return FormatJSON
+}
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// OpenExportFile opens an export file for decoding, transparently
+// decompressing gzip files such as rotated backups. The returned closer closes
+// the underlying file.
+func OpenExportFile(path string) (io.Reader, io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := bufio.NewReader(f)
+	magic, err := r.Peek(len(gzipMagic))
+	if err == nil && bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			f.Close()
+			return nil, nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		return gz, f, nil
+	}
+	return r, f, nil
+}
+
+// ProtobufEncoder writes varint length-delimited protobuf encoded events.
+// Every event is written with a single Write call so that rotating writers
+// never split an event across files.
+type ProtobufEncoder struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+}
+
+func NewProtobufEncoder(w io.Writer) *ProtobufEncoder {
+	return &ProtobufEncoder{w: w}
+}
+
+// Encode implements EventEncoder.Encode.
+func (p *ProtobufEncoder) Encode(v any) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	size := proto.Size(event)
+	buf := protowire.AppendVarint(p.buf[:0], uint64(size))
+	buf, err := proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(buf, event)
+	if err != nil {
+		return err
+	}
+	p.buf = buf
+	_, err = p.w.Write(buf)
+	return err
+}
+
+// EventDecoder reads events written by an EventEncoder. Decode returns io.EOF
+// when there are no more events.
+type EventDecoder interface {
+	Decode() (*tetragon.GetEventsResponse, error)
+}
+
+// NewDecoder returns a decoder reading events in the given format from r.
+func NewDecoder(r io.Reader, format string) (EventDecoder, error) {
+	switch format {
+	case FormatJSON:
+		return NewProtojsonDecoder(r), nil
+	case FormatProtobuf:
+		return NewProtobufDecoder(r), nil
+	case FormatCBOR:
+		return NewCBORDecoder(r), nil
+	}
+	return nil, ValidateFormat(format)
+}
+
+// ProtojsonDecoder reads events encoded by ProtojsonEncoder.
+type ProtojsonDecoder struct {
+	r           *bufio.Reader
+	unmarshaler protojson.UnmarshalOptions
+}
+
+func NewProtojsonDecoder(r io.Reader) *ProtojsonDecoder {
+	return &ProtojsonDecoder{
+		r:           bufio.NewReader(r),
+		unmarshaler: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}
+}
+
+// Decode implements EventDecoder.Decode. Empty lines are skipped. Lines that
+// cannot be unmarshaled return an error wrapping ErrInvalidEvent, after which
+// decoding can continue with the next line.
+func (d *ProtojsonDecoder) Decode() (*tetragon.GetEventsResponse, error) {
+	for {
+		line, err := d.r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		event := &tetragon.GetEventsResponse{}
+		if uerr := d.unmarshaler.Unmarshal(line, event); uerr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidEvent, uerr)
+		}
+		return event, nil
+	}
+}
+
+// maxProtobufEventSize bounds the size of the events accepted by
+// ProtobufDecoder so that a corrupted length prefix cannot cause a huge
+// allocation.
+const maxProtobufEventSize = 64 << 20
+
+// ProtobufDecoder reads events encoded by ProtobufEncoder.
+type ProtobufDecoder struct {
+	r *bufio.Reader
+}
+
+func NewProtobufDecoder(r io.Reader) *ProtobufDecoder {
+	return &ProtobufDecoder{r: bufio.NewReader(r)}
+}
+
+// Decode implements EventDecoder.Decode.
+func (d *ProtobufDecoder) Decode() (*tetragon.GetEventsResponse, error) {
+	event := &tetragon.GetEventsResponse{}
+	err := protodelim.UnmarshalOptions{
+		MaxSize:          maxProtobufEventSize,
+		UnmarshalOptions: proto.UnmarshalOptions{DiscardUnknown: true},
+	}.UnmarshalFrom(d.r, event)
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("truncated event: %w", err)
+		}
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/sryoya/protorand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func testEvents() []*tetragon.GetEventsResponse {
+	return []*tetragon.GetEventsResponse{
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{
+					Binary:    "/usr/bin/curl",
+					Arguments: "cilium.io",
+					Pid:       wrapperspb.UInt32(1234),
+					Pod: &tetragon.Pod{
+						Namespace: "default",
+						PodLabels: map[string]string{"app": "curl"},
+						Workload:  "curl",
+						Container: &tetragon.Container{Id: "abc", Pid: wrapperspb.UInt32(1)},
+					},
+				},
+			}},
+			NodeName: "node1",
+			Time:     timestamppb.New(timestamppb.Now().AsTime()),
+		},
+		{
+			Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+				FunctionName: "security_file_open",
+				Action:       tetragon.KprobeAction_KPROBE_ACTION_SIGKILL,
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_IntArg{IntArg: -42}},
+					{Arg: &tetragon.KprobeArgument_BytesArg{BytesArg: []byte{0, 1, 2, 0xff}}},
+					{Arg: &tetragon.KprobeArgument_SizeArg{SizeArg: 1 << 40}},
+				},
+			}},
+		},
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, format := range Formats {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			enc, err := NewEncoder(&buf, format)
+			require.NoError(t, err)
+			events := testEvents()
+			for _, ev := range events {
+				require.NoError(t, enc.Encode(ev))
+			}
+			require.ErrorIs(t, enc.Encode("not an event"), ErrInvalidEvent)
+
+			dec, err := NewDecoder(&buf, format)
+			require.NoError(t, err)
+			for _, want := range events {
+				got, err := dec.Decode()
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
+			}
+			_, err = dec.Decode()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+
+	_, err := NewEncoder(io.Discard, "xml")
+	require.Error(t, err)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	for _, format := range []string{FormatProtobuf, FormatCBOR} {
+		var buf bytes.Buffer
+		enc, err := NewEncoder(&buf, format)
+		require.NoError(t, err)
+		require.NoError(t, enc.Encode(testEvents()[0]))
+		dec, err := NewDecoder(bytes.NewReader(buf.Bytes()[:buf.Len()-3]), format)
+		require.NoError(t, err)
+		_, err = dec.Decode()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF, format)
+	}
+}
+
+func TestDecodeOversized(t *testing.T) {
+	// a corrupted length prefix of 1TiB
+	data := protowire.AppendVarint(nil, 1<<40)
+	dec, err := NewDecoder(bytes.NewReader(data), FormatProtobuf)
+	require.NoError(t, err)
+	_, err = dec.Decode()
+	require.Error(t, err)
+}
+
+func TestCBORDecodeNested(t *testing.T) {
+	// an unknown field holding a deeply nested chain of tags
+	data := slices.Concat(
+		[]byte{0xa1, 0x67}, []byte("unknown"), // map(1), text(7)
+		bytes.Repeat([]byte{0xc6}, 1<<20), // tag(6)...
+		[]byte{0xf6},                      // null
+	)
+	dec, err := NewDecoder(bytes.NewReader(data), FormatCBOR)
+	require.NoError(t, err)
+	_, err = dec.Decode()
+	require.Error(t, err)
+}
+
+// TestCBOREncoderWireFormat checks the output of CBOREncoder against a
+// hand-decoded RFC 8949 encoding, so that it can be read by generic CBOR
+// implementations. Map keys are in core deterministic order.
+func TestCBOREncoderWireFormat(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewCBOREncoder(&buf).Encode(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			FunctionName: "f",
+			Action:       tetragon.KprobeAction_KPROBE_ACTION_SIGKILL,
+			Args: []*tetragon.KprobeArgument{
+				{Arg: &tetragon.KprobeArgument_IntArg{IntArg: -42}},
+				{Arg: &tetragon.KprobeArgument_BytesArg{BytesArg: []byte{0, 1, 2, 0xff}}},
+			},
+		}},
+	}))
+	want := slices.Concat(
+		[]byte{0xa1, 0x6e}, []byte("process_kprobe"), // map(1), text(14)
+		[]byte{0xa3, 0x64}, []byte("args"), // map(3), text(4)
+		[]byte{0x82, 0xa1, 0x67}, []byte("int_arg"), // array(2), map(1), text(7)
+		[]byte{0x38, 0x29},                      // negative(41), that is -42
+		[]byte{0xa1, 0x69}, []byte("bytes_arg"), // map(1), text(9)
+		[]byte{0x44, 0x00, 0x01, 0x02, 0xff}, // bytes(4)
+		[]byte{0x66}, []byte("action"),       // text(6)
+		[]byte{byte(tetragon.KprobeAction_KPROBE_ACTION_SIGKILL)}, // unsigned(3)
+		[]byte{0x6d}, []byte("function_name"), // text(13)
+		[]byte{0x61}, []byte("f"), // text(1)
+	)
+	assert.Equal(t, want, buf.Bytes())
+}
+
+func TestFormatFromFilename(t *testing.T) {
+	assert.Equal(t, FormatJSON, FormatFromFilename("/var/log/tetragon/tetragon.log"))
+	assert.Equal(t, FormatProtobuf, FormatFromFilename("events.pb"))
+	assert.Equal(t, FormatProtobuf, FormatFromFilename("events-2024-01-02T03-04-05.000.binpb.gz"))
+	assert.Equal(t, FormatCBOR, FormatFromFilename("events.cbor"))
+}
+
+func TestOpenExportFile(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "events.log")
+	require.NoError(t, os.WriteFile(plain, []byte("plain"), 0o600))
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err := w.Write([]byte("compressed"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	compressed := filepath.Join(dir, "events-2024-01-02T03-04-05.000.log.gz")
+	require.NoError(t, os.WriteFile(compressed, gz.Bytes(), 0o600))
+
+	for path, want := range map[string]string{plain: "plain", compressed: "compressed"} {
+		r, closer, err := OpenExportFile(path)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, want, string(data))
+		require.NoError(t, closer.Close())
+	}
+
+	_, _, err = OpenExportFile(filepath.Join(dir, "missing.log"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func FuzzBinaryEncoders(f *testing.F) {
+	for _, n := range []int64{
+		1337,
+		78776406,
+		56343416,
+		68876713,
+		51156281,
+		45544244,
+		4011756,
+	} {
+		f.Add(n)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		pr := protorand.New()
+		pr.Seed(seed)
+		msg, err := pr.Gen(&tetragon.GetEventsResponse{})
+		require.NoError(t, err)
+
+		for _, format := range []string{FormatProtobuf, FormatCBOR} {
+			var buf bytes.Buffer
+			enc, err := NewEncoder(&buf, format)
+			require.NoError(t, err)
+			require.NoError(t, enc.Encode(msg))
+			dec, err := NewDecoder(&buf, format)
+			require.NoError(t, err)
+			got, err := dec.Decode()
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(msg, got), format)
+		}
+	})
+}
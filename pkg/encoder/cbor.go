@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// maxCBORNestedLevels bounds the nesting of the items accepted by the
+// decoder, which is well above the depth of events, so that crafted input
+// cannot exhaust the stack.
+const maxCBORNestedLevels = 64
+
+var (
+	// Map keys are sorted so that the encoding of an event is
+	// deterministic, and floats are written with their original precision
+	// and bits so that the encoding is lossless.
+	cborEncMode, _ = cbor.EncOptions{
+		Sort:          cbor.SortCoreDeterministic,
+		ShortestFloat: cbor.ShortestFloatNone,
+		NaNConvert:    cbor.NaNConvertNone,
+		InfConvert:    cbor.InfConvertNone,
+	}.EncMode()
+	cborDecMode, _ = cbor.DecOptions{
+		MaxNestedLevels: maxCBORNestedLevels,
+	}.DecMode()
+)
+
+// CBOREncoder writes events as a CBOR sequence (RFC 8742). Messages are
+// encoded as maps keyed by the proto field names, as in the JSON export,
+// and only populated fields are written. Enums are encoded as integers,
+// bytes fields as byte strings and well-known types such as Timestamp as
+// regular messages, so the encoding is lossless. Every event is written with
+// a single Write call.
+type CBOREncoder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewCBOREncoder(w io.Writer) *CBOREncoder {
+	return &CBOREncoder{w: w}
+}
+
+// Encode implements EventEncoder.Encode.
+func (c *CBOREncoder) Encode(v any) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	buf, err := cborEncMode.Marshal(cborMessage(event.ProtoReflect()))
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err = c.w.Write(buf)
+	return err
+}
+
+// cborMessage returns the populated fields of m keyed by their names.
+func cborMessage(m protoreflect.Message) map[string]any {
+	fields := make(map[string]any)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields[string(fd.Name())] = cborField(fd, v)
+		return true
+	})
+	return fields
+}
+
+func cborField(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		items := make([]any, 0, list.Len())
+		for i := range list.Len() {
+			items = append(items, cborValue(fd, list.Get(i)))
+		}
+		return items
+	case fd.IsMap():
+		items := make(map[any]any, v.Map().Len())
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			items[cborValue(fd.MapKey(), k.Value())] = cborValue(fd.MapValue(), v)
+			return true
+		})
+		return items
+	}
+	return cborValue(fd, v)
+}
+
+func cborValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint()
+	case protoreflect.EnumKind:
+		return int64(v.Enum())
+	case protoreflect.FloatKind:
+		return float32(v.Float())
+	case protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return v.Bytes()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return cborMessage(v.Message())
+	}
+	return nil
+}
+
+// CBORDecoder reads events encoded by CBOREncoder. Unknown fields are
+// ignored.
+type CBORDecoder struct {
+	dec *cbor.Decoder
+}
+
+func NewCBORDecoder(r io.Reader) *CBORDecoder {
+	return &CBORDecoder{dec: cborDecMode.NewDecoder(r)}
+}
+
+// Decode implements EventDecoder.Decode.
+func (d *CBORDecoder) Decode() (*tetragon.GetEventsResponse, error) {
+	var item any
+	if err := d.dec.Decode(&item); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to decode CBOR event: %w", err)
+	}
+	event := &tetragon.GetEventsResponse{}
+	if err := decodeCBORMessage(event.ProtoReflect(), item); err != nil {
+		return nil, fmt.Errorf("failed to decode CBOR event: %w", err)
+	}
+	return event, nil
+}
+
+func decodeCBORMessage(m protoreflect.Message, item any) error {
+	fields, ok := item.(map[any]any)
+	if !ok {
+		return fmt.Errorf("unexpected CBOR item %T for message", item)
+	}
+	descs := m.Descriptor().Fields()
+	for k, v := range fields {
+		name, ok := k.(string)
+		if !ok {
+			return fmt.Errorf("unexpected CBOR item %T for field name", k)
+		}
+		fd := descs.ByName(protoreflect.Name(name))
+		if fd == nil {
+			continue
+		}
+		if err := decodeCBORField(m, fd, v); err != nil {
+			return fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+	}
+	return nil
+}
+
+func decodeCBORField(m protoreflect.Message, fd protoreflect.FieldDescriptor, item any) error {
+	switch {
+	case fd.IsList():
+		items, ok := item.([]any)
+		if !ok {
+			return fmt.Errorf("unexpected CBOR item %T for list", item)
+		}
+		list := m.Mutable(fd).List()
+		for _, item := range items {
+			v, err := decodeCBORValue(fd, item, list.NewElement)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	case fd.IsMap():
+		items, ok := item.(map[any]any)
+		if !ok {
+			return fmt.Errorf("unexpected CBOR item %T for map", item)
+		}
+		mm := m.Mutable(fd).Map()
+		for k, item := range items {
+			key, err := decodeCBORValue(fd.MapKey(), k, nil)
+			if err != nil {
+				return err
+			}
+			v, err := decodeCBORValue(fd.MapValue(), item, mm.NewValue)
+			if err != nil {
+				return err
+			}
+			mm.Set(key.MapKey(), v)
+		}
+		return nil
+	}
+	v, err := decodeCBORValue(fd, item, func() protoreflect.Value { return m.NewField(fd) })
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+// decodeCBORValue converts item to a value of the kind of fd. newMessage
+// returns the value that messages are decoded into.
+func decodeCBORValue(fd protoreflect.FieldDescriptor, item any, newMessage func() protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if v, ok := item.(bool); ok {
+			return protoreflect.ValueOfBool(v), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := cborInt(item)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return protoreflect.Value{}, fmt.Errorf("integer %d overflows int32", v)
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := cborInt(item)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if v, ok := item.(uint64); ok {
+			if v > math.MaxUint32 {
+				return protoreflect.Value{}, fmt.Errorf("integer %d overflows uint32", v)
+			}
+			return protoreflect.ValueOfUint32(uint32(v)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if v, ok := item.(uint64); ok {
+			return protoreflect.ValueOfUint64(v), nil
+		}
+	case protoreflect.EnumKind:
+		v, err := cborInt(item)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return protoreflect.Value{}, fmt.Errorf("integer %d overflows enum", v)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	case protoreflect.FloatKind:
+		if v, ok := item.(float64); ok {
+			return protoreflect.ValueOfFloat32(float32(v)), nil
+		}
+	case protoreflect.DoubleKind:
+		if v, ok := item.(float64); ok {
+			return protoreflect.ValueOfFloat64(v), nil
+		}
+	case protoreflect.StringKind:
+		if v, ok := item.(string); ok {
+			return protoreflect.ValueOfString(v), nil
+		}
+	case protoreflect.BytesKind:
+		if v, ok := item.([]byte); ok {
+			return protoreflect.ValueOfBytes(v), nil
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v := newMessage()
+		if err := decodeCBORMessage(v.Message(), item); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return v, nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+	return protoreflect.Value{}, fmt.Errorf("unexpected CBOR item %T for %s", item, fd.Kind())
+}
+
+func cborInt(item any) (int64, error) {
+	switch v := item.(type) {
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("integer %d overflows int64", v)
+		}
+		return int64(v), nil
+	case int64:
+		return v, nil
+	}
+	return 0, fmt.Errorf("unexpected CBOR item %T for integer", item)
+}
@@ -206,6 +206,16 @@ func PrintNS(ns int32) string {
 	return nsId[ns]
 }
 
+// sockDestination returns the destination address and port of a sock
+// argument, followed by the domain names the address was resolved from.
+func sockDestination(sa *tetragon.KprobeSock) string {
+	dst := fmt.Sprintf("%s:%d", sa.GetDaddr(), sa.GetDport())
+	if len(sa.GetHostnames()) > 0 {
+		dst += " (" + strings.Join(sa.GetHostnames(), ",") + ")"
+	}
+	return dst
+}
+
 func HumanStackTrace(response *tetragon.GetEventsResponse, colorer *Colorer) string {
 	out := new(strings.Builder)
 	if ev, ok := response.Event.(*tetragon.GetEventsResponse_ProcessKprobe); ok {
@@ -431,7 +441,7 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			sock := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
 				sa := kprobe.Args[0].GetSockArg()
-				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s", sa.Saddr, sa.Sport, sockDestination(sa))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, sock), caps), nil
 		case "tcp_close":
@@ -439,7 +449,7 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			sock := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
 				sa := kprobe.Args[0].GetSockArg()
-				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s", sa.Saddr, sa.Sport, sockDestination(sa))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, sock), caps), nil
 		case "tcp_sendmsg":
@@ -447,7 +457,7 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			args := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
 				sa := kprobe.Args[0].GetSockArg()
-				args = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				args = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s", sa.Saddr, sa.Sport, sockDestination(sa))
 			}
 			bytes := int32(0)
 			if len(kprobe.Args) > 1 && kprobe.Args[1] != nil {
@@ -566,6 +576,11 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, usdt.Process)
 		event := p.Colorer.Blue.Sprintf("🕵️ %-7s", "usdt")
 		return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s %s", event, processInfo, usdt.Path, usdt.Provider, usdt.Name), caps), nil
+	case *tetragon.GetEventsResponse_EventsGap:
+		gap := response.GetEventsGap()
+		event := p.Colorer.Red.Sprintf("⚠️ %-7s", "gap")
+		return fmt.Sprintf("%s %d events lost (sequence %d to %d)", event,
+			gap.LastSequence-gap.FirstSequence+1, gap.FirstSequence, gap.LastSequence), nil
 	}
 
 	return "", fmt.Errorf("%w: %s", ErrUnknownEventType, response.EventType())
@@ -289,6 +289,50 @@ func TestCompactEncoder_KprobeCloseEventToString(t *testing.T) {
 	assert.Equal(t, "📪 close   kube-system/tetragon /usr/bin/curl /etc/password", result)
 }
 
+func TestCompactEncoder_KprobeConnectEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
+
+	event := func(sock *tetragon.KprobeSock) *tetragon.GetEventsResponse {
+		return &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessKprobe{
+				ProcessKprobe: &tetragon.ProcessKprobe{
+					Process: &tetragon.Process{
+						Binary: "/usr/bin/curl",
+						Pod: &tetragon.Pod{
+							Namespace: "kube-system",
+							Name:      "tetragon",
+						},
+					},
+					FunctionName: "tcp_connect",
+					Args: []*tetragon.KprobeArgument{
+						{Arg: &tetragon.KprobeArgument_SockArg{SockArg: sock}},
+					},
+				},
+			},
+		}
+	}
+
+	result, err := p.EventToString(event(&tetragon.KprobeSock{
+		Saddr: "10.0.0.1",
+		Sport: 40000,
+		Daddr: "93.184.216.34",
+		Dport: 443,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "🔌 connect kube-system/tetragon /usr/bin/curl tcp 10.0.0.1:40000 -> 93.184.216.34:443", result)
+
+	// with the names from the passive DNS cache
+	result, err = p.EventToString(event(&tetragon.KprobeSock{
+		Saddr:     "10.0.0.1",
+		Sport:     40000,
+		Daddr:     "93.184.216.34",
+		Dport:     443,
+		Hostnames: []string{"example.com", "www.example.com"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "🔌 connect kube-system/tetragon /usr/bin/curl tcp 10.0.0.1:40000 -> 93.184.216.34:443 (example.com,www.example.com)", result)
+}
+
 func TestCompactEncoder_KprobeBPFEventToString(t *testing.T) {
 	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
 
@@ -434,6 +478,18 @@ func TestCompactEncoder_KprobeBPFMapAllocEventToString(t *testing.T) {
 	assert.Equal(t, "🗺 bpf_map_create kube-system/tetragon /usr/bin/bpftool BPF_MAP_TYPE_HASH amazing-map key size 8 value size 8 max entries 1024", result)
 }
 
+func TestCompactEncoder_EventsGapToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_EventsGap{
+			EventsGap: &tetragon.EventsGap{FirstSequence: 10, LastSequence: 14},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "⚠️ gap     5 events lost (sequence 10 to 14)", result)
+}
+
 func TestCompactEncoder_Encode(t *testing.T) {
 	var b bytes.Buffer
 	p := NewCompactEncoder(&b, Never, false, false, false)
@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/diskbuffer"
+	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+	"github.com/cilium/tetragon/pkg/filters"
+)
+
+// FilterConfig holds the event filters of an exporter. AllowList, DenyList,
+// FieldFilters and RedactionFilters use the same format as the
+// --export-allowlist, --export-denylist, --field-filters and
+// --redaction-filters flags. RedactionFilters only apply to the events of
+// this exporter, on top of the agent-wide --redaction-filters.
+type FilterConfig struct {
+	AllowList        string `json:"allowList,omitempty"`
+	DenyList         string `json:"denyList,omitempty"`
+	FieldFilters     string `json:"fieldFilters,omitempty"`
+	RedactionFilters string `json:"redactionFilters,omitempty"`
+}
+
+func (c *FilterConfig) isEmpty() bool {
+	return c.AllowList == "" && c.DenyList == "" && c.FieldFilters == "" && c.RedactionFilters == ""
+}
+
+// Redaction returns the redaction filters, or nil if there are none.
+func (c *FilterConfig) Redaction() (*fieldfilters.RedactionFilterList, error) {
+	redactionFilters, err := fieldfilters.ParseRedactionFilterList(c.RedactionFilters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redactionFilters: %w", err)
+	}
+	return redactionFilters, nil
+}
+
+// Request builds a GetEventsRequest holding the filters.
+func (c *FilterConfig) Request(enablePidSetFilter bool) (*tetragon.GetEventsRequest, error) {
+	allowList, err := filters.ParseFilterList(c.AllowList, enablePidSetFilter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowList: %w", err)
+	}
+	denyList, err := filters.ParseFilterList(c.DenyList, enablePidSetFilter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denyList: %w", err)
+	}
+	fieldFilters, err := fieldfilters.ParseFieldFilterList(c.FieldFilters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fieldFilters: %w", err)
+	}
+	return &tetragon.GetEventsRequest{
+		AllowList:    allowList,
+		DenyList:     denyList,
+		FieldFilters: fieldFilters,
+	}, nil
+}
+
+// FileConfig describes an export file. The fields match the
+// --export-file-* flags.
+type FileConfig struct {
+	Filename         string `json:"filename"`
+	MaxSizeMB        int    `json:"maxSizeMB,omitempty"`
+	MaxBackups       int    `json:"maxBackups,omitempty"`
+	Compress         bool   `json:"compress,omitempty"`
+	RotationInterval string `json:"rotationInterval,omitempty"`
+	Perm             string `json:"perm,omitempty"`
+	// Format is one of "json" (the default), "protobuf" or "cbor".
+	Format string `json:"format,omitempty"`
+}
+
+// AggregationConfig enables aggregation of exported events.
+type AggregationConfig struct {
+	// WindowSize defaults to 15s.
+	WindowSize string `json:"windowSize,omitempty"`
+	// BufferSize defaults to 10000.
+	BufferSize uint64 `json:"bufferSize,omitempty"`
+}
+
+// BufferConfig enables a disk buffer between the server and the destination
+// of an exporter. Events are appended to the buffer as they are produced and
+// exported from it in order, so that events survive an outage of a network
+// sink or a slow destination, within the size and age limits.
+type BufferConfig struct {
+	// Directory holds the buffer. It must not be shared with another
+	// exporter. Events left in it are exported after a restart.
+	Directory string `json:"directory"`
+	// MaxSizeMB defaults to 1024.
+	MaxSizeMB int `json:"maxSizeMB,omitempty"`
+	// MaxAge is the maximum age of buffered events. Older events are
+	// evicted. Zero (the default) means no age limit.
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
+func (c *BufferConfig) maxAge() (time.Duration, error) {
+	if c.MaxAge == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.MaxAge)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid buffer.maxAge %q", c.MaxAge)
+	}
+	return d, nil
+}
+
+// ExporterConfig describes a named exporter. Every exporter has exactly one
+// destination (a file or a network sink) and its own filters, rate limit and
+// aggregation options.
+type ExporterConfig struct {
+	// Name identifies the exporter in logs and in the "exporter" metrics
+	// label.
+	Name string      `json:"name"`
+	File *FileConfig `json:"file,omitempty"`
+	// Sink is a network sink as in --export-sinks. Its name defaults to the
+	// name of the exporter and its filters must be left empty, since they
+	// are configured on the exporter.
+	Sink *SinkConfig `json:"sink,omitempty"`
+
+	FilterConfig
+
+	// RateLimit is the maximum number of events exported per minute. Zero
+	// disables rate limiting.
+	RateLimit   int                `json:"rateLimit,omitempty"`
+	Aggregation *AggregationConfig `json:"aggregation,omitempty"`
+	Buffer      *BufferConfig      `json:"buffer,omitempty"`
+}
+
+// ParseExporterConfigs parses a YAML (or JSON) list of named exporters.
+func ParseExporterConfigs(data string) ([]ExporterConfig, error) {
+	var configs []ExporterConfig
+	if err := yaml.UnmarshalStrict([]byte(data), &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse exporters: %w", err)
+	}
+	names := make(map[string]struct{}, len(configs))
+	files := make(map[string]string, len(configs))
+	buffers := make(map[string]string, len(configs))
+	for i := range configs {
+		c := &configs[i]
+		if err := c.validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := names[c.Name]; ok {
+			return nil, fmt.Errorf("duplicate exporter name %q", c.Name)
+		}
+		names[c.Name] = struct{}{}
+		if c.File != nil {
+			path := cleanPath(c.File.Filename)
+			if other, ok := files[path]; ok {
+				return nil, fmt.Errorf("exporters %q and %q write to the same file %q", other, c.Name, c.File.Filename)
+			}
+			files[path] = c.Name
+		}
+		if c.Buffer != nil {
+			dir := filepath.Clean(c.Buffer.Directory)
+			if other, ok := buffers[dir]; ok {
+				return nil, fmt.Errorf("exporters %q and %q use the same buffer directory %q", other, c.Name, c.Buffer.Directory)
+			}
+			buffers[dir] = c.Name
+		}
+	}
+	return configs, nil
+}
+
+// CheckExportFilename returns an error if one of the exporters writes to
+// filename, the file of the global exporter configured with
+// --export-filename. Two writers rotating the same file would corrupt it.
+func CheckExportFilename(configs []ExporterConfig, filename string) error {
+	if filename == "" {
+		return nil
+	}
+	path := cleanPath(filename)
+	for i := range configs {
+		c := &configs[i]
+		if c.File != nil && cleanPath(c.File.Filename) == path {
+			return fmt.Errorf("exporter %q writes to the export file %q", c.Name, filename)
+		}
+	}
+	return nil
+}
+
+// CheckExportBufferDirectory returns an error if one of the exporters uses
+// dir, the buffer directory of the global exporter, as its buffer directory.
+func CheckExportBufferDirectory(configs []ExporterConfig, dir string) error {
+	if dir == "" {
+		return nil
+	}
+	path := cleanPath(dir)
+	for i := range configs {
+		c := &configs[i]
+		if c.Buffer != nil && cleanPath(c.Buffer.Directory) == path {
+			return fmt.Errorf("exporter %q uses the export buffer directory %q", c.Name, dir)
+		}
+	}
+	return nil
+}
+
+// cleanPath returns the absolute form of path, so that different spellings of
+// the same file compare equal.
+func cleanPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+func (c *ExporterConfig) validate() error {
+	if c.Name == "" {
+		return errors.New("exporter name must not be empty")
+	}
+	switch {
+	case c.File == nil && c.Sink == nil:
+		return fmt.Errorf("exporter %q: one of file or sink must be set", c.Name)
+	case c.File != nil && c.Sink != nil:
+		return fmt.Errorf("exporter %q: only one of file or sink can be set", c.Name)
+	case c.File != nil:
+		if c.File.Filename == "" {
+			return fmt.Errorf("exporter %q: file.filename must not be empty", c.Name)
+		}
+		if _, err := c.File.rotationInterval(); err != nil {
+			return fmt.Errorf("exporter %q: %w", c.Name, err)
+		}
+		if c.File.Format == "" {
+			c.File.Format = encoder.FormatJSON
+		}
+		if err := encoder.ValidateFormat(c.File.Format); err != nil {
+			return fmt.Errorf("exporter %q: invalid file.format: %w", c.Name, err)
+		}
+	case c.Sink != nil:
+		if c.Sink.Name == "" {
+			c.Sink.Name = c.Name
+		}
+		if !c.Sink.FilterConfig.isEmpty() {
+			return fmt.Errorf("exporter %q: filters must be set on the exporter, not on its sink", c.Name)
+		}
+		if err := c.Sink.validate(); err != nil {
+			return fmt.Errorf("exporter %q: %w", c.Name, err)
+		}
+	}
+	if _, err := c.aggregationOptions(); err != nil {
+		return fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	if _, err := c.Redaction(); err != nil {
+		return fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	if c.Buffer != nil {
+		if c.Buffer.Directory == "" {
+			return fmt.Errorf("exporter %q: buffer.directory must not be empty", c.Name)
+		}
+		if c.Buffer.MaxSizeMB < 0 {
+			return fmt.Errorf("exporter %q: buffer.maxSizeMB must not be negative", c.Name)
+		}
+		if _, err := c.Buffer.maxAge(); err != nil {
+			return fmt.Errorf("exporter %q: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *FileConfig) rotationInterval() (time.Duration, error) {
+	if c.RotationInterval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.RotationInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid file.rotationInterval: %w", err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("file.rotationInterval %q is negative", c.RotationInterval)
+	}
+	return d, nil
+}
+
+// RotationIntervalDuration returns the parsed rotation interval, zero meaning
+// that files are only rotated by size.
+func (c *FileConfig) RotationIntervalDuration() time.Duration {
+	d, _ := c.rotationInterval()
+	return d
+}
+
+func (c *ExporterConfig) aggregationOptions() (*tetragon.AggregationOptions, error) {
+	if c.Aggregation == nil {
+		return nil, nil
+	}
+	window := 15 * time.Second
+	if c.Aggregation.WindowSize != "" {
+		var err error
+		window, err = time.ParseDuration(c.Aggregation.WindowSize)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("invalid aggregation.windowSize %q", c.Aggregation.WindowSize)
+		}
+	}
+	bufferSize := c.Aggregation.BufferSize
+	if bufferSize == 0 {
+		bufferSize = 10000
+	}
+	return &tetragon.AggregationOptions{
+		WindowSize:        durationpb.New(window),
+		ChannelBufferSize: bufferSize,
+	}, nil
+}
+
+// OpenBuffer opens the disk buffer of the exporter, or returns nil if it has
+// none. Evictions are accounted for in the exporter_buffer_evicted_events_total
+// metric.
+func (c *ExporterConfig) OpenBuffer() (*diskbuffer.Queue, error) {
+	if c.Buffer == nil {
+		return nil, nil
+	}
+	maxAge, err := c.Buffer.maxAge()
+	if err != nil {
+		return nil, fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	q, err := openBuffer(c.Name, c.Buffer.Directory, c.Buffer.MaxSizeMB, maxAge)
+	if err != nil {
+		return nil, fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	return q, nil
+}
+
+// OpenExportBuffer opens the disk buffer of the global exporter configured
+// with --export-filename. Its metrics have an empty "exporter" label.
+func OpenExportBuffer(dir string, maxSizeMB int, maxAge time.Duration) (*diskbuffer.Queue, error) {
+	return openBuffer("", dir, maxSizeMB, maxAge)
+}
+
+func openBuffer(name string, dir string, maxSizeMB int, maxAge time.Duration) (*diskbuffer.Queue, error) {
+	return diskbuffer.Open(diskbuffer.Options{
+		Dir:     dir,
+		MaxSize: int64(maxSizeMB) << 20,
+		MaxAge:  maxAge,
+		OnEvict: func(events int, reason string) {
+			exporterBufferEvicted.WithLabelValues(reason, name).Add(float64(events))
+		},
+	})
+}
+
+// Request builds the GetEventsRequest of the exporter, holding its filters
+// and aggregation options.
+func (c *ExporterConfig) Request(enablePidSetFilter bool) (*tetragon.GetEventsRequest, error) {
+	req, err := c.FilterConfig.Request(enablePidSetFilter)
+	if err != nil {
+		return nil, fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	req.AggregationOptions, err = c.aggregationOptions()
+	if err != nil {
+		return nil, fmt.Errorf("exporter %q: %w", c.Name, err)
+	}
+	return req, nil
+}
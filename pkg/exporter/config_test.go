@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/rthooks"
+	"github.com/cilium/tetragon/pkg/server"
+)
+
+func TestParseExporterConfigs(t *testing.T) {
+	configs, err := ParseExporterConfigs(`
+- name: security
+  file:
+    filename: /var/run/cilium/tetragon/security.log
+    maxBackups: 3
+    rotationInterval: 1h
+    format: protobuf
+  allowList: '{"event_set":["PROCESS_KPROBE","PROCESS_LSM"]}'
+- name: audit
+  sink:
+    type: http
+    endpoint: https://audit.example.com/events
+  allowList: '{"event_set":["PROCESS_EXEC","PROCESS_EXIT"]}'
+  fieldFilters: '{"fields":"process.binary","action":"INCLUDE"}'
+  redactionFilters: '{"redact":["(?:--password)[\\s=]+(\\S+)"]}'
+  rateLimit: 1000
+  aggregation:
+    windowSize: 30s
+  buffer:
+    directory: /var/lib/tetragon/buffer/audit
+    maxSizeMB: 512
+    maxAge: 24h
+`)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+
+	assert.Equal(t, time.Hour, configs[0].File.RotationIntervalDuration())
+	assert.Equal(t, encoder.FormatProtobuf, configs[0].File.Format)
+	req, err := configs[0].Request(false)
+	require.NoError(t, err)
+	require.Len(t, req.AllowList, 1)
+	assert.Equal(t, []tetragon.EventType{tetragon.EventType_PROCESS_KPROBE, tetragon.EventType_PROCESS_LSM}, req.AllowList[0].EventSet)
+	assert.Nil(t, req.AggregationOptions)
+
+	// The sink name defaults to the exporter name.
+	assert.Equal(t, "audit", configs[1].Sink.Name)
+	assert.Equal(t, 1000, configs[1].RateLimit)
+	assert.Equal(t, 512, configs[1].Buffer.MaxSizeMB)
+	req, err = configs[1].Request(false)
+	require.NoError(t, err)
+	require.Len(t, req.FieldFilters, 1)
+	require.NotNil(t, req.AggregationOptions)
+	assert.Equal(t, 30*time.Second, req.AggregationOptions.WindowSize.AsDuration())
+	assert.Equal(t, uint64(10000), req.AggregationOptions.ChannelBufferSize)
+	redaction, err := configs[1].Redaction()
+	require.NoError(t, err)
+	assert.NotNil(t, redaction)
+	redaction, err = configs[0].Redaction()
+	require.NoError(t, err)
+	assert.Nil(t, redaction)
+
+	for _, bad := range []string{
+		`[{"name": "a"}]`,
+		`[{"file": {"filename": "/tmp/a.log"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "sink": {"type": "http", "endpoint": "http://x"}}]`,
+		`[{"name": "a", "file": {}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log", "rotationInterval": "-1h"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}}, {"name": "a", "file": {"filename": "/tmp/b.log"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}}, {"name": "b", "file": {"filename": "/tmp/a.log"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}}, {"name": "b", "file": {"filename": "/tmp/../tmp/a.log"}}]`,
+		`[{"name": "a", "sink": {"type": "http", "endpoint": "http://x", "allowList": "{}"}}]`,
+		`[{"name": "a", "sink": {"type": "http", "endpoint": "http://x", "redactionFilters": "{\\"redact\\":[\\"(\\"]}"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "redactionFilters": "{\\"redact\\":[\\"(\\"]}"}]`,
+		`[{"name": "a", "sink": {"type": "kafka", "endpoint": "x:1"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "aggregation": {"windowSize": "0s"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "unknown": true}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log", "format": "xml"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "buffer": {}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "buffer": {"directory": "/tmp/buf", "maxAge": "1"}}]`,
+		`[{"name": "a", "file": {"filename": "/tmp/a.log"}, "buffer": {"directory": "/tmp/buf"}}, {"name": "b", "file": {"filename": "/tmp/b.log"}, "buffer": {"directory": "/tmp/buf/"}}]`,
+	} {
+		_, err := ParseExporterConfigs(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCheckExportFilename(t *testing.T) {
+	configs, err := ParseExporterConfigs(`
+- name: security
+  file:
+    filename: /var/run/cilium/tetragon/security.log
+- name: audit
+  sink:
+    type: http
+    endpoint: https://audit.example.com/events
+`)
+	require.NoError(t, err)
+	require.NoError(t, CheckExportFilename(configs, ""))
+	require.NoError(t, CheckExportFilename(configs, "/var/run/cilium/tetragon/tetragon.log"))
+	require.Error(t, CheckExportFilename(configs, "/var/run/cilium/tetragon/security.log"))
+	require.Error(t, CheckExportFilename(configs, "/var/run/cilium/tetragon//security.log"))
+}
+
+func TestCheckExportBufferDirectory(t *testing.T) {
+	configs, err := ParseExporterConfigs(`
+- name: audit
+  sink:
+    type: http
+    endpoint: https://audit.example.com/events
+  buffer:
+    directory: /var/lib/tetragon/buffer/audit
+`)
+	require.NoError(t, err)
+	require.NoError(t, CheckExportBufferDirectory(configs, ""))
+	require.NoError(t, CheckExportBufferDirectory(configs, "/var/lib/tetragon/buffer/export"))
+	require.Error(t, CheckExportBufferDirectory(configs, "/var/lib/tetragon/buffer/audit/"))
+}
+
+func TestNamedExporterMetrics(t *testing.T) {
+	var wg sync.WaitGroup
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &server.FakeObserver{}, rthooks.DummyHookRunner{})
+
+	results := newArrayWriter(2)
+	enc := encoder.NewProtojsonEncoder(NewNamedExportedBytesTotalWriter(results, "named"))
+	request := &tetragon.GetEventsRequest{DenyList: []*tetragon.Filter{{BinaryRegex: []string{"b"}}}}
+	exporter := NewNamedExporter(ctx, "named", request, grpcServer, enc, results, nil)
+	require.NoError(t, exporter.Start(), "exporter must start without errors")
+	for _, binary := range []string{"a", "b", "c"} {
+		eventNotifier.NotifyListener(nil, &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{
+				ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: binary}},
+			}})
+	}
+	<-results.done
+	cancel()
+	<-eventNotifier.removed
+
+	assert.InDelta(t, 2, testutil.ToFloat64(exporterEventsExported.WithLabelValues("named")), 0)
+	// Each event is encoded as `{"process_exec":{"process":{"binary":"a"}}}\n`.
+	assert.InDelta(t, 2*44, testutil.ToFloat64(exporterBytesExported.WithLabelValues("named")), 0)
+}
+
+func TestNamedExporterDiskBuffer(t *testing.T) {
+	var wg sync.WaitGroup
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &server.FakeObserver{}, rthooks.DummyHookRunner{})
+
+	cfg := ExporterConfig{Name: "buffered", Buffer: &BufferConfig{Directory: t.TempDir()}}
+	buffer, err := cfg.OpenBuffer()
+	require.NoError(t, err)
+
+	// The sink is down for the first attempts: events wait in the buffer
+	// and are then sent in order.
+	sink := &fakeSink{name: "buffered", failures: 5}
+	enc := NewSinkEncoder(ctx, sink, SinkOptions{
+		BatchSize:      1,
+		QueueSize:      1,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Blocking:       true,
+	})
+	exporter := NewNamedExporter(ctx, "buffered", &tetragon.GetEventsRequest{}, grpcServer, enc, enc, nil).WithDiskBuffer(buffer)
+	require.NoError(t, exporter.Start(), "exporter must start without errors")
+	for i := range 20 {
+		eventNotifier.NotifyListener(nil, testEvent(strconv.Itoa(i)))
+	}
+	require.Eventually(t, func() bool { return sink.eventCount() == 20 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-eventNotifier.removed
+
+	var got []string
+	for _, b := range sink.batches {
+		for _, ev := range b {
+			got = append(got, ev.GetProcessExec().GetProcess().GetBinary())
+		}
+	}
+	for i := range 20 {
+		assert.Equal(t, strconv.Itoa(i), got[i])
+	}
+	assert.InDelta(t, 0, testutil.ToFloat64(exporterBufferEvents.WithLabelValues("buffered")), 0)
+}
+
+func TestNamedExporterDiskBufferUndelivered(t *testing.T) {
+	var wg sync.WaitGroup
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &server.FakeObserver{}, rthooks.DummyHookRunner{})
+
+	cfg := ExporterConfig{Name: "undelivered", Buffer: &BufferConfig{Directory: t.TempDir()}}
+	buffer, err := cfg.OpenBuffer()
+	require.NoError(t, err)
+
+	// The sink is down until the agent stops: the events read from the
+	// buffer are not delivered and must be kept.
+	sink := &fakeSink{name: "undelivered", failures: 1000}
+	enc := NewSinkEncoder(ctx, sink, SinkOptions{
+		BatchSize:      2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Blocking:       true,
+	})
+	exporter := NewNamedExporter(ctx, "undelivered", &tetragon.GetEventsRequest{}, grpcServer, enc, enc, nil).WithDiskBuffer(buffer)
+	require.NoError(t, exporter.Start(), "exporter must start without errors")
+	for i := range 5 {
+		eventNotifier.NotifyListener(nil, testEvent(strconv.Itoa(i)))
+	}
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(sinkErrors.WithLabelValues("undelivered")) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-eventNotifier.removed
+
+	buffer, err = cfg.OpenBuffer()
+	require.NoError(t, err)
+	defer buffer.Close()
+	require.Equal(t, 5, buffer.Len())
+	for i := range 5 {
+		ev, err := buffer.Pop(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(i), ev.GetProcessExec().GetProcess().GetBinary())
+		require.NoError(t, buffer.Ack(1))
+	}
+}
+
+func TestExporterDiskBuffer(t *testing.T) {
+	var wg sync.WaitGroup
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &server.FakeObserver{}, rthooks.DummyHookRunner{})
+
+	dir := t.TempDir()
+	buffer, err := OpenExportBuffer(dir, 1, 0)
+	require.NoError(t, err)
+	results := newArrayWriter(3)
+	enc := encoder.NewProtojsonEncoder(results)
+	exporter := NewExporter(ctx, &tetragon.GetEventsRequest{}, grpcServer, enc, results, nil).WithDiskBuffer(buffer)
+	require.NoError(t, exporter.Start(), "exporter must start without errors")
+	for i := range 3 {
+		eventNotifier.NotifyListener(nil, testEvent(strconv.Itoa(i)))
+	}
+	<-results.done
+	cancel()
+	<-eventNotifier.removed
+
+	// Events written by the encoder are acknowledged.
+	buffer, err = OpenExportBuffer(dir, 1, 0)
+	require.NoError(t, err)
+	defer buffer.Close()
+	assert.Equal(t, 0, buffer.Len())
+}
@@ -5,6 +5,7 @@ package exporter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -12,6 +13,8 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/diskbuffer"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/ratelimit"
@@ -22,6 +25,14 @@ type ExportEncoder interface {
 	Encode(v any) error
 }
 
+// deliveryNotifier is implemented by encoders that deliver events
+// asynchronously, such as SinkEncoder.
+type deliveryNotifier interface {
+	// notifyDelivered registers f to be called with the number of events
+	// delivered, in the order they were encoded.
+	notifyDelivered(f func(n int))
+}
+
 type Exporter struct {
 	ctx         context.Context
 	request     *tetragon.GetEventsRequest
@@ -29,6 +40,13 @@ type Exporter struct {
 	encoder     ExportEncoder
 	closer      io.Closer
 	rateLimiter *ratelimit.RateLimiter
+	// name is set for exporters configured through --exporters and is
+	// used as the "exporter" label of the per-exporter metrics.
+	name string
+	// buffer, if set, holds the events between Send and the encoder.
+	buffer *diskbuffer.Queue
+	// redaction, if set, redacts the events of this exporter.
+	redaction *fieldfilters.RedactionFilterList
 }
 
 func NewExporter(
@@ -39,19 +57,91 @@ func NewExporter(
 	closer io.Closer,
 	rateLimiter *ratelimit.RateLimiter,
 ) *Exporter {
-	return &Exporter{ctx, request, server, encoder, closer, rateLimiter}
+	return &Exporter{
+		ctx:         ctx,
+		request:     request,
+		server:      server,
+		encoder:     encoder,
+		closer:      closer,
+		rateLimiter: rateLimiter,
+	}
+}
+
+// NewNamedExporter creates an exporter that, in addition to the global export
+// metrics, updates the metrics labelled with its name.
+func NewNamedExporter(
+	ctx context.Context,
+	name string,
+	request *tetragon.GetEventsRequest,
+	server *server.Server,
+	encoder ExportEncoder,
+	closer io.Closer,
+	rateLimiter *ratelimit.RateLimiter,
+) *Exporter {
+	e := NewExporter(ctx, request, server, encoder, closer, rateLimiter)
+	e.name = name
+	return e
+}
+
+// WithDiskBuffer makes the exporter append events to q and export them from
+// there, in order, from a separate goroutine. A slow or unavailable
+// destination then fills the buffer instead of dropping events. Events are
+// acknowledged in q once they are written by the encoder, or once they are
+// delivered if the encoder sends them asynchronously, so that the events that
+// were not delivered are exported again after a restart. The exporter closes q
+// when it stops. It must be called before Start.
+func (e *Exporter) WithDiskBuffer(q *diskbuffer.Queue) *Exporter {
+	e.buffer = q
+	return e
+}
+
+// WithRedactionFilters makes the exporter redact the events it exports with
+// f. The events are shared with the other listeners of the server, so they are
+// copied before being redacted. It must be called before Start.
+func (e *Exporter) WithRedactionFilters(f *fieldfilters.RedactionFilterList) *Exporter {
+	e.redaction = f
+	return e
 }
 
 func (e *Exporter) Start() error {
 	var readyWG sync.WaitGroup
 	var exporterStartErr error
+	closer := e.closer
+	var stopDrain context.CancelFunc
+	var drainDone chan struct{}
+	if e.buffer != nil {
+		stopDrain, drainDone = e.startDrain()
+		// Close the destination before waiting for the drain goroutine,
+		// since it may be blocked sending an event to it.
+		closer = closerFunc(func() error {
+			stopDrain()
+			err := e.closer.Close()
+			<-drainDone
+			if berr := e.buffer.Close(); berr != nil {
+				err = berr
+			}
+			return err
+		})
+	}
 	readyWG.Add(1)
 	go func() {
-		if err := e.server.GetEventsWG(e.request, e, e.closer, &readyWG); err != nil {
-			exporterStartErr = fmt.Errorf("error starting JSON exporter: %w", err)
+		if err := e.server.GetEventsWG(e.request, e, closer, &readyWG); err != nil {
+			if e.name != "" {
+				exporterStartErr = fmt.Errorf("error starting exporter %q: %w", e.name, err)
+			} else {
+				exporterStartErr = fmt.Errorf("error starting JSON exporter: %w", err)
+			}
 		}
 	}()
 	readyWG.Wait()
+	if exporterStartErr != nil && stopDrain != nil {
+		// The caller closes the destination.
+		stopDrain()
+		go func() {
+			<-drainDone
+			e.buffer.Close()
+		}()
+	}
 	return exporterStartErr
 }
 
@@ -59,15 +149,82 @@ func (e *Exporter) Send(event *tetragon.GetEventsResponse) error {
 	if e.rateLimiter != nil && !e.rateLimiter.Allow() {
 		e.rateLimiter.Drop()
 		rateLimitDropped.Inc()
+		if e.name != "" {
+			exporterRateLimitDropped.WithLabelValues(e.name).Inc()
+		}
 		return nil
 	}
 
+	if e.redaction != nil {
+		event = e.redaction.RedactEvent(event)
+	}
+	if e.buffer != nil {
+		if err := e.buffer.Push(event); err != nil {
+			logger.GetLogger().Warn("Failed to write event to disk buffer", "exporter", e.name, logfields.Error, err)
+			exporterBufferEvicted.WithLabelValues(bufferEvictWriteError, e.name).Inc()
+		}
+		e.updateBufferMetrics()
+		return nil
+	}
+	e.export(event)
+	return nil
+}
+
+func (e *Exporter) export(event *tetragon.GetEventsResponse) {
 	if err := e.encoder.Encode(event); err != nil {
 		logger.GetLogger().Warn("Failed to JSON encode", logfields.Error, err)
 	}
 	eventsExportedTotal.Inc()
+	if e.name != "" {
+		exporterEventsExported.WithLabelValues(e.name).Inc()
+	}
 	eventsExportTimestamp.Set(float64(event.GetTime().GetSeconds()))
-	return nil
+}
+
+func (e *Exporter) updateBufferMetrics() {
+	exporterBufferEvents.WithLabelValues(e.name).Set(float64(e.buffer.Len()))
+	exporterBufferBytes.WithLabelValues(e.name).Set(float64(e.buffer.Size()))
+}
+
+// startDrain starts exporting the events of the disk buffer until the
+// returned function is called. The returned channel is closed when draining
+// stops.
+func (e *Exporter) startDrain() (context.CancelFunc, chan struct{}) {
+	ctx, cancel := context.WithCancel(e.ctx)
+	done := make(chan struct{})
+	ack := func(n int) {
+		if err := e.buffer.Ack(n); err != nil && !errors.Is(err, diskbuffer.ErrClosed) {
+			logger.GetLogger().Warn("Failed to acknowledge events in disk buffer", "exporter", e.name, logfields.Error, err)
+		}
+		e.updateBufferMetrics()
+	}
+	notifier, async := e.encoder.(deliveryNotifier)
+	if async {
+		notifier.notifyDelivered(ack)
+	}
+	go func() {
+		defer close(done)
+		for {
+			event, err := e.buffer.Pop(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.GetLogger().Warn("Failed to read event from disk buffer", "exporter", e.name, logfields.Error, err)
+				}
+				return
+			}
+			e.export(event)
+			if !async {
+				ack(1)
+			}
+		}
+	}()
+	return cancel, done
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
 }
 
 func (e *Exporter) SetHeader(metadata.MD) error {
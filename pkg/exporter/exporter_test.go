@@ -18,6 +18,7 @@ import (
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/ratelimit"
 	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/rthooks"
@@ -113,6 +114,34 @@ func TestExporter_Send(t *testing.T) {
 	<-eventNotifier.removed
 }
 
+func TestExporter_SendRedaction(t *testing.T) {
+	var wg sync.WaitGroup
+
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	dr := rthooks.DummyHookRunner{}
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &server.FakeObserver{}, dr)
+	results := newArrayWriter(1)
+	encoder := encoder.NewProtojsonEncoder(results)
+	redaction, err := fieldfilters.ParseRedactionFilterList(`{"redact": ["(?:--password)[\\s=]+(\\S+)"]}`)
+	require.NoError(t, err)
+	exporter := NewExporter(ctx, &tetragon.GetEventsRequest{}, grpcServer, encoder, results, nil).WithRedactionFilters(redaction)
+	require.NoError(t, exporter.Start(), "exporter must start without errors")
+	event := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: "a", Arguments: "--password secret"}},
+		}}
+	eventNotifier.NotifyListener(nil, event)
+	<-results.done
+	require.Len(t, results.items, 1)
+	assert.Contains(t, results.items[0], `"--password *****"`)
+	assert.NotContains(t, results.items[0], "secret")
+	// other listeners still get the event as it was generated
+	assert.Equal(t, "--password secret", event.GetProcessExec().Process.Arguments)
+	cancel()
+	<-eventNotifier.removed
+}
+
 type jsonEvent struct {
 	Event         json.RawMessage `json:"process_exec"`
 	RateLimitInfo json.RawMessage `json:"rate_limit_info"`
@@ -8,6 +8,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/cilium/tetragon/pkg/diskbuffer"
 	"github.com/cilium/tetragon/pkg/metrics"
 	"github.com/cilium/tetragon/pkg/metrics/consts"
 )
@@ -39,12 +40,104 @@ var (
 	})
 )
 
+const (
+	sinkDropQueueFull  = "queue_full"
+	sinkDropSendFailed = "send_failed"
+
+	bufferEvictWriteError = "write_error"
+)
+
+var (
+	sinkLabel     = metrics.UnconstrainedLabel{Name: "sink", ExampleValue: "siem-syslog"}
+	exporterLabel = metrics.UnconstrainedLabel{Name: "exporter", ExampleValue: "security-events"}
+
+	sinkDropReasonLabel = metrics.ConstrainedLabel{
+		Name:   "reason",
+		Values: []string{sinkDropQueueFull, sinkDropSendFailed},
+	}
+
+	bufferEvictReasonLabel = metrics.ConstrainedLabel{
+		Name:   "reason",
+		Values: []string{diskbuffer.EvictSize, diskbuffer.EvictAge, diskbuffer.EvictCorrupt, bufferEvictWriteError},
+	}
+
+	sinkEventsSent = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "export_sink_events_sent_total",
+		"Number of events successfully sent to an export sink.",
+		nil, nil, []metrics.UnconstrainedLabel{sinkLabel},
+	), nil)
+
+	sinkEventsDropped = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "export_sink_events_dropped_total",
+		"Number of events dropped by an export sink.",
+		nil, []metrics.ConstrainedLabel{sinkDropReasonLabel}, []metrics.UnconstrainedLabel{sinkLabel},
+	), nil)
+
+	sinkErrors = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "export_sink_errors_total",
+		"Number of failed attempts to send a batch of events to an export sink.",
+		nil, nil, []metrics.UnconstrainedLabel{sinkLabel},
+	), nil)
+
+	sinkQueueLength = metrics.MustNewGauge(metrics.NewOpts(
+		consts.MetricsNamespace, "", "export_sink_queue_length",
+		"Number of events waiting to be sent to an export sink.",
+		nil, nil, []metrics.UnconstrainedLabel{sinkLabel},
+	), nil)
+
+	exporterEventsExported = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_events_exported_total",
+		"Number of events exported by a named exporter.",
+		nil, nil, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+
+	exporterBytesExported = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_events_exported_bytes_total",
+		"Number of bytes exported by a named exporter.",
+		nil, nil, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+
+	exporterRateLimitDropped = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_ratelimit_events_dropped_total",
+		"Number of events dropped by a named exporter due to rate limiting.",
+		nil, nil, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+
+	exporterBufferEvents = metrics.MustNewGauge(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_buffer_events",
+		"Number of events waiting in the disk buffer of a named exporter.",
+		nil, nil, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+
+	exporterBufferBytes = metrics.MustNewGauge(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_buffer_bytes",
+		"Size on disk of the disk buffer of a named exporter.",
+		nil, nil, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+
+	exporterBufferEvicted = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "exporter_buffer_evicted_events_total",
+		"Number of events evicted from the disk buffer of a named exporter before being exported.",
+		nil, []metrics.ConstrainedLabel{bufferEvictReasonLabel}, []metrics.UnconstrainedLabel{exporterLabel},
+	), nil)
+)
+
 func RegisterMetrics(group metrics.Group) {
 	group.MustRegister(
 		eventsExportedTotal,
 		eventsExportedBytesTotal,
 		eventsExportTimestamp,
 		rateLimitDropped,
+		sinkEventsSent,
+		sinkEventsDropped,
+		sinkErrors,
+		sinkQueueLength,
+		exporterEventsExported,
+		exporterBytesExported,
+		exporterRateLimitDropped,
+		exporterBufferEvents,
+		exporterBufferBytes,
+		exporterBufferEvicted,
 	)
 }
 
@@ -66,3 +159,9 @@ func (w byteCounterWriter) Write(p []byte) (int, error) {
 func NewExportedBytesTotalWriter(w io.Writer) io.Writer {
 	return newExportedBytesCounterWriter(w, eventsExportedBytesTotal)
 }
+
+// NewNamedExportedBytesTotalWriter is like NewExportedBytesTotalWriter, but
+// counts the bytes written by the named exporter.
+func NewNamedExportedBytesTotalWriter(w io.Writer, name string) io.Writer {
+	return newExportedBytesCounterWriter(w, exporterBytesExported.WithLabelValues(name))
+}
@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+// Sink is a network destination for exported events. Sinks receive events in
+// batches from a SinkEncoder, which takes care of buffering, retries and
+// accounting.
+type Sink interface {
+	// Name identifies the sink in logs and metrics.
+	Name() string
+	// Write sends a batch of events to the destination and returns the
+	// number of bytes sent. A returned error means that the whole batch
+	// has to be retried.
+	Write(ctx context.Context, events []*tetragon.GetEventsResponse) (int, error)
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// SinkOptions configures how a SinkEncoder batches and retries events.
+type SinkOptions struct {
+	// BatchSize is the maximum number of events sent in a single Write.
+	BatchSize int
+	// FlushInterval is the maximum time an event is buffered before it is
+	// sent, even if the batch is not full.
+	FlushInterval time.Duration
+	// QueueSize is the number of events buffered in memory. Events are
+	// dropped when the queue is full.
+	QueueSize int
+	// MaxRetries is the number of times a failed batch is retried before
+	// its events are dropped. A negative value disables retries.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. It doubles on
+	// every subsequent retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Blocking makes Encode wait for room in the queue and failed batches
+	// be retried until they are sent, instead of dropping events. It is
+	// used when the exporter has a disk buffer, which then absorbs sink
+	// outages.
+	Blocking bool
+	// Exporter is the name of the named exporter the sink belongs to, if
+	// any. The bytes sent are then counted in its metrics.
+	Exporter string
+}
+
+const (
+	defaultSinkBatchSize      = 100
+	defaultSinkFlushInterval  = time.Second
+	defaultSinkQueueSize      = 10000
+	defaultSinkMaxRetries     = 5
+	defaultSinkInitialBackoff = 500 * time.Millisecond
+	defaultSinkMaxBackoff     = 30 * time.Second
+)
+
+func (o SinkOptions) withDefaults() SinkOptions {
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultSinkBatchSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = defaultSinkFlushInterval
+	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = defaultSinkQueueSize
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	} else if o.MaxRetries == 0 {
+		o.MaxRetries = defaultSinkMaxRetries
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = defaultSinkInitialBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = defaultSinkMaxBackoff
+	}
+	if o.MaxBackoff < o.InitialBackoff {
+		o.MaxBackoff = o.InitialBackoff
+	}
+	return o
+}
+
+// SinkEncoder implements ExportEncoder on top of a Sink. Unless
+// SinkOptions.Blocking is set, Encode never blocks: events are queued and
+// sent in batches from a separate goroutine. Events that do not fit in the
+// queue, or that could not be delivered after all retries, are dropped and
+// accounted for in the export_sink_events_dropped_total metric.
+type SinkEncoder struct {
+	sink   Sink
+	opts   SinkOptions
+	queue  chan *tetragon.GetEventsResponse
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// onDelivered is called with the number of events of every batch
+	// delivered, until an event is dropped. It is set before the first
+	// event is encoded.
+	onDelivered func(n int)
+	lost        bool
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewSinkEncoder starts a SinkEncoder sending events to sink. The encoder
+// stops when ctx is done or when Close is called.
+func NewSinkEncoder(ctx context.Context, sink Sink, opts SinkOptions) *SinkEncoder {
+	opts = opts.withDefaults()
+	ctx, cancel := context.WithCancel(ctx)
+	e := &SinkEncoder{
+		sink:   sink,
+		opts:   opts,
+		queue:  make(chan *tetragon.GetEventsResponse, opts.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go e.run(ctx)
+	return e
+}
+
+// notifyDelivered implements deliveryNotifier.
+func (e *SinkEncoder) notifyDelivered(f func(n int)) {
+	e.onDelivered = f
+}
+
+// Encode queues an event for sending.
+func (e *SinkEncoder) Encode(v any) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("sink %s: unexpected event type %T", e.sink.Name(), v)
+	}
+	if e.opts.Blocking {
+		select {
+		case e.queue <- event:
+			sinkQueueLength.WithLabelValues(e.sink.Name()).Inc()
+		case <-e.ctx.Done():
+			sinkEventsDropped.WithLabelValues(sinkDropQueueFull, e.sink.Name()).Inc()
+		}
+		return nil
+	}
+	select {
+	case e.queue <- event:
+		sinkQueueLength.WithLabelValues(e.sink.Name()).Inc()
+		return nil
+	default:
+		// Dropping is accounted for in metrics, do not flood the logs.
+		sinkEventsDropped.WithLabelValues(sinkDropQueueFull, e.sink.Name()).Inc()
+		return nil
+	}
+}
+
+// Close stops the encoder, flushes the events that are still queued on a
+// best effort basis and closes the underlying sink.
+func (e *SinkEncoder) Close() error {
+	e.closeOnce.Do(func() {
+		e.cancel()
+		<-e.done
+		e.closeErr = e.sink.Close()
+	})
+	return e.closeErr
+}
+
+func (e *SinkEncoder) run(ctx context.Context) {
+	defer close(e.done)
+
+	ticker := time.NewTicker(e.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*tetragon.GetEventsResponse, 0, e.opts.BatchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		e.send(ctx, batch)
+		batch = make([]*tetragon.GetEventsResponse, 0, e.opts.BatchSize)
+	}
+
+	for {
+		select {
+		case event := <-e.queue:
+			sinkQueueLength.WithLabelValues(e.sink.Name()).Dec()
+			batch = append(batch, event)
+			if len(batch) >= e.opts.BatchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		case <-ctx.Done():
+			// Drain what is left in the queue and give the sink a
+			// last chance to deliver it, without retries.
+			drainCtx, cancel := context.WithTimeout(context.Background(), e.opts.FlushInterval)
+			defer cancel()
+			for {
+				select {
+				case event := <-e.queue:
+					sinkQueueLength.WithLabelValues(e.sink.Name()).Dec()
+					batch = append(batch, event)
+					if len(batch) >= e.opts.BatchSize {
+						e.sendOnce(drainCtx, batch)
+						batch = make([]*tetragon.GetEventsResponse, 0, e.opts.BatchSize)
+					}
+				default:
+					e.sendOnce(drainCtx, batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// send writes a batch to the sink, retrying with an exponential backoff.
+func (e *SinkEncoder) send(ctx context.Context, batch []*tetragon.GetEventsResponse) {
+	backoff := e.opts.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		n, err := e.sink.Write(ctx, batch)
+		if err == nil {
+			e.sent(len(batch), n)
+			return
+		}
+		sinkErrors.WithLabelValues(e.sink.Name()).Inc()
+		if (!e.opts.Blocking && attempt >= e.opts.MaxRetries) || errors.Is(err, context.Canceled) {
+			logger.GetLogger().Warn("Failed to send events to export sink, dropping them",
+				"sink", e.sink.Name(), "events", len(batch), logfields.Error, err)
+			e.dropped(len(batch))
+			return
+		}
+		logger.GetLogger().Debug("Failed to send events to export sink, retrying",
+			"sink", e.sink.Name(), "attempt", attempt+1, "backoff", backoff, logfields.Error, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			e.dropped(len(batch))
+			return
+		}
+		backoff = min(2*backoff, e.opts.MaxBackoff)
+	}
+}
+
+func (e *SinkEncoder) sendOnce(ctx context.Context, batch []*tetragon.GetEventsResponse) {
+	if len(batch) == 0 {
+		return
+	}
+	n, err := e.sink.Write(ctx, batch)
+	if err != nil {
+		sinkErrors.WithLabelValues(e.sink.Name()).Inc()
+		e.dropped(len(batch))
+		return
+	}
+	e.sent(len(batch), n)
+}
+
+// sent accounts for a batch of events delivered to the sink.
+func (e *SinkEncoder) sent(events int, bytes int) {
+	sinkEventsSent.WithLabelValues(e.sink.Name()).Add(float64(events))
+	if e.opts.Exporter != "" {
+		exporterBytesExported.WithLabelValues(e.opts.Exporter).Add(float64(bytes))
+	}
+	if e.onDelivered != nil && !e.lost {
+		e.onDelivered(events)
+	}
+}
+
+// dropped accounts for a batch of events that could not be sent. Later
+// deliveries are not notified anymore, since they would be counted in place
+// of the dropped events.
+func (e *SinkEncoder) dropped(events int) {
+	sinkEventsDropped.WithLabelValues(sinkDropSendFailed, e.sink.Name()).Add(float64(events))
+	e.lost = true
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/encoder"
+)
+
+// httpSink POSTs batches of events to a webhook. The request body contains
+// one JSON encoded event per line, the same format as the JSON export file.
+type httpSink struct {
+	name    string
+	url     string
+	headers map[string]string
+	gzip    bool
+	client  *http.Client
+}
+
+// NewHTTPSink creates a sink posting batches of events to endpoint. headers
+// are added to every request, which can be used for authentication. When
+// compress is true, request bodies are gzip compressed.
+func NewHTTPSink(name string, endpoint string, tlsConfig *tls.Config, headers map[string]string, compress bool) (Sink, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP sink URL %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid HTTP sink URL %q: scheme must be http or https", endpoint)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &httpSink{
+		name:    name,
+		url:     endpoint,
+		headers: headers,
+		gzip:    compress,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   30 * time.Second,
+		},
+	}, nil
+}
+
+func (s *httpSink) Name() string {
+	return s.name
+}
+
+func (s *httpSink) Write(ctx context.Context, events []*tetragon.GetEventsResponse) (int, error) {
+	var body bytes.Buffer
+	var w io.Writer = &body
+	var gz *gzip.Writer
+	if s.gzip {
+		gz = gzip.NewWriter(&body)
+		w = gz
+	}
+	enc := encoder.NewProtojsonEncoder(w)
+	for _, ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			return 0, err
+		}
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return 0, err
+		}
+	}
+
+	size := body.Len()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, &body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if s.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("HTTP sink %q returned status %s", s.url, resp.Status)
+	}
+	return size, nil
+}
+
+func (s *httpSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/logger"
+)
+
+const otlpScopeName = "github.com/cilium/tetragon"
+
+// otlpSink sends events as OpenTelemetry log records using the OTLP/gRPC
+// logs service. The body of every log record is the JSON encoded event.
+type otlpSink struct {
+	name    string
+	conn    *grpc.ClientConn
+	client  collogspb.LogsServiceClient
+	headers metadata.MD
+}
+
+// NewOTLPSink creates a sink exporting events to an OTLP/gRPC logs endpoint
+// (e.g. an OpenTelemetry collector listening on "localhost:4317"). A nil
+// tlsConfig means that the connection is not encrypted.
+func NewOTLPSink(name string, endpoint string, tlsConfig *tls.Config, headers map[string]string) (Sink, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP client for %q: %w", endpoint, err)
+	}
+	return &otlpSink{
+		name:    name,
+		conn:    conn,
+		client:  collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(headers),
+	}, nil
+}
+
+func (s *otlpSink) Name() string {
+	return s.name
+}
+
+func (s *otlpSink) Write(ctx context.Context, events []*tetragon.GetEventsResponse) (int, error) {
+	req, err := otlpLogsRequest(events)
+	if err != nil {
+		return 0, err
+	}
+	if len(s.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, s.headers)
+	}
+	resp, err := s.client.Export(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	// Rejected records are not retried, since the receiver told us they
+	// are not acceptable.
+	if ps := resp.GetPartialSuccess(); ps.GetRejectedLogRecords() > 0 {
+		logger.GetLogger().Warn("OTLP endpoint rejected log records",
+			"sink", s.name, "rejected", ps.GetRejectedLogRecords(), "message", ps.GetErrorMessage())
+		sinkEventsDropped.WithLabelValues(sinkDropSendFailed, s.name).Add(float64(ps.GetRejectedLogRecords()))
+	}
+	return proto.Size(req), nil
+}
+
+func (s *otlpSink) Close() error {
+	return s.conn.Close()
+}
+
+func otlpStringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+// otlpLogsRequest converts a batch of events to an OTLP logs request. Events
+// are grouped by the node they were observed on, which is reported as the
+// host.name resource attribute.
+func otlpLogsRequest(events []*tetragon.GetEventsResponse) (*collogspb.ExportLogsServiceRequest, error) {
+	var buf bytes.Buffer
+	enc := encoder.NewProtojsonEncoder(&buf)
+
+	byNode := map[string]*logspb.ScopeLogs{}
+	req := &collogspb.ExportLogsServiceRequest{}
+	for _, ev := range events {
+		buf.Reset()
+		if err := enc.Encode(ev); err != nil {
+			return nil, err
+		}
+
+		scopeLogs, ok := byNode[ev.GetNodeName()]
+		if !ok {
+			scopeLogs = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: otlpScopeName},
+			}
+			byNode[ev.GetNodeName()] = scopeLogs
+			resourceAttrs := []*commonpb.KeyValue{otlpStringAttr("service.name", "tetragon")}
+			if ev.GetNodeName() != "" {
+				resourceAttrs = append(resourceAttrs, otlpStringAttr("host.name", ev.GetNodeName()))
+			}
+			if ev.GetClusterName() != "" {
+				resourceAttrs = append(resourceAttrs, otlpStringAttr("k8s.cluster.name", ev.GetClusterName()))
+			}
+			req.ResourceLogs = append(req.ResourceLogs, &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: resourceAttrs},
+				ScopeLogs: []*logspb.ScopeLogs{scopeLogs},
+			})
+		}
+
+		record := &logspb.LogRecord{
+			SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:   "INFO",
+			Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{
+				StringValue: string(bytes.TrimSpace(buf.Bytes())),
+			}},
+		}
+		if ts := ev.GetTime(); ts != nil {
+			record.TimeUnixNano = uint64(ts.AsTime().UnixNano())
+			record.ObservedTimeUnixNano = record.TimeUnixNano
+		}
+		if eventType, err := helpers.ResponseTypeString(ev); err == nil {
+			record.Attributes = append(record.Attributes, otlpStringAttr("tetragon.event_type", eventType))
+		}
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, record)
+	}
+	return req, nil
+}
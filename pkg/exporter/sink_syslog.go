@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+	"github.com/cilium/tetragon/pkg/encoder"
+)
+
+const (
+	syslogAppName = "tetragon"
+	// RFC5424 severity for informational messages.
+	syslogSeverityInfo = 6
+	// RFC5424 timestamps allow at most microsecond precision.
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5,
+	"lpr": 6, "news": 7, "uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// syslogSink sends events as RFC5424 messages over a TCP (optionally TLS)
+// stream, using the octet-counting framing from RFC6587. The message body is
+// the JSON encoded event and the MSGID is the event type.
+type syslogSink struct {
+	name      string
+	address   string
+	tlsConfig *tls.Config
+	facility  int
+	hostname  string
+	procID    string
+	timeout   time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogSink creates a sink sending events to the syslog server at
+// address ("host:port"). facility is a syslog facility name such as "local0";
+// an empty facility defaults to "local0". A nil tlsConfig means that the
+// connection is not encrypted.
+func NewSyslogSink(name string, address string, tlsConfig *tls.Config, facility string) (Sink, error) {
+	if facility == "" {
+		facility = "local0"
+	}
+	fac, ok := syslogFacilities[facility]
+	if !ok {
+		return nil, fmt.Errorf("unknown syslog facility %q", facility)
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return nil, fmt.Errorf("invalid syslog address %q: %w", address, err)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{
+		name:      name,
+		address:   address,
+		tlsConfig: tlsConfig,
+		facility:  fac,
+		hostname:  hostname,
+		procID:    strconv.Itoa(os.Getpid()),
+		timeout:   10 * time.Second,
+	}, nil
+}
+
+func (s *syslogSink) Name() string {
+	return s.name
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: s.timeout}
+	if s.tlsConfig != nil {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}
+		return tlsDialer.DialContext(ctx, "tcp", s.address)
+	}
+	return dialer.DialContext(ctx, "tcp", s.address)
+}
+
+func (s *syslogSink) Write(ctx context.Context, events []*tetragon.GetEventsResponse) (int, error) {
+	var buf bytes.Buffer
+	for _, ev := range events {
+		if err := s.appendMessage(&buf, ev); err != nil {
+			return 0, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to connect to syslog server %q: %w", s.address, err)
+		}
+		s.conn = conn
+	}
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	s.conn.SetWriteDeadline(deadline)
+	n, err := s.conn.Write(buf.Bytes())
+	if err != nil {
+		// Drop the connection so that the next attempt reconnects.
+		s.conn.Close()
+		s.conn = nil
+		return 0, fmt.Errorf("failed to write to syslog server %q: %w", s.address, err)
+	}
+	return n, nil
+}
+
+// appendMessage appends a single octet-counted RFC5424 message to buf.
+func (s *syslogSink) appendMessage(buf *bytes.Buffer, ev *tetragon.GetEventsResponse) error {
+	var body bytes.Buffer
+	if err := encoder.NewProtojsonEncoder(&body).Encode(ev); err != nil {
+		return err
+	}
+
+	hostname := ev.GetNodeName()
+	if hostname == "" {
+		hostname = s.hostname
+	}
+	ts := time.Now()
+	if ev.GetTime() != nil {
+		ts = ev.GetTime().AsTime()
+	}
+	msgID, err := helpers.ResponseTypeString(ev)
+	if err != nil {
+		msgID = "-"
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		s.facility*8+syslogSeverityInfo,
+		ts.UTC().Format(syslogTimeFormat),
+		hostname, syslogAppName, s.procID, msgID,
+		bytes.TrimSpace(body.Bytes()))
+	fmt.Fprintf(buf, "%d %s", len(msg), msg)
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
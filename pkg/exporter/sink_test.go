@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+type fakeSink struct {
+	name     string
+	mu       sync.Mutex
+	failures int
+	batches  [][]*tetragon.GetEventsResponse
+	closed   bool
+}
+
+func (s *fakeSink) Name() string {
+	return s.name
+}
+
+func (s *fakeSink) Write(_ context.Context, events []*tetragon.GetEventsResponse) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return 0, errors.New("sink unavailable")
+	}
+	s.batches = append(s.batches, events)
+	return 10 * len(events), nil
+}
+
+func (s *fakeSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeSink) eventCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, b := range s.batches {
+		n += len(b)
+	}
+	return n
+}
+
+func testEvent(binary string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: binary}},
+		},
+		NodeName: "node1",
+		Time:     timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+	}
+}
+
+func TestSinkEncoderBatches(t *testing.T) {
+	sink := &fakeSink{name: "batches"}
+	enc := NewSinkEncoder(context.Background(), sink, SinkOptions{
+		BatchSize:     3,
+		FlushInterval: time.Hour,
+	})
+	for range 7 {
+		require.NoError(t, enc.Encode(testEvent("/bin/true")))
+	}
+	require.Eventually(t, func() bool { return sink.eventCount() == 6 }, 5*time.Second, 10*time.Millisecond)
+
+	// Closing flushes the last, incomplete batch.
+	require.NoError(t, enc.Close())
+	assert.Equal(t, 7, sink.eventCount())
+	assert.Len(t, sink.batches, 3)
+	assert.True(t, sink.closed)
+}
+
+func TestSinkEncoderRetries(t *testing.T) {
+	sink := &fakeSink{name: "retries", failures: 2}
+	enc := NewSinkEncoder(context.Background(), sink, SinkOptions{
+		BatchSize:      1,
+		InitialBackoff: time.Millisecond,
+	})
+	defer enc.Close()
+	require.NoError(t, enc.Encode(testEvent("/bin/true")))
+	require.Eventually(t, func() bool { return sink.eventCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.InDelta(t, 2, testutil.ToFloat64(sinkErrors.WithLabelValues("retries")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(sinkEventsSent.WithLabelValues("retries")), 0)
+}
+
+func TestSinkEncoderExporterBytes(t *testing.T) {
+	sink := &fakeSink{name: "bytes-sink"}
+	enc := NewSinkEncoder(context.Background(), sink, SinkOptions{
+		BatchSize: 2,
+		Exporter:  "bytes",
+	})
+	require.NoError(t, enc.Encode(testEvent("/bin/true")))
+	require.NoError(t, enc.Encode(testEvent("/bin/false")))
+	require.NoError(t, enc.Close())
+	// fakeSink reports 10 bytes per event.
+	assert.InDelta(t, 20, testutil.ToFloat64(exporterBytesExported.WithLabelValues("bytes")), 0)
+}
+
+func TestSinkEncoderDropsAfterRetries(t *testing.T) {
+	sink := &fakeSink{name: "drops", failures: 100}
+	enc := NewSinkEncoder(context.Background(), sink, SinkOptions{
+		BatchSize:      2,
+		MaxRetries:     1,
+		InitialBackoff: time.Millisecond,
+	})
+	defer enc.Close()
+	require.NoError(t, enc.Encode(testEvent("/bin/true")))
+	require.NoError(t, enc.Encode(testEvent("/bin/false")))
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(sinkEventsDropped.WithLabelValues(sinkDropSendFailed, "drops")) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, sink.eventCount())
+}
+
+func TestSinkEncoderBlocking(t *testing.T) {
+	sink := &fakeSink{name: "blocking", failures: 10}
+	enc := NewSinkEncoder(context.Background(), sink, SinkOptions{
+		BatchSize:      1,
+		QueueSize:      1,
+		MaxRetries:     1,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Blocking:       true,
+	})
+	defer enc.Close()
+	for range 5 {
+		require.NoError(t, enc.Encode(testEvent("/bin/true")))
+	}
+	require.Eventually(t, func() bool { return sink.eventCount() == 5 }, 5*time.Second, 10*time.Millisecond)
+	assert.InDelta(t, 0, testutil.ToFloat64(sinkEventsDropped.WithLabelValues(sinkDropSendFailed, "blocking")), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(sinkEventsDropped.WithLabelValues(sinkDropQueueFull, "blocking")), 0)
+}
+
+func TestSyslogSink(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 2)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			// octet-counting framing: "<len> <msg>"
+			lenStr, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(lenStr))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err := io.ReadFull(r, msg); err != nil {
+				return
+			}
+			received <- string(msg)
+		}
+	}()
+
+	sink, err := NewSyslogSink("syslog", ln.Addr().String(), nil, "local3")
+	require.NoError(t, err)
+	defer sink.Close()
+	_, err = sink.Write(context.Background(), []*tetragon.GetEventsResponse{
+		testEvent("/usr/bin/curl"),
+		testEvent("/usr/bin/wget"),
+	})
+	require.NoError(t, err)
+
+	for _, binary := range []string{"/usr/bin/curl", "/usr/bin/wget"} {
+		msg := <-received
+		// local3 (19) * 8 + info (6) = 158
+		prefix := "<158>1 2024-01-02T03:04:05.000006Z node1 tetragon "
+		require.True(t, strings.HasPrefix(msg, prefix), msg)
+		fields := strings.SplitN(strings.TrimPrefix(msg, prefix), " ", 4)
+		require.Len(t, fields, 4)
+		assert.Equal(t, "PROCESS_EXEC", fields[1])
+		assert.Equal(t, "-", fields[2])
+		var ev tetragon.GetEventsResponse
+		require.NoError(t, ev.UnmarshalJSON([]byte(fields[3])))
+		assert.Equal(t, binary, ev.GetProcessExec().GetProcess().GetBinary())
+	}
+
+	_, err = NewSyslogSink("syslog", ln.Addr().String(), nil, "nosuchfacility")
+	require.Error(t, err)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var mu sync.Mutex
+	var lines []string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, strings.Split(strings.TrimSpace(string(body)), "\n")...)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink, err := NewHTTPSink("http", srv.URL, nil, map[string]string{"Authorization": "Bearer token"}, false)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	_, err = sink.Write(context.Background(), []*tetragon.GetEventsResponse{
+		testEvent("/usr/bin/curl"),
+		testEvent("/usr/bin/wget"),
+	})
+	require.NoError(t, err)
+	mu.Lock()
+	require.Len(t, lines, 2)
+	var ev map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &ev))
+	assert.Contains(t, ev, "process_exec")
+	status = http.StatusServiceUnavailable
+	mu.Unlock()
+
+	_, err = sink.Write(context.Background(), []*tetragon.GetEventsResponse{testEvent("/bin/true")})
+	require.Error(t, err)
+
+	_, err = NewHTTPSink("http", "ftp://example.com", nil, nil, false)
+	require.Error(t, err)
+}
+
+type fakeLogsService struct {
+	collogspb.UnimplementedLogsServiceServer
+	requests chan *collogspb.ExportLogsServiceRequest
+}
+
+func (s *fakeLogsService) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.requests <- req
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func TestOTLPSink(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	svc := &fakeLogsService{requests: make(chan *collogspb.ExportLogsServiceRequest, 1)}
+	collogspb.RegisterLogsServiceServer(srv, svc)
+	go srv.Serve(ln)
+	defer srv.Stop()
+
+	sink, err := NewOTLPSink("otlp", ln.Addr().String(), nil, nil)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	_, err = sink.Write(context.Background(), []*tetragon.GetEventsResponse{
+		testEvent("/usr/bin/curl"),
+		testEvent("/usr/bin/wget"),
+	})
+	require.NoError(t, err)
+	req := <-svc.requests
+	require.Len(t, req.ResourceLogs, 1)
+	rl := req.ResourceLogs[0]
+	assert.Contains(t, rl.GetResource().GetAttributes(), otlpStringAttr("host.name", "node1"))
+	require.Len(t, rl.ScopeLogs, 1)
+	records := rl.ScopeLogs[0].LogRecords
+	require.Len(t, records, 2)
+	assert.Equal(t, uint64(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC).UnixNano()), records[0].TimeUnixNano)
+	assert.Contains(t, records[1].GetBody().GetStringValue(), "/usr/bin/wget")
+	assert.Equal(t, "PROCESS_EXEC", records[1].GetAttributes()[0].GetValue().GetStringValue())
+}
+
+func TestParseSinkConfigs(t *testing.T) {
+	configs, err := ParseSinkConfigs(`
+- name: siem
+  type: syslog
+  endpoint: siem.example.com:6514
+  tls:
+    caFile: /etc/ssl/siem.pem
+  facility: local4
+  allowList: '{"event_set":["PROCESS_KPROBE"]}'
+  flushInterval: 5s
+- name: webhook
+  type: http
+  endpoint: https://hooks.example.com/tetragon
+  headers:
+    Authorization: Bearer secret
+  fieldFilters: '{"fields":"process.binary","action":"INCLUDE"}'
+`)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+
+	opts, err := configs[0].Options()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, opts.FlushInterval)
+	req, err := configs[0].Request(false)
+	require.NoError(t, err)
+	require.Len(t, req.AllowList, 1)
+	assert.Equal(t, []tetragon.EventType{tetragon.EventType_PROCESS_KPROBE}, req.AllowList[0].EventSet)
+
+	req, err = configs[1].Request(false)
+	require.NoError(t, err)
+	require.Len(t, req.FieldFilters, 1)
+
+	for _, bad := range []string{
+		`[{"name": "a", "type": "kafka", "endpoint": "x:1"}]`,
+		`[{"name": "", "type": "http", "endpoint": "http://x"}]`,
+		`[{"name": "a", "type": "http"}]`,
+		`[{"name": "a", "type": "http", "endpoint": "http://x"}, {"name": "a", "type": "http", "endpoint": "http://y"}]`,
+		`[{"name": "a", "type": "http", "endpoint": "http://x", "flushInterval": "soon"}]`,
+		`[{"name": "a", "type": "http", "endpoint": "http://x", "unknown": true}]`,
+	} {
+		_, err := ParseSinkConfigs(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+const (
+	SinkTypeOTLP   = "otlp"
+	SinkTypeSyslog = "syslog"
+	SinkTypeHTTP   = "http"
+)
+
+// TLSConfig configures TLS for network sinks.
+type TLSConfig struct {
+	// CAFile is the CA bundle used to verify the server certificate. The
+	// system roots are used if empty.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are the client certificate and key used for
+	// mutual TLS.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// ServerName overrides the name used to verify the server certificate.
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// TLSClientConfig builds a crypto/tls client configuration.
+func (c *TLSConfig) TLSClientConfig() (*tls.Config, error) {
+	if c == nil {
+		return nil, nil
+	}
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", c.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// SinkConfig describes a network export sink. Each sink is fed by its own
+// exporter, so it has its own filters in addition to its destination.
+type SinkConfig struct {
+	// Name identifies the sink in logs and in the "sink" metrics label.
+	Name string `json:"name"`
+	// Type is one of "otlp", "syslog" or "http".
+	Type string `json:"type"`
+	// Endpoint is "host:port" for otlp and syslog sinks, and a URL for
+	// http sinks.
+	Endpoint string     `json:"endpoint"`
+	TLS      *TLSConfig `json:"tls,omitempty"`
+	// Headers are added to every request (otlp and http sinks only).
+	Headers map[string]string `json:"headers,omitempty"`
+	// Facility is the syslog facility name (syslog sinks only).
+	Facility string `json:"facility,omitempty"`
+	// Compress enables gzip compression of request bodies (http sinks only).
+	Compress bool `json:"compress,omitempty"`
+
+	FilterConfig
+
+	BatchSize      int    `json:"batchSize,omitempty"`
+	FlushInterval  string `json:"flushInterval,omitempty"`
+	QueueSize      int    `json:"queueSize,omitempty"`
+	MaxRetries     int    `json:"maxRetries,omitempty"`
+	InitialBackoff string `json:"initialBackoff,omitempty"`
+	MaxBackoff     string `json:"maxBackoff,omitempty"`
+}
+
+// ParseSinkConfigs parses a YAML (or JSON) list of sink configurations.
+func ParseSinkConfigs(data string) ([]SinkConfig, error) {
+	var configs []SinkConfig
+	if err := yaml.UnmarshalStrict([]byte(data), &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse export sinks: %w", err)
+	}
+	names := make(map[string]struct{}, len(configs))
+	for i := range configs {
+		if err := configs[i].validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := names[configs[i].Name]; ok {
+			return nil, fmt.Errorf("duplicate export sink name %q", configs[i].Name)
+		}
+		names[configs[i].Name] = struct{}{}
+	}
+	return configs, nil
+}
+
+func (c *SinkConfig) validate() error {
+	if c.Name == "" {
+		return errors.New("export sink name must not be empty")
+	}
+	switch c.Type {
+	case SinkTypeOTLP, SinkTypeSyslog, SinkTypeHTTP:
+	default:
+		return fmt.Errorf("export sink %q: unknown type %q", c.Name, c.Type)
+	}
+	if c.Endpoint == "" {
+		return fmt.Errorf("export sink %q: endpoint must not be empty", c.Name)
+	}
+	if _, err := c.Options(); err != nil {
+		return err
+	}
+	if _, err := c.Redaction(); err != nil {
+		return fmt.Errorf("export sink %q: %w", c.Name, err)
+	}
+	return nil
+}
+
+// Options returns the batching and retry options of the sink.
+func (c *SinkConfig) Options() (SinkOptions, error) {
+	opts := SinkOptions{
+		BatchSize:  c.BatchSize,
+		QueueSize:  c.QueueSize,
+		MaxRetries: c.MaxRetries,
+	}
+	durations := []struct {
+		name  string
+		value string
+		dst   *time.Duration
+	}{
+		{"flushInterval", c.FlushInterval, &opts.FlushInterval},
+		{"initialBackoff", c.InitialBackoff, &opts.InitialBackoff},
+		{"maxBackoff", c.MaxBackoff, &opts.MaxBackoff},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		v, err := time.ParseDuration(d.value)
+		if err != nil {
+			return SinkOptions{}, fmt.Errorf("export sink %q: invalid %s: %w", c.Name, d.name, err)
+		}
+		*d.dst = v
+	}
+	return opts, nil
+}
+
+// Request builds the GetEventsRequest holding the filters of the sink.
+func (c *SinkConfig) Request(enablePidSetFilter bool) (*tetragon.GetEventsRequest, error) {
+	req, err := c.FilterConfig.Request(enablePidSetFilter)
+	if err != nil {
+		return nil, fmt.Errorf("export sink %q: %w", c.Name, err)
+	}
+	return req, nil
+}
+
+// NewSink creates the sink described by the configuration.
+func NewSink(c *SinkConfig) (Sink, error) {
+	tlsConfig, err := c.TLS.TLSClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("export sink %q: %w", c.Name, err)
+	}
+	switch c.Type {
+	case SinkTypeOTLP:
+		return NewOTLPSink(c.Name, c.Endpoint, tlsConfig, c.Headers)
+	case SinkTypeSyslog:
+		return NewSyslogSink(c.Name, c.Endpoint, tlsConfig, c.Facility)
+	case SinkTypeHTTP:
+		return NewHTTPSink(c.Name, c.Endpoint, tlsConfig, c.Headers, c.Compress)
+	}
+	return nil, fmt.Errorf("export sink %q: unknown type %q", c.Name, c.Type)
+}
@@ -10,6 +10,9 @@ import (
 	"regexp"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
@@ -96,6 +99,57 @@ func (f RedactionFilterList) Redact(binary, args string, envs []string) (string,
 	return args, envs
 }
 
+// RedactEvent returns event with the arguments and environment variables of
+// its processes redacted. Events are shared between the listeners of the
+// server, so a redacted copy is returned if anything was redacted.
+func (f RedactionFilterList) RedactEvent(event *tetragon.GetEventsResponse) *tetragon.GetEventsResponse {
+	redacted := proto.Clone(event).(*tetragon.GetEventsResponse)
+	if !f.redactMessage(redacted.ProtoReflect()) {
+		return event
+	}
+	return redacted
+}
+
+// redactMessage redacts the processes found in m, and returns whether any of
+// them was modified.
+func (f RedactionFilterList) redactMessage(m protoreflect.Message) bool {
+	modified := false
+	if p, ok := m.Interface().(*tetragon.Process); ok {
+		modified = f.redactProcess(p)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() != protoreflect.MessageKind || fd.IsMap():
+		case fd.IsList():
+			for i := range v.List().Len() {
+				modified = f.redactMessage(v.List().Get(i).Message()) || modified
+			}
+		default:
+			modified = f.redactMessage(v.Message()) || modified
+		}
+		return true
+	})
+	return modified
+}
+
+func (f RedactionFilterList) redactProcess(p *tetragon.Process) bool {
+	envs := make([]string, 0, len(p.EnvironmentVariables))
+	for _, env := range p.EnvironmentVariables {
+		envs = append(envs, env.Key+"="+env.Value)
+	}
+	args, envs := f.Redact(p.Binary, p.Arguments, envs)
+	modified := args != p.Arguments
+	p.Arguments = args
+	for i, env := range envs {
+		key, value, _ := strings.Cut(env, "=")
+		if key != p.EnvironmentVariables[i].Key || value != p.EnvironmentVariables[i].Value {
+			p.EnvironmentVariables[i] = &tetragon.EnvVar{Key: key, Value: value}
+			modified = true
+		}
+	}
+	return modified
+}
+
 // Redact resursively checks any string fields in the event for matches to
 // redaction regexes and replaces any capture groups with `*****`.
 //
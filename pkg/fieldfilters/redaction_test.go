@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
 func TestRedactString_Simple(t *testing.T) {
@@ -140,3 +142,36 @@ func TestRedact_ArgsWithEnvs(t *testing.T) {
 	str := strings.Join(envs, " ")
 	assert.Equal(t, "VAR1=XXX SSH_PASSWORD="+REDACTION_STR+" VAR2=YYY", str)
 }
+
+func TestRedactEvent(t *testing.T) {
+	filters, err := ParseRedactionFilterList(`{"redact": ["(?:--password)[\\s=]+(\\S+)", "(?:SSH_PASSWORD)[\\s=]+(\\S+)"]}`)
+	require.NoError(t, err)
+
+	event := &tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+		Process: &tetragon.Process{
+			Binary:               "/usr/bin/mysql",
+			Arguments:            "--password secret",
+			EnvironmentVariables: []*tetragon.EnvVar{{Key: "HOME", Value: "/root"}, {Key: "SSH_PASSWORD", Value: "secret"}},
+		},
+		Parent:    &tetragon.Process{Binary: "/bin/sh", Arguments: "-c true"},
+		Ancestors: []*tetragon.Process{{Binary: "/bin/sh", Arguments: "--password=secret"}},
+	}}}
+	redacted := filters.RedactEvent(event)
+	exec := redacted.GetProcessExec()
+	assert.Equal(t, "--password "+REDACTION_STR, exec.Process.Arguments)
+	require.Len(t, exec.Process.EnvironmentVariables, 2)
+	assert.Equal(t, "/root", exec.Process.EnvironmentVariables[0].Value)
+	assert.Equal(t, REDACTION_STR, exec.Process.EnvironmentVariables[1].Value)
+	assert.Equal(t, "-c true", exec.Parent.Arguments)
+	assert.Equal(t, "--password="+REDACTION_STR, exec.Ancestors[0].Arguments)
+
+	// the event is shared with other listeners and left untouched
+	assert.Equal(t, "--password secret", event.GetProcessExec().Process.Arguments)
+	assert.Equal(t, "secret", event.GetProcessExec().Process.EnvironmentVariables[1].Value)
+
+	// events with nothing to redact are not copied
+	event.GetProcessExec().Process.Arguments = "--help"
+	event.GetProcessExec().Process.EnvironmentVariables = nil
+	event.GetProcessExec().Ancestors = nil
+	assert.Same(t, event, filters.RedactEvent(event))
+}
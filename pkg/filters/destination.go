@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/event"
+)
+
+func getArgs(ev *event.Event) []*tetragon.KprobeArgument {
+	if ev == nil {
+		return nil
+	}
+	response, ok := ev.Event.(*tetragon.GetEventsResponse)
+	if !ok {
+		return nil
+	}
+
+	switch ev := (response.Event).(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.GetArgs()
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return ev.ProcessTracepoint.GetArgs()
+	case *tetragon.GetEventsResponse_ProcessUprobe:
+		return ev.ProcessUprobe.GetArgs()
+	case *tetragon.GetEventsResponse_ProcessUsdt:
+		return ev.ProcessUsdt.GetArgs()
+	case *tetragon.GetEventsResponse_ProcessLsm:
+		return ev.ProcessLsm.GetArgs()
+	default:
+		return nil
+	}
+}
+
+func getDestination(arg *tetragon.KprobeArgument) *tetragon.NetworkEndpoint {
+	switch a := arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_SockArg:
+		return a.SockArg.GetDestination()
+	case *tetragon.KprobeArgument_SkbArg:
+		return a.SkbArg.GetDestination()
+	case *tetragon.KprobeArgument_SockaddrArg:
+		return a.SockaddrArg.GetDestination()
+	default:
+		return nil
+	}
+}
+
+// filterByDestinationWorkload matches events with a network argument whose
+// destination workload is one of workloads, either as "workload" or as
+// "namespace/workload".
+func filterByDestinationWorkload(workloads []string) FilterFunc {
+	return func(ev *event.Event) bool {
+		for _, arg := range getArgs(ev) {
+			dest := getDestination(arg)
+			if dest.GetWorkload() == "" {
+				continue
+			}
+			for _, workload := range workloads {
+				if workload == dest.Workload || workload == dest.Namespace+"/"+dest.Workload {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+type DestinationWorkloadFilter struct{}
+
+func (f *DestinationWorkloadFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]FilterFunc, error) {
+	var fs []FilterFunc
+	if ff.DestinationWorkload != nil {
+		fs = append(fs, filterByDestinationWorkload(ff.DestinationWorkload))
+	}
+	return fs, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/event"
+)
+
+func TestDestinationWorkloadFilter(t *testing.T) {
+	f := []*tetragon.Filter{{DestinationWorkload: []string{"web", "db/postgres"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&DestinationWorkloadFilter{}})
+	require.NoError(t, err)
+
+	kprobeEvent := func(args ...*tetragon.KprobeArgument) *event.Event {
+		return &event.Event{
+			Event: &tetragon.GetEventsResponse{
+				Event: &tetragon.GetEventsResponse_ProcessKprobe{
+					ProcessKprobe: &tetragon.ProcessKprobe{Args: args},
+				},
+			},
+		}
+	}
+	sockArg := func(namespace, workload string) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+			Destination: &tetragon.NetworkEndpoint{Namespace: namespace, Workload: workload},
+		}}}
+	}
+
+	assert.True(t, fl.MatchOne(kprobeEvent(sockArg("default", "web"))))
+	assert.True(t, fl.MatchOne(kprobeEvent(sockArg("db", "postgres"))))
+	assert.False(t, fl.MatchOne(kprobeEvent(sockArg("default", "postgres"))))
+	assert.False(t, fl.MatchOne(kprobeEvent(sockArg("default", ""))))
+	assert.True(t, fl.MatchOne(kprobeEvent(
+		&tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_IntArg{IntArg: 1}},
+		&tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockaddrArg{SockaddrArg: &tetragon.KprobeSockaddr{
+			Destination: &tetragon.NetworkEndpoint{Namespace: "default", Workload: "web"},
+		}}},
+	)))
+	assert.False(t, fl.MatchOne(kprobeEvent(
+		&tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SkbArg{SkbArg: &tetragon.KprobeSkb{}}},
+	)))
+	assert.True(t, fl.MatchOne(&event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessLsm{
+				ProcessLsm: &tetragon.ProcessLsm{Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_SkbArg{SkbArg: &tetragon.KprobeSkb{
+						Destination: &tetragon.NetworkEndpoint{Namespace: "default", Workload: "web"},
+					}}},
+				}},
+			},
+		},
+	}))
+	assert.False(t, fl.MatchOne(&event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{}},
+		},
+	}))
+	assert.False(t, fl.MatchOne(nil))
+}
@@ -152,6 +152,7 @@ var Filters = []OnBuildFilter{
 	&InInitTreeFilter{},
 	NewCELExpressionFilter(logger.GetLogger()),
 	&ContainerNameFilter{},
+	&DestinationWorkloadFilter{},
 }
 
 func GetProcess(event *event.Event) *tetragon.Process {
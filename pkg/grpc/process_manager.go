@@ -7,24 +7,29 @@ import (
 	"context"
 	"sync"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/eventcache"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/metrics/eventmetrics"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/reader/notify"
 	"github.com/cilium/tetragon/pkg/rthooks"
 	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sequences"
 	"github.com/cilium/tetragon/pkg/server"
 )
 
 // ProcessManager maintains a cache of processes from tetragon exec events.
 type ProcessManager struct {
 	Server *server.Server
-	// synchronize access to the listeners map.
+	// synchronize access to the listeners map and to the history.
 	mux       sync.Mutex
 	listeners map[server.Listener]struct{}
+	history   *server.EventHistory
 }
 
 // NewProcessManager returns a pointer to an initialized ProcessManager struct.
@@ -36,6 +41,7 @@ func NewProcessManager(
 ) (*ProcessManager, error) {
 	pm := &ProcessManager{
 		listeners: make(map[server.Listener]struct{}),
+		history:   server.NewEventHistory(option.Config.EventHistorySize),
 	}
 
 	pm.Server = server.NewServer(ctx, wg, pm, manager, hookRunner)
@@ -71,6 +77,24 @@ func (pm *ProcessManager) AddListener(listener server.Listener) {
 	pm.listeners[listener] = struct{}{}
 }
 
+// AddListenerFrom implements server.ResumableNotifier.AddListenerFrom.
+func (pm *ProcessManager) AddListenerFrom(listener server.Listener, seq uint64, instanceID string) ([]*tetragon.GetEventsResponse, *tetragon.GetEventsResponse) {
+	logger.GetLogger().Debug("Adding a getEventsListener", "getEventsListener", listener, "resumeFrom", seq, "resumeInstanceID", instanceID)
+	pm.mux.Lock()
+	defer pm.mux.Unlock()
+	pm.listeners[listener] = struct{}{}
+	events, gap := pm.history.Since(seq, instanceID)
+	if gap == nil {
+		return events, nil
+	}
+	gapEvent := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_EventsGap{EventsGap: gap},
+		Time:  timestamppb.Now(),
+	}
+	node.SetCommonFields(gapEvent)
+	return events, gapEvent
+}
+
 func (pm *ProcessManager) RemoveListener(listener server.Listener) {
 	logger.GetLogger().Debug("Removing a getEventsListener", "getEventsListener", listener)
 	pm.mux.Lock()
@@ -79,9 +103,22 @@ func (pm *ProcessManager) RemoveListener(listener server.Listener) {
 }
 
 func (pm *ProcessManager) NotifyListener(original any, processed *tetragon.GetEventsResponse) {
+	// Events reaching a step of a policy sequence are recorded, and the
+	// event completing the sequence references the earlier ones.
+	if ev, ok := original.(sequences.Event); ok {
+		ev.SequenceStep().Handle(ev.SequenceHook(), processed)
+	}
+
+	// Events of hooks with policy metrics only update the metrics, unless
+	// the policy asks to keep them.
+	if ev, ok := original.(hookmetrics.Event); ok && !ev.HookMetrics().Handle(processed) {
+		return
+	}
+
 	pm.mux.Lock()
 	defer pm.mux.Unlock()
 	node.SetCommonFields(processed)
+	pm.history.Add(processed)
 	for l := range pm.listeners {
 		l.Notify(processed)
 	}
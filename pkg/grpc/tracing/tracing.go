@@ -15,12 +15,15 @@ import (
 	"github.com/cilium/tetragon/pkg/api/processapi"
 	"github.com/cilium/tetragon/pkg/api/tracingapi"
 	"github.com/cilium/tetragon/pkg/constants"
+	"github.com/cilium/tetragon/pkg/dnscache"
 	"github.com/cilium/tetragon/pkg/eventcache"
 	gt "github.com/cilium/tetragon/pkg/generictypes"
+	"github.com/cilium/tetragon/pkg/ipcache"
 	"github.com/cilium/tetragon/pkg/ksyms"
 	"github.com/cilium/tetragon/pkg/ktime"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/process"
 	"github.com/cilium/tetragon/pkg/procsyms"
@@ -30,6 +33,7 @@ import (
 	"github.com/cilium/tetragon/pkg/reader/network"
 	"github.com/cilium/tetragon/pkg/reader/notify"
 	"github.com/cilium/tetragon/pkg/reader/path"
+	"github.com/cilium/tetragon/pkg/sequences"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -111,6 +115,36 @@ func getKprobeArgInt(arg tracingapi.MsgGenericKprobeArgInt, a *tetragon.KprobeAr
 	a.Label = arg.Label
 }
 
+// getDestination returns the Kubernetes identity of the destination address
+// of a network argument, if destination enrichment is enabled.
+func getDestination(addr string) *tetragon.NetworkEndpoint {
+	if !option.Config.EnableDestinationEnrichment {
+		return nil
+	}
+	return ipcache.Get().Lookup(addr)
+}
+
+// addHostnames adds to the sock and sockaddr arguments the names their
+// address was resolved from in the pod of the process, if the passive DNS
+// cache is enabled.
+func addHostnames(proc *tetragon.Process, args []*tetragon.KprobeArgument) {
+	if !option.Config.EnablePassiveDNSCache {
+		return
+	}
+	var pod string
+	if p := proc.GetPod(); p != nil {
+		pod = p.Namespace + "/" + p.Name
+	}
+	for _, arg := range args {
+		switch a := arg.GetArg().(type) {
+		case *tetragon.KprobeArgument_SockArg:
+			a.SockArg.Hostnames = dnscache.Lookup(pod, a.SockArg.Daddr)
+		case *tetragon.KprobeArgument_SockaddrArg:
+			a.SockaddrArg.Hostnames = dnscache.Lookup(pod, a.SockaddrArg.Addr)
+		}
+	}
+}
+
 func getKprobeArgument(arg tracingapi.MsgGenericKprobeArg) *tetragon.KprobeArgument {
 	a := &tetragon.KprobeArgument{}
 	switch e := arg.(type) {
@@ -136,17 +170,18 @@ func getKprobeArgument(arg tracingapi.MsgGenericKprobeArg) *tetragon.KprobeArgum
 		a.Label = e.Label
 	case tracingapi.MsgGenericKprobeArgSock:
 		sockArg := &tetragon.KprobeSock{
-			Cookie:   e.Sockaddr,
-			Family:   network.InetFamily(e.Family),
-			State:    network.TcpState(e.State),
-			Type:     network.InetType(e.Type),
-			Protocol: network.InetProtocol(e.Protocol),
-			Mark:     e.Mark,
-			Priority: e.Priority,
-			Saddr:    e.Saddr,
-			Daddr:    e.Daddr,
-			Sport:    e.Sport,
-			Dport:    e.Dport,
+			Cookie:      e.Sockaddr,
+			Family:      network.InetFamily(e.Family),
+			State:       network.TcpState(e.State),
+			Type:        network.InetType(e.Type),
+			Protocol:    network.InetProtocol(e.Protocol),
+			Mark:        e.Mark,
+			Priority:    e.Priority,
+			Saddr:       e.Saddr,
+			Daddr:       e.Daddr,
+			Sport:       e.Sport,
+			Dport:       e.Dport,
+			Destination: getDestination(e.Daddr),
 		}
 		a.Arg = &tetragon.KprobeArgument_SockArg{SockArg: sockArg}
 		a.Label = e.Label
@@ -165,14 +200,16 @@ func getKprobeArgument(arg tracingapi.MsgGenericKprobeArg) *tetragon.KprobeArgum
 			SecPathLen:  e.SecPathLen,
 			SecPathOlen: e.SecPathOLen,
 			Family:      network.InetFamily(e.Family),
+			Destination: getDestination(e.Daddr),
 		}
 		a.Arg = &tetragon.KprobeArgument_SkbArg{SkbArg: skbArg}
 		a.Label = e.Label
 	case tracingapi.MsgGenericKprobeArgSockaddr:
 		sockaddrArg := &tetragon.KprobeSockaddr{
-			Family: network.InetFamily(e.SinFamily),
-			Addr:   e.SinAddr,
-			Port:   e.SinPort,
+			Family:      network.InetFamily(e.SinFamily),
+			Addr:        e.SinAddr,
+			Port:        e.SinPort,
+			Destination: getDestination(e.SinAddr),
 		}
 		a.Arg = &tetragon.KprobeArgument_SockaddrArg{SockaddrArg: sockaddrArg}
 		a.Label = e.Label
@@ -411,6 +448,8 @@ func GetProcessKprobe(event *MsgGenericKprobeUnix) *tetragon.ProcessKprobe {
 		userStackTrace = append(userStackTrace, entry)
 	}
 
+	addHostnames(tetragonProcess, tetragonArgs)
+
 	tetragonEvent := &tetragon.ProcessKprobe{
 		Process:          tetragonProcess,
 		Parent:           tetragonParent,
@@ -426,6 +465,7 @@ func GetProcessKprobe(event *MsgGenericKprobeUnix) *tetragon.ProcessKprobe {
 		PolicyName:       event.PolicyName,
 		Message:          event.Message,
 		Tags:             event.Tags,
+		SampleRate:       event.Msg.SampleRate,
 	}
 
 	if tetragonProcess.Pid == nil {
@@ -467,6 +507,8 @@ type MsgGenericTracepointUnix struct {
 	PolicyName string
 	Message    string
 	Tags       []string
+	Metrics    *hookmetrics.Hook
+	Step       *sequences.Step
 }
 
 func (msg *MsgGenericTracepointUnix) Notify() bool {
@@ -565,6 +607,7 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 				Protocol:    network.InetProtocol(uint16(v.Proto)),
 				SecPathLen:  v.SecPathLen,
 				SecPathOlen: v.SecPathOLen,
+				Destination: getDestination(v.Daddr),
 			}
 
 			tetragonArgs = append(tetragonArgs, &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SkbArg{
@@ -573,17 +616,18 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 
 		case tracingapi.MsgGenericKprobeArgSock:
 			sk := tetragon.KprobeSock{
-				Family:   familyString(v.Family),
-				Type:     network.InetType(v.Type),
-				Protocol: network.InetProtocol(uint16(v.Protocol)),
-				Mark:     v.Mark,
-				Priority: v.Priority,
-				Saddr:    v.Saddr,
-				Daddr:    v.Daddr,
-				Sport:    v.Sport,
-				Dport:    v.Dport,
-				Cookie:   v.Sockaddr,
-				State:    network.TcpState(v.State),
+				Family:      familyString(v.Family),
+				Type:        network.InetType(v.Type),
+				Protocol:    network.InetProtocol(uint16(v.Protocol)),
+				Mark:        v.Mark,
+				Priority:    v.Priority,
+				Saddr:       v.Saddr,
+				Daddr:       v.Daddr,
+				Sport:       v.Sport,
+				Dport:       v.Dport,
+				Cookie:      v.Sockaddr,
+				State:       network.TcpState(v.State),
+				Destination: getDestination(v.Daddr),
 			}
 
 			tetragonArgs = append(tetragonArgs, &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockArg{
@@ -592,9 +636,10 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 
 		case tracingapi.MsgGenericKprobeArgSockaddr:
 			address := tetragon.KprobeSockaddr{
-				Family: familyString(v.SinFamily),
-				Addr:   v.SinAddr,
-				Port:   v.SinPort,
+				Family:      familyString(v.SinFamily),
+				Addr:        v.SinAddr,
+				Port:        v.SinPort,
+				Destination: getDestination(v.SinAddr),
 			}
 
 			tetragonArgs = append(tetragonArgs, &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockaddrArg{
@@ -634,6 +679,8 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 		}
 	}
 
+	addHostnames(tetragonProcess, tetragonArgs)
+
 	tetragonEvent := &tetragon.ProcessTracepoint{
 		Process:    tetragonProcess,
 		Parent:     tetragonParent,
@@ -645,6 +692,7 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 		Message:    msg.Message,
 		Tags:       msg.Tags,
 		Action:     kprobeAction(msg.Msg.ActionId),
+		SampleRate: msg.Msg.SampleRate,
 	}
 
 	if tetragonProcess.Pid == nil {
@@ -690,6 +738,18 @@ func (msg *MsgGenericTracepointUnix) PolicyInfo() tracingpolicy.PolicyInfo {
 	}
 }
 
+func (msg *MsgGenericTracepointUnix) HookMetrics() *hookmetrics.Hook {
+	return msg.Metrics
+}
+
+func (msg *MsgGenericTracepointUnix) SequenceStep() *sequences.Step {
+	return msg.Step
+}
+
+func (msg *MsgGenericTracepointUnix) SequenceHook() string {
+	return msg.PolicyInfo().Hook
+}
+
 type MsgGenericKprobeUnix struct {
 	Msg              *tracingapi.MsgGenericKprobe
 	ReturnAction     uint64
@@ -701,6 +761,8 @@ type MsgGenericKprobeUnix struct {
 	KernelStackTrace [constants.PERF_MAX_STACK_DEPTH]uint64
 	UserStackTrace   [constants.PERF_MAX_STACK_DEPTH]uint64
 	Tags             []string
+	Metrics          *hookmetrics.Hook
+	Step             *sequences.Step
 }
 
 func (msg *MsgGenericKprobeUnix) GetArgs() *[]tracingapi.MsgGenericKprobeArg {
@@ -742,6 +804,18 @@ func (msg *MsgGenericKprobeUnix) PolicyInfo() tracingpolicy.PolicyInfo {
 	}
 }
 
+func (msg *MsgGenericKprobeUnix) HookMetrics() *hookmetrics.Hook {
+	return msg.Metrics
+}
+
+func (msg *MsgGenericKprobeUnix) SequenceStep() *sequences.Step {
+	return msg.Step
+}
+
+func (msg *MsgGenericKprobeUnix) SequenceHook() string {
+	return msg.PolicyInfo().Hook
+}
+
 type MsgProcessLoaderUnix struct {
 	Msg     *tracingapi.MsgLoader
 	Path    string
@@ -875,6 +949,8 @@ func GetProcessUprobe(event *MsgGenericUprobeUnix) *tetragon.ProcessUprobe {
 		tetragonData = append(tetragonData, getKprobeArgument(data))
 	}
 
+	addHostnames(tetragonProcess, tetragonArgs)
+
 	tetragonEvent := &tetragon.ProcessUprobe{
 		Process:      tetragonProcess,
 		Parent:       tetragonParent,
@@ -889,6 +965,7 @@ func GetProcessUprobe(event *MsgGenericUprobeUnix) *tetragon.ProcessUprobe {
 		Offset:       event.Offset,
 		RefCtrOffset: event.RefCtrOffset,
 		Action:       kprobeAction(event.Msg.ActionId),
+		SampleRate:   event.Msg.SampleRate,
 	}
 
 	if tetragonProcess.Pid == nil {
@@ -983,6 +1060,8 @@ func GetProcessUsdt(event *MsgGenericUsdtUnix) *tetragon.ProcessUsdt {
 		tetragonArgs = append(tetragonArgs, getKprobeArgument(arg))
 	}
 
+	addHostnames(tetragonProcess, tetragonArgs)
+
 	tetragonEvent := &tetragon.ProcessUsdt{
 		Process:    tetragonProcess,
 		Parent:     tetragonParent,
@@ -995,6 +1074,7 @@ func GetProcessUsdt(event *MsgGenericUsdtUnix) *tetragon.ProcessUsdt {
 		Args:       tetragonArgs,
 		Tags:       event.Tags,
 		Action:     kprobeAction(event.Msg.ActionId),
+		SampleRate: event.Msg.SampleRate,
 	}
 
 	if tetragonProcess.Pid == nil {
@@ -1110,6 +1190,8 @@ func GetProcessLsm(event *MsgGenericLsmUnix) *tetragon.ProcessLsm {
 		tetragonArgs = append(tetragonArgs, a)
 	}
 
+	addHostnames(tetragonProcess, tetragonArgs)
+
 	tetragonEvent := &tetragon.ProcessLsm{
 		Process:      tetragonProcess,
 		Parent:       tetragonParent,
@@ -1120,6 +1202,7 @@ func GetProcessLsm(event *MsgGenericLsmUnix) *tetragon.ProcessLsm {
 		PolicyName:   event.PolicyName,
 		Message:      event.Message,
 		Tags:         event.Tags,
+		SampleRate:   event.Msg.SampleRate,
 	}
 
 	switch event.ImaHash.Algo {
@@ -1172,9 +1255,11 @@ func GetProcessLsm(event *MsgGenericLsmUnix) *tetragon.ProcessLsm {
 }
 
 type MsgProcessThrottleUnix struct {
-	Type   tetragon.ThrottleType
-	Cgroup string
-	Ktime  uint64
+	Type            tetragon.ThrottleType
+	Cgroup          string
+	PolicyName      string
+	PolicyNamespace string
+	Ktime           uint64
 }
 
 func (msg *MsgProcessThrottleUnix) Notify() bool {
@@ -1191,8 +1276,10 @@ func (msg *MsgProcessThrottleUnix) Retry(_ *process.ProcessInternal, _ notify.Ev
 
 func (msg *MsgProcessThrottleUnix) HandleMessage() *tetragon.GetEventsResponse {
 	event := &tetragon.ProcessThrottle{
-		Type:   msg.Type,
-		Cgroup: msg.Cgroup,
+		Type:            msg.Type,
+		Cgroup:          msg.Cgroup,
+		PolicyName:      msg.PolicyName,
+		PolicyNamespace: msg.PolicyNamespace,
 	}
 	return &tetragon.GetEventsResponse{
 		Event: &tetragon.GetEventsResponse_ProcessThrottle{ProcessThrottle: event},
@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package ipcache maps IP addresses to the Kubernetes pods and services they
+// belong to, so that network arguments of events can be enriched with the
+// identity of their destination.
+package ipcache
+
+import (
+	"net/netip"
+	"sync"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+var global = New()
+
+// Get returns the IP cache fed by the Kubernetes watchers of the agent.
+func Get() *Cache {
+	return global
+}
+
+type podIdentity struct {
+	namespace    string
+	name         string
+	workload     string
+	workloadKind string
+}
+
+type serviceIdentity struct {
+	namespace string
+	name      string
+}
+
+// backend is an address of an endpoint slice.
+type backend struct {
+	service serviceIdentity
+	// pod is the name of the pod the address belongs to, if any
+	pod string
+}
+
+// Cache is an IP to Kubernetes identity cache. The IPs of every object
+// are tracked so that updating or deleting an object removes its stale IPs.
+type Cache struct {
+	mu sync.RWMutex
+
+	pods   map[netip.Addr]podIdentity
+	podIPs map[string][]netip.Addr
+
+	services   map[netip.Addr]serviceIdentity
+	serviceIPs map[string][]netip.Addr
+
+	// an address can belong to several endpoint slices, so backends are
+	// indexed by IP and by endpoint slice key
+	backends   map[netip.Addr]map[string]backend
+	backendIPs map[string][]netip.Addr
+}
+
+// New creates an empty IP cache.
+func New() *Cache {
+	return &Cache{
+		pods:       map[netip.Addr]podIdentity{},
+		podIPs:     map[string][]netip.Addr{},
+		services:   map[netip.Addr]serviceIdentity{},
+		serviceIPs: map[string][]netip.Addr{},
+		backends:   map[netip.Addr]map[string]backend{},
+		backendIPs: map[string][]netip.Addr{},
+	}
+}
+
+func parseAddrs(ips []string) []netip.Addr {
+	var ret []netip.Addr
+	for _, ip := range ips {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, addr.Unmap())
+	}
+	return ret
+}
+
+func objectKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func (c *Cache) upsertPod(id podIdentity, ips []string) {
+	key := objectKey(id.namespace, id.name)
+	addrs := parseAddrs(ips)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deletePodLocked(key)
+	if len(addrs) == 0 {
+		return
+	}
+	for _, addr := range addrs {
+		c.pods[addr] = id
+	}
+	c.podIPs[key] = addrs
+}
+
+func (c *Cache) deletePod(namespace, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deletePodLocked(objectKey(namespace, name))
+}
+
+func (c *Cache) deletePodLocked(key string) {
+	for _, addr := range c.podIPs[key] {
+		// the IP may have been reused by another pod since
+		if id, ok := c.pods[addr]; ok && objectKey(id.namespace, id.name) == key {
+			delete(c.pods, addr)
+		}
+	}
+	delete(c.podIPs, key)
+}
+
+func (c *Cache) upsertService(id serviceIdentity, ips []string) {
+	key := objectKey(id.namespace, id.name)
+	addrs := parseAddrs(ips)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteServiceLocked(key)
+	if len(addrs) == 0 {
+		return
+	}
+	for _, addr := range addrs {
+		c.services[addr] = id
+	}
+	c.serviceIPs[key] = addrs
+}
+
+func (c *Cache) deleteService(namespace, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteServiceLocked(objectKey(namespace, name))
+}
+
+func (c *Cache) deleteServiceLocked(key string) {
+	for _, addr := range c.serviceIPs[key] {
+		if id, ok := c.services[addr]; ok && objectKey(id.namespace, id.name) == key {
+			delete(c.services, addr)
+		}
+	}
+	delete(c.serviceIPs, key)
+}
+
+// upsertBackends replaces the addresses of the endpoint slice key.
+func (c *Cache) upsertBackends(key string, backends map[netip.Addr]backend) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteBackendsLocked(key)
+	if len(backends) == 0 {
+		return
+	}
+	addrs := make([]netip.Addr, 0, len(backends))
+	for addr, b := range backends {
+		m := c.backends[addr]
+		if m == nil {
+			m = map[string]backend{}
+			c.backends[addr] = m
+		}
+		m[key] = b
+		addrs = append(addrs, addr)
+	}
+	c.backendIPs[key] = addrs
+}
+
+func (c *Cache) deleteBackends(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteBackendsLocked(key)
+}
+
+func (c *Cache) deleteBackendsLocked(key string) {
+	for _, addr := range c.backendIPs[key] {
+		m := c.backends[addr]
+		delete(m, key)
+		if len(m) == 0 {
+			delete(c.backends, addr)
+		}
+	}
+	delete(c.backendIPs, key)
+}
+
+// backendLocked returns the backend of addr. If the address belongs to
+// several services, the first one in name order is returned so that the
+// result does not depend on the order of the updates.
+func (c *Cache) backendLocked(addr netip.Addr) (backend, bool) {
+	m := c.backends[addr]
+	if len(m) == 0 {
+		return backend{}, false
+	}
+	var ret backend
+	first := true
+	for _, b := range m {
+		if first || objectKey(b.service.namespace, b.service.name) < objectKey(ret.service.namespace, ret.service.name) {
+			ret = b
+			first = false
+		}
+	}
+	return ret, true
+}
+
+// Lookup returns the Kubernetes identity of ip, or nil if it is unknown.
+func (c *Cache) Lookup(ip string) *tetragon.NetworkEndpoint {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	addr = addr.Unmap()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if pod, ok := c.pods[addr]; ok {
+		ret := &tetragon.NetworkEndpoint{
+			Namespace:    pod.namespace,
+			PodName:      pod.name,
+			Workload:     pod.workload,
+			WorkloadKind: pod.workloadKind,
+		}
+		if b, ok := c.backendLocked(addr); ok && b.service.namespace == pod.namespace {
+			ret.Service = b.service.name
+		}
+		return ret
+	}
+	if svc, ok := c.services[addr]; ok {
+		return &tetragon.NetworkEndpoint{
+			Namespace: svc.namespace,
+			Service:   svc.name,
+		}
+	}
+	if b, ok := c.backendLocked(addr); ok {
+		return &tetragon.NetworkEndpoint{
+			Namespace: b.service.namespace,
+			PodName:   b.pod,
+			Service:   b.service.name,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ipcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func requireEndpoint(t *testing.T, c *Cache, ip string, expected *tetragon.NetworkEndpoint) {
+	t.Helper()
+	ep := c.Lookup(ip)
+	if expected == nil {
+		require.Nil(t, ep, "lookup of %s", ip)
+		return
+	}
+	require.True(t, proto.Equal(expected, ep), "lookup of %s: expected %v, got %v", ip, expected, ep)
+}
+
+func TestPods(t *testing.T) {
+	c := New()
+	isController := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:         "web-7d4b9c-x2x9z",
+			GenerateName: "web-7d4b9c-",
+			Namespace:    "default",
+			Labels:       map[string]string{"pod-template-hash": "7d4b9c"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "web-7d4b9c", Controller: &isController},
+			},
+		},
+		Status: corev1.PodStatus{
+			PodIP:  "10.0.0.1",
+			PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+		},
+	}
+	c.EventHandler().OnAdd(pod, false)
+	expected := &tetragon.NetworkEndpoint{
+		Namespace:    "default",
+		PodName:      "web-7d4b9c-x2x9z",
+		Workload:     "web",
+		WorkloadKind: "Deployment",
+	}
+	requireEndpoint(t, c, "10.0.0.1", expected)
+	requireEndpoint(t, c, "::ffff:10.0.0.1", expected)
+	requireEndpoint(t, c, "fd00::1", expected)
+	requireEndpoint(t, c, "10.0.0.2", nil)
+	requireEndpoint(t, c, "", nil)
+
+	// a new IP replaces the old ones
+	updated := pod.DeepCopy()
+	updated.Status.PodIPs = []corev1.PodIP{{IP: "10.0.0.2"}}
+	c.EventHandler().OnUpdate(pod, updated)
+	requireEndpoint(t, c, "10.0.0.1", nil)
+	requireEndpoint(t, c, "10.0.0.2", expected)
+
+	// the IP is reused by a pod of the operator before the deletion event
+	podInfo := &v1alpha1.PodInfo{
+		ObjectMeta:     metav1.ObjectMeta{Name: "db-0", Namespace: "db"},
+		Status:         v1alpha1.PodInfoStatus{PodIP: "10.0.0.2"},
+		WorkloadType:   metav1.TypeMeta{Kind: "StatefulSet"},
+		WorkloadObject: v1alpha1.WorkloadObjectMeta{Name: "db", Namespace: "db"},
+	}
+	c.EventHandler().OnAdd(podInfo, false)
+	c.EventHandler().OnDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+	requireEndpoint(t, c, "10.0.0.2", &tetragon.NetworkEndpoint{
+		Namespace:    "db",
+		PodName:      "db-0",
+		Workload:     "db",
+		WorkloadKind: "StatefulSet",
+	})
+
+	c.EventHandler().OnDelete(podInfo)
+	requireEndpoint(t, c, "10.0.0.2", nil)
+
+	hostPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "kube-system"},
+		Spec:       corev1.PodSpec{HostNetwork: true},
+		Status:     corev1.PodStatus{PodIP: "192.168.1.1"},
+	}
+	c.EventHandler().OnAdd(hostPod, false)
+	requireEndpoint(t, c, "192.168.1.1", nil)
+}
+
+func TestServices(t *testing.T) {
+	c := New()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:  "10.96.0.10",
+			ClusterIPs: []string{"10.96.0.10"},
+		},
+	}
+	c.EventHandler().OnAdd(svc, false)
+	requireEndpoint(t, c, "10.96.0.10", &tetragon.NetworkEndpoint{
+		Namespace: "default",
+		Service:   "web",
+	})
+
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+		},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{"10.0.1.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "default"},
+			},
+			{
+				Addresses: []string{"10.0.1.2"},
+			},
+		},
+	}
+	c.EventHandler().OnAdd(slice, false)
+	requireEndpoint(t, c, "10.0.1.1", &tetragon.NetworkEndpoint{
+		Namespace: "default",
+		PodName:   "web-1",
+		Service:   "web",
+	})
+	requireEndpoint(t, c, "10.0.1.2", &tetragon.NetworkEndpoint{
+		Namespace: "default",
+		Service:   "web",
+	})
+
+	// the workload of known pods is added to their service
+	c.UpsertPod(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIP: "10.0.1.1"},
+	})
+	requireEndpoint(t, c, "10.0.1.1", &tetragon.NetworkEndpoint{
+		Namespace:    "default",
+		PodName:      "web-1",
+		Workload:     "web-1",
+		WorkloadKind: "Pod",
+		Service:      "web",
+	})
+
+	// an address in several services resolves to the first one
+	other := slice.DeepCopy()
+	other.Name = "api-abcde"
+	other.Labels[discoveryv1.LabelServiceName] = "api"
+	c.EventHandler().OnAdd(other, false)
+	requireEndpoint(t, c, "10.0.1.2", &tetragon.NetworkEndpoint{
+		Namespace: "default",
+		Service:   "api",
+	})
+	c.EventHandler().OnDelete(other)
+	requireEndpoint(t, c, "10.0.1.2", &tetragon.NetworkEndpoint{
+		Namespace: "default",
+		Service:   "web",
+	})
+
+	c.EventHandler().OnDelete(slice)
+	c.EventHandler().OnDelete(svc)
+	requireEndpoint(t, c, "10.96.0.10", nil)
+	requireEndpoint(t, c, "10.0.1.2", nil)
+	assert.Empty(t, c.backends)
+	assert.Empty(t, c.backendIPs)
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ipcache
+
+import (
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/podhelpers"
+)
+
+// UpsertPod adds or updates the IPs of a pod. Pods using the host network
+// are ignored since their IP is the one of the node, and so are terminated
+// pods since their IP can be reused.
+func (c *Cache) UpsertPod(pod *corev1.Pod) {
+	if pod.Spec.HostNetwork || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		c.deletePod(pod.Namespace, pod.Name)
+		return
+	}
+	var ips []string
+	for _, ip := range pod.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+	if len(ips) == 0 && pod.Status.PodIP != "" {
+		ips = append(ips, pod.Status.PodIP)
+	}
+	workloadObject, workloadType := podhelpers.GetWorkloadMetaFromPod(pod)
+	c.upsertPod(podIdentity{
+		namespace:    pod.Namespace,
+		name:         pod.Name,
+		workload:     workloadObject.Name,
+		workloadKind: workloadType.Kind,
+	}, ips)
+}
+
+// UpsertPodInfo adds or updates the IPs of a pod from its PodInfo, which the
+// operator maintains for the pods of every node.
+func (c *Cache) UpsertPodInfo(podInfo *v1alpha1.PodInfo) {
+	if podInfo.Spec.HostNetwork {
+		c.deletePod(podInfo.Namespace, podInfo.Name)
+		return
+	}
+	var ips []string
+	for _, ip := range podInfo.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+	if len(ips) == 0 && podInfo.Status.PodIP != "" {
+		ips = append(ips, podInfo.Status.PodIP)
+	}
+	c.upsertPod(podIdentity{
+		namespace:    podInfo.Namespace,
+		name:         podInfo.Name,
+		workload:     podInfo.WorkloadObject.Name,
+		workloadKind: podInfo.WorkloadType.Kind,
+	}, ips)
+}
+
+// UpsertService adds or updates the cluster IPs of a service.
+func (c *Cache) UpsertService(svc *corev1.Service) {
+	ips := svc.Spec.ClusterIPs
+	if len(ips) == 0 && svc.Spec.ClusterIP != "" {
+		ips = []string{svc.Spec.ClusterIP}
+	}
+	c.upsertService(serviceIdentity{
+		namespace: svc.Namespace,
+		name:      svc.Name,
+	}, ips)
+}
+
+func endpointSliceKey(slice *discoveryv1.EndpointSlice) string {
+	return objectKey(slice.Namespace, slice.Name)
+}
+
+// UpsertEndpointSlice adds or updates the addresses of the endpoints of a
+// service.
+func (c *Cache) UpsertEndpointSlice(slice *discoveryv1.EndpointSlice) {
+	svcName := slice.Labels[discoveryv1.LabelServiceName]
+	if svcName == "" {
+		c.deleteBackends(endpointSliceKey(slice))
+		return
+	}
+	backends := map[netip.Addr]backend{}
+	for _, ep := range slice.Endpoints {
+		var pod string
+		if ep.TargetRef != nil && ep.TargetRef.Kind == "Pod" {
+			pod = ep.TargetRef.Name
+		}
+		for _, addr := range parseAddrs(ep.Addresses) {
+			backends[addr] = backend{
+				service: serviceIdentity{
+					namespace: slice.Namespace,
+					name:      svcName,
+				},
+				pod: pod,
+			}
+		}
+	}
+	c.upsertBackends(endpointSliceKey(slice), backends)
+}
+
+func (c *Cache) upsert(obj any) {
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		c.UpsertPod(o)
+	case *v1alpha1.PodInfo:
+		c.UpsertPodInfo(o)
+	case *corev1.Service:
+		c.UpsertService(o)
+	case *discoveryv1.EndpointSlice:
+		c.UpsertEndpointSlice(o)
+	}
+}
+
+func (c *Cache) delete(obj any) {
+	if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		// Handle the case when the watcher missed the deletion event
+		// (e.g. due to a lost apiserver connection).
+		obj = d.Obj
+	}
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		c.deletePod(o.Namespace, o.Name)
+	case *v1alpha1.PodInfo:
+		c.deletePod(o.Namespace, o.Name)
+	case *corev1.Service:
+		c.deleteService(o.Namespace, o.Name)
+	case *discoveryv1.EndpointSlice:
+		c.deleteBackends(endpointSliceKey(o))
+	}
+}
+
+// EventHandler returns an informer event handler that keeps the cache in
+// sync with the pods, PodInfo, services and endpoint slices of the informer.
+func (c *Cache) EventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			c.upsert(obj)
+		},
+		UpdateFunc: func(_, newObj any) {
+			c.upsert(newObj)
+		},
+		DeleteFunc: func(obj any) {
+			c.delete(obj)
+		},
+	}
+}
@@ -104,6 +104,24 @@ type TracingPolicySpec struct {
 	// Currently, only the "name" field is supported.
 	ContainerSelector *slimv1.LabelSelector `json:"containerSelector,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// NamespaceSelector selects the namespaces, based on their labels, of the pods that this
+	// policy applies to. Pods are matched again when the labels of their namespace change.
+	NamespaceSelector *slimv1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// WorkloadSelector selects the workloads of the pods that this policy applies to.
+	// A map of workload fields will be constructed in the same way as a map of labels.
+	// The name of the field represents the label "key", and the value of the field - label "value".
+	// The "name" and "kind" (e.g., Deployment, DaemonSet) fields are supported.
+	WorkloadSelector *slimv1.LabelSelector `json:"workloadSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// NodeSelector selects the nodes, based on their labels, that this policy is loaded on.
+	// Agents running on nodes that do not match the selector do not load the policy and
+	// report it as not applicable.
+	NodeSelector *slimv1.LabelSelector `json:"nodeSelector,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// A list of list specs.
 	Lists []ListSpec `json:"lists,omitempty"`
@@ -115,6 +133,16 @@ type TracingPolicySpec struct {
 	// +kubebuilder:validation:Optional
 	// A list of overloaded options
 	Options []OptionSpec `json:"options,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// A list of metrics updated by the kprobes and tracepoints of the policy.
+	Metrics []MetricSpec `json:"metrics,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// A list of sequences of steps reached by the processes on the hooks of the policy.
+	// Hooks reach the steps with the SetState action and selectors match the steps already
+	// reached with matchState.
+	Sequences []SequenceSpec `json:"sequences,omitempty"`
 }
 
 func (tp *TracingPolicy) TpName() string {
@@ -50,6 +50,11 @@ type KProbeSpec struct {
 	// A list of data to include in the trace output.
 	Data []KProbeArg `json:"data,omitempty"`
 	// +kubebuilder:validation:Optional
+	// Indexes of the args that are only used by selectors. These args are
+	// read and matched in the kernel, but not copied into the events, which
+	// reduces the amount of data sent through the ring buffer.
+	OmitArgs []uint32 `json:"omitArgs,omitempty"`
+	// +kubebuilder:validation:Optional
 	// A return argument to include in the trace output.
 	ReturnArg *KProbeArg `json:"returnArg,omitempty"`
 	// +kubebuilder:validation:Optional
@@ -126,6 +131,35 @@ type BinarySelector struct {
 	FollowChildren bool `json:"followChildren"`
 }
 
+type AncestorBinarySelector struct {
+	// +kubebuilder:validation:Enum=In;NotIn;Prefix;NotPrefix;Postfix;NotPostfix
+	// Filter operation.
+	Operator string `json:"operator"`
+	// Value to compare the argument against.
+	Values []string `json:"values"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// Number of ancestors, starting from the parent, whose binaries are compared against the
+	// values. If not set, the maximum depth of 16 is used.
+	MaxDepth uint32 `json:"maxDepth,omitempty"`
+}
+
+type ThresholdSelector struct {
+	// +kubebuilder:validation:Minimum=1
+	// Number of matches within the window after which the actions of the selector fire.
+	Count uint32 `json:"count"`
+	// The length of the sliding window. Can be specified in seconds (default or with 's'
+	// suffix), minutes ('m' suffix) or hours ('h' suffix).
+	Window string `json:"window"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=process;cgroup;global
+	// +kubebuilder:default=process
+	// The key the matches are counted for. Can be "process" (default), "cgroup" (all
+	// processes of the same cgroup), or "global" (regardless of which process matched).
+	Scope string `json:"scope,omitempty"`
+}
+
 // KProbeSelector selects function calls for kprobe based on PIDs and function arguments. The
 // results of MatchPIDs and MatchArgs are ANDed.
 type KProbeSelector struct {
@@ -151,6 +185,13 @@ type KProbeSelector struct {
 	// A list of binary exec name filters.
 	MatchBinaries []BinarySelector `json:"matchBinaries,omitempty"`
 	// +kubebuilder:validation:Optional
+	// A list of binary exec name filters applied to the parent of the process.
+	MatchParentBinaries []BinarySelector `json:"matchParentBinaries,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A list of binary exec name filters applied to the ancestors of the process. The filter
+	// matches if the binary of any of the ancestors matches.
+	MatchAncestorBinaries []AncestorBinarySelector `json:"matchAncestorBinaries,omitempty"`
+	// +kubebuilder:validation:Optional
 	// A list of namespaces and IDs
 	MatchNamespaces []NamespaceSelector `json:"matchNamespaces,omitempty"`
 	// +kubebuilder:validation:Optional
@@ -162,6 +203,26 @@ type KProbeSelector struct {
 	// +kubebuilder:validation:Optional
 	// IDs for capabilities changes
 	MatchCapabilityChanges []CapabilitiesSelector `json:"matchCapabilityChanges,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A count threshold over a sliding time window. If set, the actions of the selector
+	// fire only once the selector has matched count times within the window, after
+	// which counting starts again.
+	MatchThreshold *ThresholdSelector `json:"matchThreshold,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A list of filters on the steps of the policy sequences reached by the process.
+	// MatchState are ANDed.
+	MatchState []StateSelector `json:"matchState,omitempty"`
+}
+
+type StateSelector struct {
+	// Name of the sequence, as defined in the sequences section of the policy.
+	Sequence string `json:"sequence"`
+	// +kubebuilder:validation:Enum=In;NotIn
+	// State selector operator. In matches if the process reached all the steps, NotIn
+	// matches if the process reached none of them.
+	Operator string `json:"operator"`
+	// Steps of the sequence to match.
+	Values []string `json:"values"`
 }
 
 type NamespaceChangesSelector struct {
@@ -225,7 +286,7 @@ type ArgSelector struct {
 	// +kubebuilder:validation:items:Minimum=0
 	// Position of the operator arguments (in spec file) to apply fhe filter to.
 	Args []uint32 `json:"args,omitempty"`
-	// +kubebuilder:validation:Enum=Equal;NotEqual;Prefix;NotPrefix;Postfix;NotPostfix;GreaterThan;LessThan;GT;LT;Mask;SPort;NotSPort;SPortPriv;NotSportPriv;DPort;NotDPort;DPortPriv;NotDPortPriv;SAddr;NotSAddr;DAddr;NotDAddr;Protocol;Family;State;InMap;NotInMap;CapabilitiesGained;InRange;NotInRange
+	// +kubebuilder:validation:Enum=Equal;NotEqual;Prefix;NotPrefix;Postfix;NotPostfix;GreaterThan;LessThan;GT;LT;Mask;SPort;NotSPort;SPortPriv;NotSportPriv;DPort;NotDPort;DPortPriv;NotDPortPriv;SAddr;NotSAddr;DAddr;NotDAddr;Protocol;Family;State;InMap;NotInMap;CapabilitiesGained;InRange;NotInRange;Glob;NotGlob;Regex;NotRegex
 	// Filter operation.
 	Operator string `json:"operator"`
 	// Value to compare the argument against.
@@ -233,7 +294,7 @@ type ArgSelector struct {
 }
 
 type ActionSelector struct {
-	// +kubebuilder:validation:Enum=Post;FollowFD;UnfollowFD;Sigkill;CopyFD;Override;GetUrl;DnsLookup;NoPost;Signal;TrackSock;UntrackSock;NotifyEnforcer;CleanupEnforcerNotification;Set
+	// +kubebuilder:validation:Enum=Post;FollowFD;UnfollowFD;Sigkill;CopyFD;Override;GetUrl;DnsLookup;NoPost;Signal;TrackSock;UntrackSock;NotifyEnforcer;CleanupEnforcerNotification;Set;SetState
 	// Action to execute.
 	// NOTE: actions FollowFD, UnfollowFD, and CopyFD are marked as deprecated and planned to
 	// be removed in version 1.5.
@@ -269,6 +330,12 @@ type ActionSelector struct {
 	// An arg value for the regs action
 	ArgRegs []string `json:"argRegs,omitempty"`
 	// +kubebuilder:validation:Optional
+	// A sequence name for the SetState action
+	ArgSequence string `json:"argSequence,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A step name of the sequence for the SetState action
+	ArgStep string `json:"argStep,omitempty"`
+	// +kubebuilder:validation:Optional
 	// A time period within which repeated messages will not be posted. Can be
 	// specified in seconds (default or with 's' suffix), minutes ('m' suffix)
 	// or hours ('h' suffix). Only valid with the post action.
@@ -282,6 +349,17 @@ type ActionSelector struct {
 	// Only valid with the post action and with a rateLimit specified.
 	RateLimitScope string `json:"rateLimitScope"`
 	// +kubebuilder:validation:Optional
+	// Post only 1 out of every N matching events, specified as "1/N" or "N".
+	// The posted events carry the sampling rate so that consumers can
+	// extrapolate. Only valid with the post action.
+	SampleRate string `json:"sampleRate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// How the events to post are sampled. Can be "random" (default) or
+	// "process". If "process" is selected then the decision is a hash of the
+	// process, so that all the events of a process are either posted or not.
+	// Only valid with the post action and with a sampleRate specified.
+	SampleScope string `json:"sampleScope,omitempty"`
+	// +kubebuilder:validation:Optional
 	// Enable kernel stack trace export. Only valid with the post action.
 	KernelStackTrace bool `json:"kernelStackTrace"`
 	// +kubebuilder:validation:Optional
@@ -317,6 +395,11 @@ type TracepointSpec struct {
 	// A list of function arguments to include in the trace output.
 	Args []KProbeArg `json:"args,omitempty"`
 	// +kubebuilder:validation:Optional
+	// Indexes of the args that are only used by selectors. These args are
+	// read and matched in the kernel, but not copied into the events, which
+	// reduces the amount of data sent through the ring buffer.
+	OmitArgs []uint32 `json:"omitArgs,omitempty"`
+	// +kubebuilder:validation:Optional
 	// Selectors to apply before producing trace output. Selectors are ORed.
 	Selectors []KProbeSelector `json:"selectors,omitempty"`
 	// +kubebuilder:validation:optional
@@ -514,3 +597,77 @@ type EnforcerSpec struct {
 	// Calls where enforcer is executed in
 	Calls []string `json:"calls"`
 }
+
+type MetricLabel struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	// Name of the label.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=binary;pod;namespace;workload;arg
+	// Source of the label value. The arg source uses the value of the
+	// argument at index.
+	Source string `json:"source"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// Index of the argument used as label value, for the arg source. It
+	// refers to the index of one of the hook args.
+	Index uint32 `json:"index,omitempty"`
+}
+
+type SequenceSpec struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_-]*$`
+	// Name of the sequence.
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=8
+	// Names of the steps of the sequence, in order. Hooks reach the steps with the
+	// SetState action. Reaching the last step completes the sequence: its event references
+	// the events of the earlier steps and the steps reached by the process are reset.
+	Steps []string `json:"steps"`
+}
+
+type MetricSpec struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	// Name of the metric. It is exposed with the tetragon_policy_ prefix.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Help text of the metric.
+	Help string `json:"help,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=counter;histogram
+	// +kubebuilder:default=counter
+	// Type of the metric. Counters count the hook events, histograms
+	// observe the value of the argument at valueArgIndex.
+	Type string `json:"type,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Call of the kprobe the metric is updated for, as specified in the
+	// kprobes section.
+	Kprobe string `json:"kprobe,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Tracepoint the metric is updated for, in the subsystem/event form.
+	Tracepoint string `json:"tracepoint,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=8
+	// Labels of the metric, in addition to the policy and policy_namespace
+	// labels.
+	Labels []MetricLabel `json:"labels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// Index of the argument observed by a histogram. It refers to the index
+	// of one of the hook args, which must be a number.
+	ValueArgIndex *uint32 `json:"valueArgIndex,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Upper bounds of the histogram buckets, in increasing order. Defaults
+	// to the Prometheus default buckets.
+	Buckets []int64 `json:"buckets,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1000
+	// Maximum number of label value combinations. Events beyond it are
+	// accounted with all labels set to "overflow".
+	MaxCardinality uint32 `json:"maxCardinality,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Emit the events of the hook in addition to updating the metric. By
+	// default, events of hooks with metrics are not emitted.
+	KeepEvents bool `json:"keepEvents,omitempty"`
+}
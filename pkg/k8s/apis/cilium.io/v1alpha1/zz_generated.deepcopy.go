@@ -37,6 +37,26 @@ func (in *ActionSelector) DeepCopy() *ActionSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AncestorBinarySelector) DeepCopyInto(out *AncestorBinarySelector) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AncestorBinarySelector.
+func (in *AncestorBinarySelector) DeepCopy() *AncestorBinarySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(AncestorBinarySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgSelector) DeepCopyInto(out *ArgSelector) {
 	*out = *in
@@ -189,6 +209,20 @@ func (in *KProbeSelector) DeepCopyInto(out *KProbeSelector) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MatchParentBinaries != nil {
+		in, out := &in.MatchParentBinaries, &out.MatchParentBinaries
+		*out = make([]BinarySelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchAncestorBinaries != nil {
+		in, out := &in.MatchAncestorBinaries, &out.MatchAncestorBinaries
+		*out = make([]AncestorBinarySelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MatchNamespaces != nil {
 		in, out := &in.MatchNamespaces, &out.MatchNamespaces
 		*out = make([]NamespaceSelector, len(*in))
@@ -217,6 +251,18 @@ func (in *KProbeSelector) DeepCopyInto(out *KProbeSelector) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MatchThreshold != nil {
+		in, out := &in.MatchThreshold, &out.MatchThreshold
+		*out = new(ThresholdSelector)
+		**out = **in
+	}
+	if in.MatchState != nil {
+		in, out := &in.MatchState, &out.MatchState
+		*out = make([]StateSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KProbeSelector.
@@ -242,6 +288,11 @@ func (in *KProbeSpec) DeepCopyInto(out *KProbeSpec) {
 		*out = make([]KProbeArg, len(*in))
 		copy(*out, *in)
 	}
+	if in.OmitArgs != nil {
+		in, out := &in.OmitArgs, &out.OmitArgs
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
 	if in.ReturnArg != nil {
 		in, out := &in.ReturnArg, &out.ReturnArg
 		*out = new(KProbeArg)
@@ -348,6 +399,51 @@ func (in *LsmHookSpec) DeepCopy() *LsmHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricLabel) DeepCopyInto(out *MetricLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricLabel.
+func (in *MetricLabel) DeepCopy() *MetricLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]MetricLabel, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValueArgIndex != nil {
+		in, out := &in.ValueArgIndex, &out.ValueArgIndex
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
+func (in *MetricSpec) DeepCopy() *MetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceChangesSelector) DeepCopyInto(out *NamespaceChangesSelector) {
 	*out = *in
@@ -534,6 +630,61 @@ func (in *PodInfoStatus) DeepCopy() *PodInfoStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SequenceSpec) DeepCopyInto(out *SequenceSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SequenceSpec.
+func (in *SequenceSpec) DeepCopy() *SequenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SequenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateSelector) DeepCopyInto(out *StateSelector) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateSelector.
+func (in *StateSelector) DeepCopy() *StateSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(StateSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThresholdSelector) DeepCopyInto(out *ThresholdSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThresholdSelector.
+func (in *ThresholdSelector) DeepCopy() *ThresholdSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ThresholdSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracepointSpec) DeepCopyInto(out *TracepointSpec) {
 	*out = *in
@@ -542,6 +693,11 @@ func (in *TracepointSpec) DeepCopyInto(out *TracepointSpec) {
 		*out = make([]KProbeArg, len(*in))
 		copy(*out, *in)
 	}
+	if in.OmitArgs != nil {
+		in, out := &in.OmitArgs, &out.OmitArgs
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
 	if in.Selectors != nil {
 		in, out := &in.Selectors, &out.Selectors
 		*out = make([]KProbeSelector, len(*in))
@@ -730,6 +886,21 @@ func (in *TracingPolicySpec) DeepCopyInto(out *TracingPolicySpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Lists != nil {
 		in, out := &in.Lists, &out.Lists
 		*out = make([]ListSpec, len(*in))
@@ -749,6 +920,20 @@ func (in *TracingPolicySpec) DeepCopyInto(out *TracingPolicySpec) {
 		*out = make([]OptionSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sequences != nil {
+		in, out := &in.Sequences, &out.Sequences
+		*out = make([]SequenceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicySpec.
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +30,7 @@ import (
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/watcher/conf"
 
+	"github.com/cilium/tetragon/pkg/ipcache"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/podhooks"
@@ -99,6 +101,10 @@ func newControllerManager() (*ControllerManager, error) {
 		if err != nil {
 			return nil, err
 		}
+		err = manager.addNamespaceInformer()
+		if err != nil {
+			return nil, err
+		}
 	}
 	return manager, nil
 }
@@ -130,6 +136,28 @@ func (cm *ControllerManager) GetNode() (*corev1.Node, error) {
 	return &k8sNode, nil
 }
 
+// WatchNodeLabels calls onUpdate with the labels of the local node when it is
+// added to the cache and every time it is updated.
+func (cm *ControllerManager) WatchNodeLabels(ctx context.Context, onUpdate func(labels map[string]string)) error {
+	informer, err := cm.Manager.GetCache().GetInformer(ctx, &corev1.Node{})
+	if err != nil {
+		return fmt.Errorf("failed to get node informer: %w", err)
+	}
+	handle := func(obj any) {
+		if k8sNode, ok := obj.(*corev1.Node); ok && k8sNode.Name == node.GetNodeName() {
+			onUpdate(k8sNode.Labels)
+		}
+	}
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    handle,
+		UpdateFunc: func(_, newObj any) { handle(newObj) },
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add node event handler: %w", err)
+	}
+	return nil
+}
+
 func (cm *ControllerManager) ListNamespaces() ([]corev1.Namespace, error) {
 	namespaceList := corev1.NamespaceList{}
 	if err := cm.Manager.GetCache().List(context.Background(), &namespaceList, &client.ListOptions{}); err != nil {
@@ -207,6 +235,40 @@ func (cm *ControllerManager) addPodInformer() error {
 	return nil
 }
 
+func (cm *ControllerManager) addNamespaceInformer() error {
+	namespaceInformer, err := cm.Manager.GetCache().GetInformer(context.Background(), &corev1.Namespace{})
+	if err != nil {
+		return err
+	}
+	podhooks.InstallNamespaceHooks(namespaceInformer.(cache.SharedIndexInformer))
+	return nil
+}
+
+// AddDestinationInformers feeds the IP cache used to enrich the destination
+// of network arguments with the local pods, the services and endpoint slices
+// and, if podInfo is set, the PodInfo of the pods of every node.
+func (cm *ControllerManager) AddDestinationInformers(ctx context.Context, podInfo bool) error {
+	objs := []client.Object{&corev1.Service{}, &discoveryv1.EndpointSlice{}}
+	if podInfo {
+		objs = append(objs, &v1alpha1.PodInfo{})
+	}
+	for _, obj := range objs {
+		informer, err := cm.Manager.GetCache().GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get %T informer: %w", obj, err)
+		}
+		if _, err := informer.AddEventHandler(ipcache.Get().EventHandler()); err != nil {
+			return fmt.Errorf("failed to add %T event handler: %w", obj, err)
+		}
+	}
+	if cm.podInformer != nil {
+		if _, err := cm.podInformer.AddEventHandler(ipcache.Get().EventHandler()); err != nil {
+			return fmt.Errorf("failed to add pod event handler: %w", err)
+		}
+	}
+	return nil
+}
+
 func (cm *ControllerManager) FindContainer(containerID string) (*corev1.Pod, *corev1.ContainerStatus, bool) {
 	return watcher.FindContainer(containerID, cm.podInformer, cm.deletedPodCache)
 }
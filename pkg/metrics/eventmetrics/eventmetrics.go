@@ -77,7 +77,7 @@ var (
 	policyStats = metrics.MustNewGranularCounterWithInit[metrics.ProcessLabels](
 		metrics.NewOpts(
 			consts.MetricsNamespace, "", "policy_events_total",
-			"Policy events calls observed.",
+			"Policy events calls observed. Events of hooks with a sampleRate action are extrapolated from the sampling rate.",
 			nil, nil, []metrics.UnconstrainedLabel{{Name: "policy", ExampleValue: consts.ExamplePolicyLabel}, {Name: "hook", ExampleValue: consts.ExampleKprobeLabel}},
 		),
 		nil,
@@ -149,8 +149,28 @@ func handleOriginalEvent(originalEvent any) {
 	}
 }
 
+// eventSampleRate returns the sampling rate of an event, i.e. the number of
+// events it stands for, which is 1 for events that are not sampled.
+func eventSampleRate(ev *tetragon.GetEventsResponse) uint32 {
+	var rate uint32
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		rate = e.ProcessKprobe.GetSampleRate()
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		rate = e.ProcessTracepoint.GetSampleRate()
+	case *tetragon.GetEventsResponse_ProcessUprobe:
+		rate = e.ProcessUprobe.GetSampleRate()
+	case *tetragon.GetEventsResponse_ProcessUsdt:
+		rate = e.ProcessUsdt.GetSampleRate()
+	case *tetragon.GetEventsResponse_ProcessLsm:
+		rate = e.ProcessLsm.GetSampleRate()
+	}
+	return max(rate, 1)
+}
+
 func handleProcessedEvent(pInfo *tracingpolicy.PolicyInfo, processedEvent any) {
 	var eventType, namespace, workload, pod, binary, nodeName string
+	rate := uint32(1)
 	switch ev := processedEvent.(type) {
 	case *tetragon.GetEventsResponse:
 		binary, pod, workload, namespace = GetProcessInfo(filters.GetProcess(&event.Event{Event: ev}))
@@ -161,6 +181,7 @@ func handleProcessedEvent(pInfo *tracingpolicy.PolicyInfo, processedEvent any) {
 			eventType = "unhandled"
 		}
 		nodeName = ev.NodeName
+		rate = eventSampleRate(ev)
 	default:
 		eventType = "unknown"
 	}
@@ -168,7 +189,7 @@ func handleProcessedEvent(pInfo *tracingpolicy.PolicyInfo, processedEvent any) {
 	EventsProcessed.WithLabelValues(processLabels, eventType).Inc()
 	if pInfo != nil && pInfo.Name != "" {
 		policyStats.
-			WithLabelValues(processLabels, pInfo.Name, pInfo.Hook).Inc()
+			WithLabelValues(processLabels, pInfo.Name, pInfo.Hook).Add(float64(rate))
 	}
 }
 
@@ -110,3 +110,10 @@ tetragon_flags_total{type="execve"} 1
 `)
 	require.NoError(t, testutil.CollectAndCompare(FlagCount, expected))
 }
+
+func TestEventSampleRate(t *testing.T) {
+	require.Equal(t, uint32(1), eventSampleRate(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{}}}))
+	require.Equal(t, uint32(1), eventSampleRate(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{}}}))
+	require.Equal(t, uint32(100), eventSampleRate(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{SampleRate: 100}}}))
+	require.Equal(t, uint32(10), eventSampleRate(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessLsm{ProcessLsm: &tetragon.ProcessLsm{SampleRate: 10}}}))
+}
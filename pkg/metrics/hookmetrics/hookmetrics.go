@@ -0,0 +1,499 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package hookmetrics implements the metrics defined in the metrics section
+// of tracing policies. They are updated in user space from the events of
+// kprobe and tracepoint hooks, which are then dropped unless requested
+// otherwise.
+package hookmetrics
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+)
+
+const (
+	// Subsystem is the subsystem of policy metrics, so that their names
+	// are prefixed with tetragon_policy_.
+	Subsystem = "policy"
+
+	TypeCounter   = "counter"
+	TypeHistogram = "histogram"
+
+	SourceBinary    = "binary"
+	SourcePod       = "pod"
+	SourceNamespace = "namespace"
+	SourceWorkload  = "workload"
+	SourceArg       = "arg"
+
+	// DefaultMaxCardinality is the maximum number of label value
+	// combinations of a metric when not set in the spec.
+	DefaultMaxCardinality = 1000
+
+	// overflowValue is the value of all labels of a metric once its
+	// maximum cardinality is reached.
+	overflowValue = "overflow"
+)
+
+// reservedNames are names of metrics defined by Tetragon with the policy
+// subsystem, that policy metrics cannot use.
+var reservedNames = map[string]struct{}{
+	"events_total":           {},
+	"metrics_overflow_total": {},
+}
+
+var numberArgTypes = map[string]struct{}{
+	"int": {}, "sint8": {}, "int8": {}, "uint8": {}, "sint16": {}, "int16": {},
+	"uint16": {}, "uint32": {}, "sint32": {}, "int32": {}, "ulong": {},
+	"uint64": {}, "size_t": {}, "long": {}, "sint64": {}, "int64": {}, "fd": {},
+}
+
+var stringArgTypes = map[string]struct{}{
+	"string": {}, "char_buf": {}, "file": {}, "path": {}, "filename": {},
+	"dentry": {}, "linux_binprm": {}, "net_device": {},
+}
+
+var overflowMetrics = metrics.MustNewCounter(metrics.NewOpts(
+	consts.MetricsNamespace, Subsystem, "metrics_overflow_total",
+	"The number of events accounted in the overflow series of policy metrics, because of their maximum cardinality.",
+	nil, nil, []metrics.UnconstrainedLabel{
+		metrics.LabelPolicy,
+		metrics.LabelPolicyNamespace,
+		{Name: "metric", ExampleValue: "open_calls"},
+	},
+), nil)
+
+// Event is implemented by messages of hooks that can have policy metrics.
+type Event interface {
+	HookMetrics() *Hook
+}
+
+// Hook holds the metrics updated by one hook of a policy.
+type Hook struct {
+	metrics    []*metric
+	keepEvents bool
+}
+
+// Policy holds the metrics of a tracing policy.
+type Policy struct {
+	name      string
+	namespace string
+	metrics   []*metric
+	hooks     map[string]*Hook
+}
+
+type label struct {
+	source string
+	// argPos is the position of the argument in the hook args, for the
+	// arg source
+	argPos int
+}
+
+type metric struct {
+	policy         string
+	namespace      string
+	spec           *v1alpha1.MetricSpec
+	labels         []label
+	valuePos       int
+	maxCardinality int
+	collector      prometheus.Collector
+	observe        func(value float64, lvs ...string)
+
+	// mu protects series
+	mu sync.Mutex
+	// series are the label value combinations seen so far
+	series map[string]struct{}
+}
+
+func kprobeKey(call string) string {
+	return "kprobe:" + call
+}
+
+func tracepointKey(subsys, event string) string {
+	return "tracepoint:" + subsys + "/" + event
+}
+
+// hookArgs returns the hook key and args of the hook the metric refers to.
+func hookArgs(spec *v1alpha1.TracingPolicySpec, m *v1alpha1.MetricSpec) (string, []v1alpha1.KProbeArg, error) {
+	switch {
+	case m.Kprobe != "" && m.Tracepoint != "":
+		return "", nil, errors.New("only one of kprobe and tracepoint can be set")
+	case m.Kprobe != "":
+		for i := range spec.KProbes {
+			if spec.KProbes[i].Call == m.Kprobe {
+				return kprobeKey(m.Kprobe), eventArgs(spec.KProbes[i].Args, spec.KProbes[i].OmitArgs), nil
+			}
+		}
+		return "", nil, fmt.Errorf("kprobe %q not found in the kprobes of the policy", m.Kprobe)
+	case m.Tracepoint != "":
+		for i := range spec.Tracepoints {
+			tp := &spec.Tracepoints[i]
+			if tp.Subsystem+"/"+tp.Event == m.Tracepoint {
+				return tracepointKey(tp.Subsystem, tp.Event), eventArgs(tp.Args, tp.OmitArgs), nil
+			}
+		}
+		return "", nil, fmt.Errorf("tracepoint %q not found in the tracepoints of the policy", m.Tracepoint)
+	}
+	return "", nil, errors.New("one of kprobe and tracepoint must be set")
+}
+
+// eventArgs returns the args of a hook that are part of its events, i.e.
+// without the ones omitted with omitArgs.
+func eventArgs(args []v1alpha1.KProbeArg, omitArgs []uint32) []v1alpha1.KProbeArg {
+	if len(omitArgs) == 0 {
+		return args
+	}
+	return slices.DeleteFunc(slices.Clone(args), func(a v1alpha1.KProbeArg) bool {
+		return slices.Contains(omitArgs, a.Index)
+	})
+}
+
+// argPosition returns the position in args of the argument with the given
+// index, along with its type.
+func argPosition(args []v1alpha1.KProbeArg, index uint32) (int, string, error) {
+	for i := range args {
+		if args[i].Index == index {
+			return i, args[i].Type, nil
+		}
+	}
+	return 0, "", fmt.Errorf("argument %d not found in the hook args", index)
+}
+
+// autoType returns whether the argument type is only known when loading the
+// policy, as for tracepoint arguments read from tracefs.
+func autoType(ty string) bool {
+	return ty == "" || ty == "auto"
+}
+
+func metricType(m *v1alpha1.MetricSpec) string {
+	if m.Type == "" {
+		return TypeCounter
+	}
+	return m.Type
+}
+
+// ValidateMetric checks the i-th metric of the spec.
+func ValidateMetric(spec *v1alpha1.TracingPolicySpec, i int) error {
+	_, err := parseMetric(spec, i)
+	return err
+}
+
+func parseMetric(spec *v1alpha1.TracingPolicySpec, i int) (*metric, error) {
+	m := &spec.Metrics[i]
+	if _, ok := reservedNames[m.Name]; ok {
+		return nil, fmt.Errorf("metric name %q is reserved", m.Name)
+	}
+	for j := range i {
+		if spec.Metrics[j].Name == m.Name {
+			return nil, fmt.Errorf("duplicate metric name %q", m.Name)
+		}
+	}
+
+	_, args, err := hookArgs(spec, m)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &metric{
+		spec:           m,
+		valuePos:       -1,
+		maxCardinality: DefaultMaxCardinality,
+		series:         map[string]struct{}{},
+	}
+	if m.MaxCardinality > 0 {
+		ret.maxCardinality = int(m.MaxCardinality)
+	}
+
+	names := map[string]struct{}{
+		metrics.LabelPolicy.Name:          {},
+		metrics.LabelPolicyNamespace.Name: {},
+	}
+	for j, l := range m.Labels {
+		if _, ok := names[l.Name]; ok {
+			return nil, fmt.Errorf("labels[%d]: duplicate label name %q", j, l.Name)
+		}
+		names[l.Name] = struct{}{}
+
+		lbl := label{source: l.Source, argPos: -1}
+		switch l.Source {
+		case SourceBinary, SourcePod, SourceNamespace, SourceWorkload:
+		case SourceArg:
+			pos, ty, err := argPosition(args, l.Index)
+			if err != nil {
+				return nil, fmt.Errorf("labels[%d]: %w", j, err)
+			}
+			_, isNumber := numberArgTypes[ty]
+			_, isString := stringArgTypes[ty]
+			if !isNumber && !isString && !autoType(ty) {
+				return nil, fmt.Errorf("labels[%d]: argument %d of type %q cannot be used as label", j, l.Index, ty)
+			}
+			lbl.argPos = pos
+		default:
+			return nil, fmt.Errorf("labels[%d]: unknown source %q", j, l.Source)
+		}
+		ret.labels = append(ret.labels, lbl)
+	}
+
+	switch metricType(m) {
+	case TypeCounter:
+		if m.ValueArgIndex != nil || len(m.Buckets) > 0 {
+			return nil, errors.New("valueArgIndex and buckets are only supported by histograms")
+		}
+	case TypeHistogram:
+		if m.ValueArgIndex == nil {
+			return nil, errors.New("valueArgIndex is required for histograms")
+		}
+		pos, ty, err := argPosition(args, *m.ValueArgIndex)
+		if err != nil {
+			return nil, fmt.Errorf("valueArgIndex: %w", err)
+		}
+		if _, ok := numberArgTypes[ty]; !ok && !autoType(ty) {
+			return nil, fmt.Errorf("valueArgIndex: argument %d of type %q is not a number", *m.ValueArgIndex, ty)
+		}
+		ret.valuePos = pos
+		if !slices.IsSorted(m.Buckets) || len(slices.Compact(slices.Clone(m.Buckets))) != len(m.Buckets) {
+			return nil, errors.New("buckets must be in increasing order")
+		}
+	default:
+		return nil, fmt.Errorf("unknown metric type %q", m.Type)
+	}
+	return ret, nil
+}
+
+// New creates the metrics of a policy. It returns nil if the policy has no
+// metrics.
+func New(policy, namespace string, spec *v1alpha1.TracingPolicySpec) (*Policy, error) {
+	if len(spec.Metrics) == 0 {
+		return nil, nil
+	}
+
+	ret := &Policy{
+		name:      policy,
+		namespace: namespace,
+		hooks:     map[string]*Hook{},
+	}
+	for i := range spec.Metrics {
+		m, err := parseMetric(spec, i)
+		if err != nil {
+			return nil, fmt.Errorf("metrics[%d]: %w", i, err)
+		}
+		m.policy, m.namespace = policy, namespace
+		if err := m.init(); err != nil {
+			return nil, fmt.Errorf("metrics[%d]: %w", i, err)
+		}
+		key, _, _ := hookArgs(spec, m.spec)
+		hook := ret.hooks[key]
+		if hook == nil {
+			hook = &Hook{}
+			ret.hooks[key] = hook
+		}
+		hook.metrics = append(hook.metrics, m)
+		hook.keepEvents = hook.keepEvents || m.spec.KeepEvents
+		ret.metrics = append(ret.metrics, m)
+	}
+	return ret, nil
+}
+
+func (m *metric) init() error {
+	help := m.spec.Help
+	if help == "" {
+		help = fmt.Sprintf("Policy metric %s.", m.spec.Name)
+	}
+	labels := []metrics.UnconstrainedLabel{metrics.LabelPolicy, metrics.LabelPolicyNamespace}
+	for _, l := range m.spec.Labels {
+		labels = append(labels, metrics.UnconstrainedLabel{Name: l.Name})
+	}
+	opts := metrics.NewOpts(consts.MetricsNamespace, Subsystem, m.spec.Name, help, nil, nil, labels)
+
+	switch metricType(m.spec) {
+	case TypeCounter:
+		c, err := metrics.NewCounter(opts, nil)
+		if err != nil {
+			return err
+		}
+		m.collector = c
+		m.observe = func(value float64, lvs ...string) {
+			c.WithLabelValues(lvs...).Add(value)
+		}
+	case TypeHistogram:
+		var buckets []float64
+		for _, b := range m.spec.Buckets {
+			buckets = append(buckets, float64(b))
+		}
+		h, err := metrics.NewHistogram(metrics.HistogramOpts{Opts: opts, Buckets: buckets}, nil)
+		if err != nil {
+			return err
+		}
+		m.collector = h
+		m.observe = func(value float64, lvs ...string) {
+			h.WithLabelValues(lvs...).Observe(value)
+		}
+	}
+	return nil
+}
+
+// Kprobe returns the metrics of the kprobe with the given call, as specified
+// in the policy, or nil if there are none.
+func (p *Policy) Kprobe(call string) *Hook {
+	if p == nil {
+		return nil
+	}
+	return p.hooks[kprobeKey(call)]
+}
+
+// Tracepoint returns the metrics of the given tracepoint, or nil if there are
+// none.
+func (p *Policy) Tracepoint(subsys, event string) *Hook {
+	if p == nil {
+		return nil
+	}
+	return p.hooks[tracepointKey(subsys, event)]
+}
+
+// Handle updates the metrics of the hook from one of its events. It returns
+// whether the event should still be emitted.
+//
+// Counters of hooks with a sampleRate action are extrapolated from the
+// sampling rate of their events, while histograms only observe the sampled
+// values.
+func (h *Hook) Handle(ev *tetragon.GetEventsResponse) bool {
+	if h == nil {
+		return true
+	}
+
+	var process *tetragon.Process
+	var args []*tetragon.KprobeArgument
+	var rate uint32
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		process, args = e.ProcessKprobe.GetProcess(), e.ProcessKprobe.GetArgs()
+		rate = e.ProcessKprobe.GetSampleRate()
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		process, args = e.ProcessTracepoint.GetProcess(), e.ProcessTracepoint.GetArgs()
+		rate = e.ProcessTracepoint.GetSampleRate()
+	default:
+		return true
+	}
+
+	for _, m := range h.metrics {
+		m.handle(process, args, max(rate, 1))
+	}
+	return h.keepEvents
+}
+
+func (m *metric) handle(process *tetragon.Process, args []*tetragon.KprobeArgument, rate uint32) {
+	value := float64(rate)
+	if m.valuePos >= 0 {
+		if m.valuePos >= len(args) {
+			return
+		}
+		n, ok := argNumber(args[m.valuePos])
+		if !ok {
+			return
+		}
+		value = n
+	}
+
+	lvs := make([]string, 0, len(m.labels))
+	for _, l := range m.labels {
+		lvs = append(lvs, labelValue(l, process, args))
+	}
+	if !m.admit(lvs) {
+		overflowMetrics.WithLabelValues(m.policy, m.namespace, m.spec.Name).Inc()
+		for i := range lvs {
+			lvs[i] = overflowValue
+		}
+	}
+	m.observe(value, append([]string{m.policy, m.namespace}, lvs...)...)
+}
+
+// admit returns whether the label values can have their own series, which
+// is the case if they were seen before or if the metric is below its maximum
+// cardinality.
+func (m *metric) admit(lvs []string) bool {
+	if len(lvs) == 0 {
+		return true
+	}
+	key := strings.Join(lvs, "\x00")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.series[key]; ok {
+		return true
+	}
+	if len(m.series) >= m.maxCardinality {
+		return false
+	}
+	m.series[key] = struct{}{}
+	return true
+}
+
+func labelValue(l label, process *tetragon.Process, args []*tetragon.KprobeArgument) string {
+	switch l.source {
+	case SourceBinary:
+		return process.GetBinary()
+	case SourcePod:
+		return process.GetPod().GetName()
+	case SourceNamespace:
+		return process.GetPod().GetNamespace()
+	case SourceWorkload:
+		return process.GetPod().GetWorkload()
+	case SourceArg:
+		if l.argPos < len(args) {
+			return argString(args[l.argPos])
+		}
+	}
+	return ""
+}
+
+func argNumber(arg *tetragon.KprobeArgument) (float64, bool) {
+	switch a := arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_IntArg:
+		return float64(a.IntArg), true
+	case *tetragon.KprobeArgument_UintArg:
+		return float64(a.UintArg), true
+	case *tetragon.KprobeArgument_SizeArg:
+		return float64(a.SizeArg), true
+	case *tetragon.KprobeArgument_LongArg:
+		return float64(a.LongArg), true
+	}
+	return 0, false
+}
+
+func argString(arg *tetragon.KprobeArgument) string {
+	switch a := arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_StringArg:
+		return a.StringArg
+	case *tetragon.KprobeArgument_BytesArg:
+		return string(a.BytesArg)
+	case *tetragon.KprobeArgument_TruncatedBytesArg:
+		return string(a.TruncatedBytesArg.GetBytesArg())
+	case *tetragon.KprobeArgument_PathArg:
+		return a.PathArg.GetPath()
+	case *tetragon.KprobeArgument_FileArg:
+		return a.FileArg.GetPath()
+	case *tetragon.KprobeArgument_LinuxBinprmArg:
+		return a.LinuxBinprmArg.GetPath()
+	case *tetragon.KprobeArgument_NetDevArg:
+		return a.NetDevArg.GetName()
+	case *tetragon.KprobeArgument_IntArg:
+		return strconv.FormatInt(int64(a.IntArg), 10)
+	case *tetragon.KprobeArgument_UintArg:
+		return strconv.FormatUint(uint64(a.UintArg), 10)
+	case *tetragon.KprobeArgument_SizeArg:
+		return strconv.FormatUint(a.SizeArg, 10)
+	case *tetragon.KprobeArgument_LongArg:
+		return strconv.FormatInt(a.LongArg, 10)
+	}
+	return ""
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package hookmetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func testSpec(metrics ...v1alpha1.MetricSpec) *v1alpha1.TracingPolicySpec {
+	return &v1alpha1.TracingPolicySpec{
+		KProbes: []v1alpha1.KProbeSpec{{
+			Call: "sys_write",
+			Args: []v1alpha1.KProbeArg{
+				{Index: 0, Type: "int"},
+				{Index: 2, Type: "size_t"},
+			},
+		}, {
+			Call: "fd_install",
+			Args: []v1alpha1.KProbeArg{
+				{Index: 1, Type: "file"},
+			},
+		}},
+		Metrics: metrics,
+	}
+}
+
+func kprobeEvent(binary string, args ...*tetragon.KprobeArgument) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			Process: &tetragon.Process{Binary: binary},
+			Args:    args,
+		}},
+	}
+}
+
+func intArg(v int32) *tetragon.KprobeArgument {
+	return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_IntArg{IntArg: v}}
+}
+
+func sizeArg(v uint64) *tetragon.KprobeArgument {
+	return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SizeArg{SizeArg: v}}
+}
+
+func TestValidateMetric(t *testing.T) {
+	idx := func(i uint32) *uint32 { return &i }
+	tests := []struct {
+		metric v1alpha1.MetricSpec
+		err    string
+	}{
+		{v1alpha1.MetricSpec{Name: "ok", Kprobe: "sys_write", Labels: []v1alpha1.MetricLabel{{Name: "fd", Source: SourceArg, Index: 0}}}, ""},
+		{v1alpha1.MetricSpec{Name: "ok", Kprobe: "sys_write", Type: TypeHistogram, ValueArgIndex: idx(2), Buckets: []int64{1, 10}}, ""},
+		{v1alpha1.MetricSpec{Name: "events_total", Kprobe: "sys_write"}, "reserved"},
+		{v1alpha1.MetricSpec{Name: "m"}, "one of kprobe and tracepoint must be set"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_read"}, `kprobe "sys_read" not found`},
+		{v1alpha1.MetricSpec{Name: "m", Tracepoint: "syscalls/sys_enter_write"}, "not found in the tracepoints"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write", Labels: []v1alpha1.MetricLabel{{Name: "a", Source: SourceArg, Index: 1}}}, "argument 1 not found"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write", Labels: []v1alpha1.MetricLabel{{Name: "policy", Source: SourceBinary}}}, "duplicate label name"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write", ValueArgIndex: idx(2)}, "only supported by histograms"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write", Type: TypeHistogram}, "valueArgIndex is required"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "fd_install", Type: TypeHistogram, ValueArgIndex: idx(1)}, "is not a number"},
+		{v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write", Type: TypeHistogram, ValueArgIndex: idx(2), Buckets: []int64{10, 1}}, "increasing order"},
+	}
+	for _, test := range tests {
+		err := ValidateMetric(testSpec(test.metric), 0)
+		if test.err == "" {
+			assert.NoError(t, err, test.metric.Name)
+		} else {
+			assert.ErrorContains(t, err, test.err, test.metric.Name)
+		}
+	}
+
+	spec := testSpec(
+		v1alpha1.MetricSpec{Name: "m", Kprobe: "sys_write"},
+		v1alpha1.MetricSpec{Name: "m", Kprobe: "fd_install"},
+	)
+	require.NoError(t, ValidateMetric(spec, 0))
+	require.ErrorContains(t, ValidateMetric(spec, 1), "duplicate metric name")
+	_, err := New("policy", "", spec)
+	require.ErrorContains(t, err, "metrics[1]: duplicate metric name")
+}
+
+func TestOmitArgs(t *testing.T) {
+	spec := testSpec(v1alpha1.MetricSpec{
+		Name:          "write_bytes",
+		Type:          TypeHistogram,
+		Kprobe:        "sys_write",
+		ValueArgIndex: func(i uint32) *uint32 { return &i }(2),
+	})
+	spec.KProbes[0].OmitArgs = []uint32{0}
+	p, err := New("writes", "", spec)
+	require.NoError(t, err)
+	// the size argument is the first argument of the events
+	m := p.Kprobe("sys_write").metrics[0]
+	assert.Equal(t, 0, m.valuePos)
+
+	spec.KProbes[0].OmitArgs = []uint32{2}
+	require.ErrorContains(t, ValidateMetric(spec, 0), "argument 2 not found")
+}
+
+func TestHandle(t *testing.T) {
+	p, err := New("writes", "default", testSpec(
+		v1alpha1.MetricSpec{
+			Name:   "writes_total",
+			Help:   "Writes.",
+			Kprobe: "sys_write",
+			Labels: []v1alpha1.MetricLabel{
+				{Name: "binary", Source: SourceBinary},
+				{Name: "fd", Source: SourceArg, Index: 0},
+			},
+			MaxCardinality: 2,
+		},
+		v1alpha1.MetricSpec{
+			Name:          "write_bytes",
+			Help:          "Write sizes.",
+			Type:          TypeHistogram,
+			Kprobe:        "sys_write",
+			ValueArgIndex: func(i uint32) *uint32 { return &i }(2),
+			Buckets:       []int64{100, 1000},
+		},
+	))
+	require.NoError(t, err)
+	require.Nil(t, p.Kprobe("fd_install"))
+	require.Nil(t, p.Tracepoint("syscalls", "sys_enter_write"))
+	hook := p.Kprobe("sys_write")
+	require.NotNil(t, hook)
+
+	assert.False(t, hook.Handle(kprobeEvent("/usr/bin/cat", intArg(1), sizeArg(10))))
+	assert.False(t, hook.Handle(kprobeEvent("/usr/bin/cat", intArg(1), sizeArg(500))))
+	assert.False(t, hook.Handle(kprobeEvent("/usr/bin/cat", intArg(2), sizeArg(5000))))
+	// above the maximum cardinality
+	assert.False(t, hook.Handle(kprobeEvent("/usr/bin/vi", intArg(1), sizeArg(10))))
+	// events of other types are not handled
+	assert.True(t, hook.Handle(&tetragon.GetEventsResponse{}))
+	// hooks without metrics keep their events
+	assert.True(t, p.Kprobe("fd_install").Handle(kprobeEvent("/usr/bin/cat")))
+
+	require.NoError(t, Register(p))
+	t.Cleanup(func() { Unregister(p) })
+
+	expected := `# HELP tetragon_policy_write_bytes Write sizes.
+# TYPE tetragon_policy_write_bytes histogram
+tetragon_policy_write_bytes_bucket{policy="writes",policy_namespace="default",le="100"} 2
+tetragon_policy_write_bytes_bucket{policy="writes",policy_namespace="default",le="1000"} 3
+tetragon_policy_write_bytes_bucket{policy="writes",policy_namespace="default",le="+Inf"} 4
+tetragon_policy_write_bytes_sum{policy="writes",policy_namespace="default"} 5520
+tetragon_policy_write_bytes_count{policy="writes",policy_namespace="default"} 4
+# HELP tetragon_policy_writes_total Writes.
+# TYPE tetragon_policy_writes_total counter
+tetragon_policy_writes_total{binary="/usr/bin/cat",fd="1",policy="writes",policy_namespace="default"} 2
+tetragon_policy_writes_total{binary="/usr/bin/cat",fd="2",policy="writes",policy_namespace="default"} 1
+tetragon_policy_writes_total{binary="overflow",fd="overflow",policy="writes",policy_namespace="default"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector{}, strings.NewReader(expected)))
+	assert.InDelta(t, 1, testutil.ToFloat64(overflowMetrics.WithLabelValues("writes", "default", "writes_total")), 0)
+}
+
+func TestHandleSampled(t *testing.T) {
+	p, err := New("sampled", "", testSpec(
+		v1alpha1.MetricSpec{Name: "sampled_writes_total", Help: "Writes.", Kprobe: "sys_write"},
+	))
+	require.NoError(t, err)
+	hook := p.Kprobe("sys_write")
+
+	ev := kprobeEvent("/usr/bin/cat")
+	ev.GetProcessKprobe().SampleRate = 100
+	hook.Handle(ev)
+	hook.Handle(kprobeEvent("/usr/bin/cat"))
+
+	require.NoError(t, Register(p))
+	t.Cleanup(func() { Unregister(p) })
+
+	expected := `# HELP tetragon_policy_sampled_writes_total Writes.
+# TYPE tetragon_policy_sampled_writes_total counter
+tetragon_policy_sampled_writes_total{policy="sampled",policy_namespace=""} 101
+`
+	require.NoError(t, testutil.CollectAndCompare(collector{}, strings.NewReader(expected)))
+}
+
+func TestKeepEvents(t *testing.T) {
+	p, err := New("writes", "", testSpec(
+		v1alpha1.MetricSpec{Name: "a", Kprobe: "sys_write"},
+		v1alpha1.MetricSpec{Name: "b", Kprobe: "sys_write", KeepEvents: true},
+	))
+	require.NoError(t, err)
+	assert.True(t, p.Kprobe("sys_write").Handle(kprobeEvent("/usr/bin/cat")))
+
+	var hook *Hook
+	assert.True(t, hook.Handle(kprobeEvent("/usr/bin/cat")))
+}
+
+func TestRegisterConflict(t *testing.T) {
+	spec := testSpec(v1alpha1.MetricSpec{Name: "writes", Kprobe: "sys_write"})
+	p1, err := New("p1", "", spec)
+	require.NoError(t, err)
+	p2, err := New("p2", "ns", spec)
+	require.NoError(t, err)
+
+	require.NoError(t, Register(p1))
+	require.NoError(t, Check(p1))
+	require.ErrorContains(t, Check(p2), `metric "writes" is already defined by policy /p1`)
+	require.Error(t, Register(p2))
+	Unregister(p1)
+	require.NoError(t, Register(p2))
+	Unregister(p2)
+}
+
+func TestRegisterNewVersion(t *testing.T) {
+	spec := testSpec(v1alpha1.MetricSpec{Name: "writes", Kprobe: "sys_write"})
+	v1, err := New("p1", "", spec)
+	require.NoError(t, err)
+	v2, err := New("p1", "", spec)
+	require.NoError(t, err)
+
+	require.NoError(t, Register(v1))
+	require.NoError(t, Check(v2))
+	require.NoError(t, Register(v2))
+	assert.Len(t, gState.policies, 1)
+	assert.Contains(t, gState.policies, v2)
+	// unregistering the replaced version does not remove the new one
+	Unregister(v1)
+	assert.Contains(t, gState.policies, v2)
+	Unregister(v2)
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package hookmetrics
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var gState = &state{
+	policies: map[*Policy]struct{}{},
+}
+
+type state struct {
+	// mu protects policies
+	mu sync.Mutex
+	// policies with metrics that are currently loaded
+	policies map[*Policy]struct{}
+}
+
+// Check returns an error if another registered policy uses one of the metric
+// names of the policy. Metric names are shared by all policies. Registered
+// versions of the same policy are ignored, since the policy replaces them.
+func Check(p *Policy) error {
+	if p == nil {
+		return nil
+	}
+	gState.mu.Lock()
+	defer gState.mu.Unlock()
+	return gState.check(p)
+}
+
+func (st *state) check(p *Policy) error {
+	for other := range st.policies {
+		if other == p || other.samePolicy(p) {
+			continue
+		}
+		for _, m := range p.metrics {
+			for _, om := range other.metrics {
+				if m.spec.Name == om.spec.Name {
+					return fmt.Errorf("metric %q is already defined by policy %s/%s", m.spec.Name, other.namespace, other.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// samePolicy returns true if both are versions of the same policy.
+func (p *Policy) samePolicy(other *Policy) bool {
+	return p.name == other.name && p.namespace == other.namespace
+}
+
+// Register makes the metrics of a policy available to the metrics server.
+// It fails if another registered policy uses one of the metric names of the
+// policy. The metrics of a registered version of the same policy are replaced.
+func Register(p *Policy) error {
+	if p == nil {
+		return nil
+	}
+	gState.mu.Lock()
+	defer gState.mu.Unlock()
+	if err := gState.check(p); err != nil {
+		return err
+	}
+	for other := range gState.policies {
+		if other.samePolicy(p) {
+			delete(gState.policies, other)
+		}
+	}
+	gState.policies[p] = struct{}{}
+	return nil
+}
+
+// Unregister removes the metrics of a policy from the metrics server.
+func Unregister(p *Policy) {
+	if p == nil {
+		return
+	}
+	gState.mu.Lock()
+	defer gState.mu.Unlock()
+	delete(gState.policies, p)
+}
+
+// collector collects the metrics of the registered policies. The metrics
+// are only known once policies are loaded, so it is an unchecked collector
+// that describes no metrics.
+type collector struct{}
+
+// Describe implements prometheus.Collector.
+func (collector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	gState.mu.Lock()
+	defer gState.mu.Unlock()
+	for p := range gState.policies {
+		for _, m := range p.metrics {
+			m.collector.Collect(ch)
+		}
+	}
+}
+
+func InitMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(overflowMetrics)
+	registry.MustRegister(collector{})
+}
+
+func InitMetricsForDocs(registry *prometheus.Registry) {
+	InitMetrics(registry)
+	overflowMetrics.InitForDocs()
+}
@@ -16,11 +16,13 @@ type Subsys int
 const (
 	RTHooksSubsys Subsys = iota
 	PodHandlersSubsys
+	NamespaceHandlersSubsys
 )
 
 var subsysLabelValues = map[Subsys]string{
-	PodHandlersSubsys: "pod-handlers",
-	RTHooksSubsys:     "rthooks",
+	PodHandlersSubsys:       "pod-handlers",
+	RTHooksSubsys:           "rthooks",
+	NamespaceHandlersSubsys: "namespace-handlers",
 }
 
 func (s Subsys) String() string {
@@ -34,13 +36,19 @@ const (
 	UpdatePodOperation
 	DeletePodOperation
 	AddContainerOperation
+	AddNamespaceOperation
+	UpdateNamespaceOperation
+	DeleteNamespaceOperation
 )
 
 var operationLabelValues = map[Operation]string{
-	AddPodOperation:       "add",
-	UpdatePodOperation:    "update",
-	DeletePodOperation:    "delete",
-	AddContainerOperation: "add-container",
+	AddPodOperation:          "add",
+	UpdatePodOperation:       "update",
+	DeletePodOperation:       "delete",
+	AddContainerOperation:    "add-container",
+	AddNamespaceOperation:    "add-namespace",
+	UpdateNamespaceOperation: "update-namespace",
+	DeleteNamespaceOperation: "delete-namespace",
 }
 
 func (s Operation) String() string {
@@ -25,6 +25,7 @@ var stateLabel = metrics.ConstrainedLabel{
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ERROR.String()), "tp_state_"),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_DISABLED.String()), "tp_state_"),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ENABLED.String()), "tp_state_"),
+		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE.String()), "tp_state_"),
 	},
 }
 
@@ -43,6 +44,24 @@ var policyKernelMemory = metrics.MustNewCustomGauge(metrics.NewOpts(
 	},
 ))
 
+var policyOmittedArgsBytes = metrics.MustNewCustomCounter(metrics.NewOpts(
+	consts.MetricsNamespace, "", "tracingpolicy_omitted_args_bytes_total",
+	"The number of bytes of arguments only used by selectors (omitArgs) that the policy's BPF programs did not copy into events.",
+	nil, nil, []metrics.UnconstrainedLabel{
+		metrics.LabelPolicy,
+		metrics.LabelPolicyNamespace,
+	},
+))
+
+var policyBudgetDroppedEvents = metrics.MustNewCustomCounter(metrics.NewOpts(
+	consts.MetricsNamespace, "", "tracingpolicy_budget_dropped_events_total",
+	"The number of events the policy's BPF programs did not post because the policy exceeded its event budget.",
+	nil, nil, []metrics.UnconstrainedLabel{
+		metrics.LabelPolicy,
+		metrics.LabelPolicyNamespace,
+	},
+))
+
 // This metric collector converts the output of ListTracingPolicies into a few
 // gauges metrics on collection. Thus, it needs a sensor manager to query.
 func NewPolicyCollector() metrics.CollectorWithInit {
@@ -50,6 +69,8 @@ func NewPolicyCollector() metrics.CollectorWithInit {
 		metrics.CustomMetrics{
 			policyState,
 			policyKernelMemory,
+			policyOmittedArgsBytes,
+			policyBudgetDroppedEvents,
 		},
 		collect,
 		collectForDocs,
@@ -76,6 +97,10 @@ func collect(ch chan<- prometheus.Metric) {
 		state := policy.State
 		counters[state]++
 		ch <- policyKernelMemory.MustMetric(float64(policy.KernelMemoryBytes), policy.Name, policy.Namespace)
+		if stats := policy.GetStats(); stats != nil {
+			ch <- policyOmittedArgsBytes.MustMetric(float64(stats.OmittedArgsBytes), policy.Name, policy.Namespace)
+			ch <- policyBudgetDroppedEvents.MustMetric(float64(stats.BudgetDroppedEvents), policy.Name, policy.Namespace)
+		}
 	}
 
 	ch <- policyState.MustMetric(
@@ -94,6 +119,10 @@ func collect(ch chan<- prometheus.Metric) {
 		float64(counters[tetragon.TracingPolicyState_TP_STATE_ENABLED]),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ENABLED.String()), "tp_state_"),
 	)
+	ch <- policyState.MustMetric(
+		float64(counters[tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE]),
+		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE.String()), "tp_state_"),
+	)
 }
 
 func collectForDocs(ch chan<- prometheus.Metric) {
@@ -101,4 +130,6 @@ func collectForDocs(ch chan<- prometheus.Metric) {
 		ch <- policyState.MustMetric(0, state)
 	}
 	ch <- policyKernelMemory.MustMetric(0, consts.ExamplePolicyLabel, consts.ExampleNamespace)
+	ch <- policyOmittedArgsBytes.MustMetric(0, consts.ExamplePolicyLabel, consts.ExampleNamespace)
+	ch <- policyBudgetDroppedEvents.MustMetric(0, consts.ExamplePolicyLabel, consts.ExampleNamespace)
 }
@@ -15,26 +15,30 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/tetragon/pkg/observer"
 	tuo "github.com/cilium/tetragon/pkg/testutils/observer"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
 func Test_policyStatusCollector_Collect(t *testing.T) {
-	expectedMetrics := func(disabled, enabled, err, load_error int) io.Reader {
+	expectedMetrics := func(disabled, enabled, err, load_error, not_applicable int) io.Reader {
 		return strings.NewReader(fmt.Sprintf(`# HELP tetragon_tracingpolicy_kernel_memory_bytes The amount of kernel memory in bytes used by policy's sensors non-shared BPF maps (memlock).
 # TYPE tetragon_tracingpolicy_kernel_memory_bytes gauge
 tetragon_tracingpolicy_kernel_memory_bytes{policy="pizza", policy_namespace=""} 0
 tetragon_tracingpolicy_kernel_memory_bytes{policy="amazing-one", policy_namespace=""} 0
 tetragon_tracingpolicy_kernel_memory_bytes{policy="amazing-one", policy_namespace="default"} 0
 tetragon_tracingpolicy_kernel_memory_bytes{policy="amazing-one", policy_namespace="kube-system"} 0
+tetragon_tracingpolicy_kernel_memory_bytes{policy="elsewhere", policy_namespace=""} 0
 # HELP tetragon_tracingpolicy_loaded The number of loaded tracing policy by state.
 # TYPE tetragon_tracingpolicy_loaded gauge
 tetragon_tracingpolicy_loaded{state="disabled"} %d
 tetragon_tracingpolicy_loaded{state="enabled"} %d
 tetragon_tracingpolicy_loaded{state="error"} %d
 tetragon_tracingpolicy_loaded{state="load_error"} %d
-`, disabled, enabled, err, load_error))
+tetragon_tracingpolicy_loaded{state="not_applicable"} %d
+`, disabled, enabled, err, load_error, not_applicable))
 	}
 
 	reg := prometheus.NewRegistry()
@@ -77,11 +81,23 @@ tetragon_tracingpolicy_loaded{state="load_error"} %d
 		},
 	})
 	require.NoError(t, err)
-	err = testutil.CollectAndCompare(collector, expectedMetrics(0, 4, 0, 0))
+	// Add a policy that does not apply to this node
+	err = manager.AddTracingPolicy(context.TODO(), &tracingpolicy.GenericTracingPolicy{
+		Metadata: v1.ObjectMeta{
+			Name: "elsewhere",
+		},
+		Spec: v1alpha1.TracingPolicySpec{
+			NodeSelector: &slimv1.LabelSelector{
+				MatchLabels: map[string]slimv1.MatchLabelsValue{"kubernetes.io/hostname": "elsewhere"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = testutil.CollectAndCompare(collector, expectedMetrics(0, 4, 0, 0, 1))
 	require.NoError(t, err)
 
 	err = manager.DisableTracingPolicy(context.TODO(), "pizza", "")
 	require.NoError(t, err)
-	err = testutil.CollectAndCompare(collector, expectedMetrics(1, 3, 0, 0))
+	err = testutil.CollectAndCompare(collector, expectedMetrics(1, 3, 0, 0, 1))
 	require.NoError(t, err)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/collectors"
 
 	"github.com/cilium/tetragon/pkg/metrics/eventmetrics"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/metrics/syscallmetrics"
 )
 
@@ -33,11 +34,13 @@ func InitResourcesMetricsForDocs(registry *prometheus.Registry) {
 func initAllEventsMetrics(registry *prometheus.Registry) {
 	eventmetrics.InitEventsMetrics(registry)
 	syscallmetrics.InitMetrics(registry)
+	hookmetrics.InitMetrics(registry)
 }
 
 func InitEventsMetricsForDocs(registry *prometheus.Registry) {
 	eventmetrics.InitEventsMetricsForDocs(registry)
 	syscallmetrics.InitMetricsForDocs(registry)
+	hookmetrics.InitMetricsForDocs(registry)
 }
 
 func InitAllMetrics(registry *prometheus.Registry) {
@@ -31,7 +31,10 @@ type config struct {
 	ForceLargeProgs bool
 	ClusterName     string
 
-	EnablePodAnnotations bool
+	EnablePodAnnotations        bool
+	EnableDestinationEnrichment bool
+	EnablePassiveDNSCache       bool
+	PassiveDNSCacheSize         int
 
 	EnableProcessAncestors            bool
 	EnableProcessKprobeAncestors      bool
@@ -69,11 +72,20 @@ type config struct {
 	DataCacheSize          int
 	ProcessCacheGCInterval time.Duration
 
+	ProcessCacheCheckpointFile     string
+	ProcessCacheCheckpointInterval time.Duration
+
 	MetricsServer      string
 	MetricsLabelFilter metrics.LabelFilter
 	ServerAddress      string
-	TracingPolicy      string
-	TracingPolicyDir   string
+	// gRPC server TLS and authorization
+	ServerTLSCertFile        string
+	ServerTLSKeyFile         string
+	ServerTLSClientCAFiles   []string
+	ServerAdminIdentities    []string
+	ServerReadOnlyIdentities []string
+	TracingPolicy            string
+	TracingPolicyDir         string
 
 	ExportFilename             string
 	ExportFileMaxSizeMB        int
@@ -82,6 +94,12 @@ type config struct {
 	ExportFileCompress         bool
 	ExportRateLimit            int
 	ExportFilePerm             string
+	ExportFileFormat           string
+
+	// Export disk buffer options
+	ExportBufferDirectory string
+	ExportBufferMaxSizeMB int
+	ExportBufferMaxAge    time.Duration
 
 	// Export aggregation options
 	EnableExportAggregation     bool
@@ -93,6 +111,9 @@ type config struct {
 	PprofAddr  string
 
 	EventQueueSize uint
+	// EventHistorySize is the number of recent events kept to resume
+	// GetEvents streams.
+	EventHistorySize uint
 
 	ReleasePinned bool
 
@@ -37,6 +37,9 @@ const (
 	KeyForceLargeProgs        = "force-large-progs"
 	KeyClusterName            = "cluster-name"
 
+	KeyProcessCacheCheckpointFile     = "process-cache-checkpoint-file"
+	KeyProcessCacheCheckpointInterval = "process-cache-checkpoint-interval"
+
 	KeyLogLevel  = "log-level"
 	KeyLogFormat = "log-format"
 
@@ -44,13 +47,22 @@ const (
 	KeyK8sKubeConfigPath    = "k8s-kubeconfig-path"
 	KeyK8sControlPlaneRetry = "k8s-controlplane-retry"
 
-	KeyEnablePodAnnotations = "enable-pod-annotations"
+	KeyEnablePodAnnotations        = "enable-pod-annotations"
+	KeyEnableDestinationEnrichment = "enable-destination-enrichment"
+	KeyEnablePassiveDNSCache       = "enable-passive-dns-cache"
+	KeyPassiveDNSCacheSize         = "passive-dns-cache-size"
 
 	KeyMetricsServer      = "metrics-server"
 	KeyMetricsLabelFilter = "metrics-label-filter"
 	KeyServerAddress      = "server-address"
 	KeyGopsAddr           = "gops-address"
 
+	KeyServerTLSCertFile        = "server-tls-cert-file"
+	KeyServerTLSKeyFile         = "server-tls-key-file"
+	KeyServerTLSClientCAFiles   = "server-tls-client-ca-files"
+	KeyServerAdminIdentities    = "server-admin-identities"
+	KeyServerReadOnlyIdentities = "server-read-only-identities"
+
 	KeyEnableProcessEnvironmentVariables = "enable-process-environment-variables"
 
 	KeyFilterEnvironmentVariables = "filter-environment-variables"
@@ -72,6 +84,11 @@ const (
 	KeyExportFileCompress         = "export-file-compress"
 	KeyExportRateLimit            = "export-rate-limit"
 	KeyExportFilePerm             = "export-file-perm"
+	KeyExportFileFormat           = "export-file-format"
+
+	KeyExportBufferDirectory = "export-buffer-directory"
+	KeyExportBufferMaxSizeMB = "export-buffer-max-size-mb"
+	KeyExportBufferMaxAge    = "export-buffer-max-age"
 
 	KeyEnableExportAggregation     = "enable-export-aggregation"
 	KeyExportAggregationWindowSize = "export-aggregation-window-size"
@@ -80,6 +97,9 @@ const (
 	KeyExportAllowlist = "export-allowlist"
 	KeyExportDenylist  = "export-denylist"
 
+	KeyExportSinks = "export-sinks"
+	KeyExporters   = "exporters"
+
 	KeyFieldFilters     = "field-filters"
 	KeyRedactionFilters = "redaction-filters"
 
@@ -93,7 +113,8 @@ const (
 	KeyRBSizeTotal       = "rb-size-total"
 	KeyRBQueueSize       = "rb-queue-size"
 
-	KeyEventQueueSize = "event-queue-size"
+	KeyEventQueueSize   = "event-queue-size"
+	KeyEventHistorySize = "event-history-size"
 
 	KeyReleasePinnedBPF = "release-pinned-bpf"
 
@@ -226,10 +247,17 @@ func ReadAndSetFlags() error {
 	if Config.ProcessCacheGCInterval <= 0 {
 		return errors.New("failed to parse process-cache-gc-interval value. Must be >= 0")
 	}
+	Config.ProcessCacheCheckpointFile = viper.GetString(KeyProcessCacheCheckpointFile)
+	Config.ProcessCacheCheckpointInterval = viper.GetDuration(KeyProcessCacheCheckpointInterval)
 
 	Config.MetricsServer = viper.GetString(KeyMetricsServer)
 	Config.MetricsLabelFilter = DefaultLabelFilter().WithEnabledLabels(ParseMetricsLabelFilter(viper.GetString(KeyMetricsLabelFilter)))
 	Config.ServerAddress = viper.GetString(KeyServerAddress)
+	Config.ServerTLSCertFile = viper.GetString(KeyServerTLSCertFile)
+	Config.ServerTLSKeyFile = viper.GetString(KeyServerTLSKeyFile)
+	Config.ServerTLSClientCAFiles = viper.GetStringSlice(KeyServerTLSClientCAFiles)
+	Config.ServerAdminIdentities = viper.GetStringSlice(KeyServerAdminIdentities)
+	Config.ServerReadOnlyIdentities = viper.GetStringSlice(KeyServerReadOnlyIdentities)
 
 	Config.ExportFilename = viper.GetString(KeyExportFilename)
 	Config.ExportFileMaxSizeMB = viper.GetInt(KeyExportFileMaxSizeMB)
@@ -238,6 +266,10 @@ func ReadAndSetFlags() error {
 	Config.ExportFileCompress = viper.GetBool(KeyExportFileCompress)
 	Config.ExportRateLimit = viper.GetInt(KeyExportRateLimit)
 	Config.ExportFilePerm = viper.GetString(KeyExportFilePerm)
+	Config.ExportFileFormat = viper.GetString(KeyExportFileFormat)
+	Config.ExportBufferDirectory = viper.GetString(KeyExportBufferDirectory)
+	Config.ExportBufferMaxSizeMB = viper.GetInt(KeyExportBufferMaxSizeMB)
+	Config.ExportBufferMaxAge = viper.GetDuration(KeyExportBufferMaxAge)
 
 	Config.EnableExportAggregation = viper.GetBool(KeyEnableExportAggregation)
 	Config.ExportAggregationWindowSize = viper.GetDuration(KeyExportAggregationWindowSize)
@@ -248,6 +280,7 @@ func ReadAndSetFlags() error {
 	Config.PprofAddr = viper.GetString(KeyPprofAddr)
 
 	Config.EventQueueSize = viper.GetUint(KeyEventQueueSize)
+	Config.EventHistorySize = viper.GetUint(KeyEventHistorySize)
 
 	Config.ReleasePinned = viper.GetBool(KeyReleasePinnedBPF)
 	Config.EnablePolicyFilter = viper.GetBool(KeyEnablePolicyFilter)
@@ -261,6 +294,9 @@ func ReadAndSetFlags() error {
 
 	Config.EnablePodInfo = viper.GetBool(KeyEnablePodInfo)
 	Config.EnablePodAnnotations = viper.GetBool(KeyEnablePodAnnotations)
+	Config.EnableDestinationEnrichment = viper.GetBool(KeyEnableDestinationEnrichment)
+	Config.EnablePassiveDNSCache = viper.GetBool(KeyEnablePassiveDNSCache)
+	Config.PassiveDNSCacheSize = viper.GetInt(KeyPassiveDNSCacheSize)
 	Config.EnableTracingPolicyCRD = viper.GetBool(KeyEnableTracingPolicyCRD)
 
 	Config.TracingPolicy = viper.GetString(KeyTracingPolicy)
@@ -382,6 +418,8 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.Int(KeyProcessCacheSize, 65536, "Size of the process cache")
 	flags.Int(KeyDataCacheSize, 1024, "Size of the data events cache")
 	flags.Duration(KeyProcessCacheGCInterval, defaults.DefaultProcessCacheGCInterval, "Time between checking the process cache for old entries")
+	flags.String(KeyProcessCacheCheckpointFile, "", "File where the process cache is checkpointed on shutdown and periodically, and restored from on startup. Disabled by default")
+	flags.Duration(KeyProcessCacheCheckpointInterval, time.Minute, "Time between process cache checkpoints. 0 only checkpoints on shutdown")
 	flags.Bool(KeyForceSmallProgs, false, "Force loading small programs, even in kernels with >= 5.3 versions")
 	flags.Bool(KeyForceLargeProgs, false, "Force loading large programs, even in kernels with < 5.3 versions")
 	flags.String(KeyExportFilename, "", "Filename for JSON export. Disabled by default")
@@ -390,7 +428,11 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.Int(KeyExportFileMaxBackups, 5, "Number of rotated JSON export files to retain")
 	flags.Bool(KeyExportFileCompress, false, "Compress rotated JSON export files")
 	flags.String(KeyExportFilePerm, defaults.DefaultLogsPermission, "Access permissions on JSON export files")
+	flags.String(KeyExportFileFormat, "json", "Encoding of exported events. json, protobuf (length-delimited) or cbor")
 	flags.Int(KeyExportRateLimit, -1, "Rate limit (per minute) for event export. Set to -1 to disable")
+	flags.String(KeyExportBufferDirectory, "", "Directory of a disk buffer holding the events until they are written to the export file, so that they survive a slow destination or a restart. Requires --export-filename. Disabled by default")
+	flags.Int(KeyExportBufferMaxSizeMB, 1024, "Maximum size in MB of the export disk buffer. The oldest events are evicted when it is reached")
+	flags.Duration(KeyExportBufferMaxAge, 0, "Maximum age of the events of the export disk buffer. Older events are evicted. Set to 0 to disable")
 	flags.String(KeyLogLevel, "info", "Set log level")
 	flags.String(KeyLogFormat, "text", "Set log format")
 	flags.Bool(KeyEnableK8sAPI, false, "Access Kubernetes API to associate Tetragon events with Kubernetes pods")
@@ -399,11 +441,20 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.String(KeyMetricsServer, "", "Metrics server address (e.g. ':2112'). Disabled by default")
 	flags.String(KeyMetricsLabelFilter, "namespace,workload,pod,binary", "Comma-separated list of enabled metrics labels. Unknown labels will be ignored.")
 	flags.String(KeyServerAddress, "localhost:54321", "gRPC server address (e.g. 'localhost:54321' or 'unix:///var/run/tetragon/tetragon.sock'). An empty address disables the gRPC server")
+	flags.String(KeyServerTLSCertFile, "", "PEM encoded certificate of the gRPC server. Enables TLS")
+	flags.String(KeyServerTLSKeyFile, "", "PEM encoded key of the gRPC server certificate")
+	flags.StringSlice(KeyServerTLSClientCAFiles, nil, "Comma-separated list of PEM encoded CA certificates. If set, gRPC clients have to present a certificate signed by one of them (mutual TLS). Certificates are reloaded when their files change")
+	flags.StringSlice(KeyServerAdminIdentities, nil, "Comma-separated list of gRPC client identities allowed to call all RPCs. An identity is the common name or a DNS, URI or email SAN of a client certificate. Setting identities enables authorization and requires mutual TLS")
+	flags.StringSlice(KeyServerReadOnlyIdentities, nil, "Comma-separated list of gRPC client identities only allowed to get events and list policies and sensors. See --server-admin-identities")
 	flags.String(KeyGopsAddr, "", "gops server address (e.g. 'localhost:8118'). Disabled by default")
 	flags.Bool(KeyEnableProcessCred, false, "Enable process_cred events")
 	flags.Bool(KeyEnableProcessNs, false, "Enable namespace information in process_exec and process_kprobe events")
 	flags.Uint(KeyEventQueueSize, 10000, "Set the size of the internal event queue.")
+	flags.Uint(KeyEventHistorySize, 10000, "Number of recent events kept in memory so that GetEvents clients can resume their stream without gaps. 0 disables the history.")
 	flags.Bool(KeyEnablePodAnnotations, false, "Add pod annotations field to events.")
+	flags.Bool(KeyEnableDestinationEnrichment, false, "Add the pod, workload and service of the destination address to the sock, skb and sockaddr arguments of events. Requires the Kubernetes API")
+	flags.Bool(KeyEnablePassiveDNSCache, false, "Record the domain names resolved by the DNS responses received by the node, and add them to the sock and sockaddr arguments of events")
+	flags.Int(KeyPassiveDNSCacheSize, 65536, "Maximum number of addresses in the passive DNS cache")
 	flags.StringSlice(KeyEnableAncestors, []string{}, "Comma-separated list of process event types to enable ancestors for. Supported event types are: base, kprobe, tracepoint, loader, uprobe, lsm, usdt. Unknown event types will be ignored. Type 'base' enables ancestors for process_exec and process_exit events and is required by all other supported event types for correct reference counting. An empty string disables ancestors completely")
 
 	flags.Bool(KeyEnableProcessEnvironmentVariables, false, "Include environment variables in process_exec events. Disabled by default. Note that this option can significantly increase the size of the events and may impact performance, as well as capture sensitive information such as passwords in the events (you can use --redaction-filters to redact the data).")
@@ -437,6 +488,10 @@ func AddFlags(flags *pflag.FlagSet) {
 	// Field filters options for export
 	flags.String(KeyFieldFilters, "", "Field filters for event exports")
 
+	// Network export sinks
+	flags.String(KeyExportSinks, "", "YAML list of network export sinks (otlp, syslog or http), each with its own endpoint, filters and retry options. Disabled by default")
+	flags.String(KeyExporters, "", "YAML list of named exporters, each with its own destination (JSON file or network sink), filters, rate limit and aggregation. Disabled by default")
+
 	// Redaction filters
 	flags.String(KeyRedactionFilters, "", "Redaction filters for events")
 
@@ -13,19 +13,20 @@ var (
 )
 
 type Callbacks struct {
-	PodCallbacks func(podInformer cache.SharedIndexInformer)
+	PodCallbacks       func(podInformer cache.SharedIndexInformer)
+	NamespaceCallbacks func(namespaceInformer cache.SharedIndexInformer)
 }
 
 // RegisterCallbacksAtInit registers callbacks.
-// Must be called before InstallHooks and callers need to be serialized externally.
+// Must be called before InstallHooks and InstallNamespaceHooks, and callers need to be serialized externally.
 func RegisterCallbacksAtInit(cbs Callbacks) {
 	if !allowRegister {
-		panic("podhooks.RegisterCallbacksAtInit must be called before podhooks.InstallHooks()")
+		panic("podhooks.RegisterCallbacksAtInit must be called before podhooks.InstallHooks() and podhooks.InstallNamespaceHooks()")
 	}
 	allCallbacks = append(allCallbacks, cbs)
 }
 
-// InstallHooks executes all registered callbacks
+// InstallHooks executes all registered pod callbacks
 func InstallHooks(podInformer cache.SharedIndexInformer) {
 	allowRegister = false
 	for _, cbs := range allCallbacks {
@@ -34,3 +35,13 @@ func InstallHooks(podInformer cache.SharedIndexInformer) {
 		}
 	}
 }
+
+// InstallNamespaceHooks executes all registered namespace callbacks
+func InstallNamespaceHooks(namespaceInformer cache.SharedIndexInformer) {
+	allowRegister = false
+	for _, cbs := range allCallbacks {
+		if fn := cbs.NamespaceCallbacks; fn != nil {
+			fn(namespaceInformer)
+		}
+	}
+}
@@ -59,8 +59,18 @@ func ModeFromBPFMap(fname string) (Mode, error) {
 }
 
 func PolicyMode(tp tracingpolicy.TracingPolicy) (Mode, error) {
-	fname := filepath.Join(bpf.MapPrefixPath(), tracingpolicy.PolicyDir(tracingpolicy.Namespace(tp), tp.TpName()), PolicyConfMapName)
-	return ModeFromBPFMap(fname)
+	return PolicyGenerationMode(tp, 0)
+}
+
+func policyConfMapPath(tp tracingpolicy.TracingPolicy, generation uint64) string {
+	dir := tracingpolicy.PolicyGenerationDir(tracingpolicy.Namespace(tp), tp.TpName(), generation)
+	return filepath.Join(bpf.MapPrefixPath(), dir, PolicyConfMapName)
+}
+
+// PolicyGenerationMode returns the mode of the policy loaded for the given
+// generation, see tracingpolicy.PolicyGenerationDir.
+func PolicyGenerationMode(tp tracingpolicy.TracingPolicy, generation uint64) (Mode, error) {
+	return ModeFromBPFMap(policyConfMapPath(tp, generation))
 }
 
 func SetModeInBPFMap(fname string, mode Mode) error {
@@ -81,6 +91,12 @@ func SetModeInBPFMap(fname string, mode Mode) error {
 }
 
 func SetPolicyMode(tp tracingpolicy.TracingPolicy, m Mode) error {
+	return SetPolicyGenerationMode(tp, 0, m)
+}
+
+// SetPolicyGenerationMode sets the mode of the policy loaded for the given
+// generation, see tracingpolicy.PolicyGenerationDir.
+func SetPolicyGenerationMode(tp tracingpolicy.TracingPolicy, generation uint64, m Mode) error {
 	// While we will never be called by collection.go with monitor_only,
 	// enforce this anyway.
 	if m == MonitorOnlyMode {
@@ -89,7 +105,7 @@ func SetPolicyMode(tp tracingpolicy.TracingPolicy, m Mode) error {
 
 	// Check that the policy is not currently in monitor_only mode,
 	// otherwise reject the mode update.
-	currMode, err := PolicyMode(tp)
+	currMode, err := PolicyGenerationMode(tp, generation)
 	if err != nil {
 		return err
 	}
@@ -97,6 +113,5 @@ func SetPolicyMode(tp tracingpolicy.TracingPolicy, m Mode) error {
 		return errors.New("cannot set policy mode on a policy that is monitor only")
 	}
 
-	fname := filepath.Join(bpf.MapPrefixPath(), tracingpolicy.PolicyDir(tracingpolicy.Namespace(tp), tp.TpName()), PolicyConfMapName)
-	return SetModeInBPFMap(fname, m)
+	return SetModeInBPFMap(policyConfMapPath(tp, generation), m)
 }
@@ -22,7 +22,8 @@ type disabled struct {
 }
 
 func (s *disabled) AddPolicy(polID PolicyID, namespace string, podSelector *slimv1.LabelSelector,
-	containerSelector *slimv1.LabelSelector) error {
+	containerSelector *slimv1.LabelSelector, namespaceSelector *slimv1.LabelSelector,
+	workloadSelector *slimv1.LabelSelector) error {
 	return errors.New("policyfilter is disabled")
 }
 
@@ -51,9 +52,20 @@ func (s *disabled) DelPod(podID PodID) error {
 	return nil
 }
 
+func (s *disabled) UpdateNamespace(namespace string, nsLabels labels.Labels) error {
+	return nil
+}
+
+func (s *disabled) DelNamespace(namespace string) error {
+	return nil
+}
+
 func (s *disabled) RegisterPodHandlers(podInformer cache.SharedIndexInformer) {
 }
 
+func (s *disabled) RegisterNamespaceHandlers(namespaceInformer cache.SharedIndexInformer) {
+}
+
 func (s *disabled) Close() error {
 	return nil
 }
@@ -343,9 +343,9 @@ func (ts *testState) containersCgroupIDs(t *testing.T, podContainerMap map[strin
 }
 
 func testNamespacePods(t *testing.T, st *state, ts *testState) {
-	err := st.AddPolicy(PolicyID(1), "ns1", nil, nil)
+	err := st.AddPolicy(PolicyID(1), "ns1", nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = st.AddPolicy(PolicyID(2), "ns2", nil, nil)
+	err = st.AddPolicy(PolicyID(2), "ns2", nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	emptyLabels := labels.Labels{}
@@ -406,7 +406,7 @@ func testPodLabelFilters(t *testing.T, st *state, ts *testState) {
 	matchesAllID := uint32(1)
 	matchesWebID := uint32(2)
 	matchesAppsID := uint32(3)
-	err := st.AddPolicy(PolicyID(matchesAllID), "", nil, nil)
+	err := st.AddPolicy(PolicyID(matchesAllID), "", nil, nil, nil, nil)
 	require.NoError(t, err)
 	err = st.AddPolicy(PolicyID(matchesWebID), "", &slimv1.LabelSelector{
 		MatchExpressions: []slimv1.LabelSelectorRequirement{{
@@ -414,14 +414,14 @@ func testPodLabelFilters(t *testing.T, st *state, ts *testState) {
 			Operator: slimv1.LabelSelectorOpIn,
 			Values:   []string{"web"},
 		}},
-	}, nil)
+	}, nil, nil, nil)
 	require.NoError(t, err)
 	err = st.AddPolicy(PolicyID(matchesAppsID), "", &slimv1.LabelSelector{
 		MatchExpressions: []slimv1.LabelSelectorRequirement{{
 			Key:      "app",
 			Operator: slimv1.LabelSelectorOpExists,
 		}},
-	}, nil)
+	}, nil, nil, nil)
 	require.NoError(t, err)
 
 	// create pods
@@ -496,7 +496,7 @@ func testContainerFieldFilters(t *testing.T, st *state, ts *testState) {
 	matchesAllContainers := uint32(1)
 	matchesWebContainers := uint32(2)
 	matchesNotInitContainers := uint32(3)
-	err := st.AddPolicy(PolicyID(matchesAllContainers), "", nil, nil)
+	err := st.AddPolicy(PolicyID(matchesAllContainers), "", nil, nil, nil, nil)
 	require.NoError(t, err)
 	err = st.AddPolicy(PolicyID(matchesWebContainers), "", nil,
 		&slimv1.LabelSelector{
@@ -505,7 +505,7 @@ func testContainerFieldFilters(t *testing.T, st *state, ts *testState) {
 				Operator: slimv1.LabelSelectorOpIn,
 				Values:   []string{"web-c1", "web-c2", "web-c3"},
 			}},
-		})
+		}, nil, nil)
 	require.NoError(t, err)
 	err = st.AddPolicy(PolicyID(matchesNotInitContainers), "", &slimv1.LabelSelector{
 		MatchExpressions: []slimv1.LabelSelectorRequirement{{
@@ -519,7 +519,7 @@ func testContainerFieldFilters(t *testing.T, st *state, ts *testState) {
 			Operator: slimv1.LabelSelectorOpNotIn,
 			Values:   []string{"init"},
 		}},
-	})
+	}, nil, nil)
 	require.NoError(t, err)
 
 	// create pods
@@ -633,7 +633,7 @@ func testPreExistingPods(t *testing.T, st *state, ts *testState) {
 			Operator: slimv1.LabelSelectorOpIn,
 			Values:   []string{"web"},
 		}},
-	}, nil)
+	}, nil, nil, nil)
 	require.NoError(t, err)
 
 	require.Len(t, ts.podsCgroupIDs(t, "web"), 2)
@@ -669,7 +669,7 @@ func testContainersChange(t *testing.T, st *state, ts *testState) {
 					Operator: slimv1.LabelSelectorOpNotIn,
 					Values:   []string{"log-c1"},
 				}},
-		})
+		}, nil, nil)
 	require.NoError(t, err)
 
 	require.Len(t, ts.podsCgroupIDs(t, "web"), 2)
@@ -79,8 +79,11 @@ type State interface {
 	//  - namespace for namespaced pilicies (if namespace == "", then policy is not namespaced)
 	//  - label selector
 	//  - container field selector
+	//  - namespace label selector
+	//  - workload field selector
 	AddPolicy(polID PolicyID, namespace string, podSelector *slimv1.LabelSelector,
-		containerSelector *slimv1.LabelSelector) error
+		containerSelector *slimv1.LabelSelector, namespaceSelector *slimv1.LabelSelector,
+		workloadSelector *slimv1.LabelSelector) error
 
 	// DelPolicy removes a policy from the state
 	DelPolicy(polID PolicyID) error
@@ -101,6 +104,13 @@ type State interface {
 	// DelPod informs policyfilter that a pod has been deleted
 	DelPod(podID PodID) error
 
+	// UpdateNamespace updates the labels of a namespace. Policies with a namespace selector
+	// are matched again against the pods of the namespace if the labels changed.
+	// This method is intended to be used from k8s watchers
+	UpdateNamespace(namespace string, nsLabels labels.Labels) error
+	// DelNamespace informs policyfilter that a namespace has been deleted
+	DelNamespace(namespace string) error
+
 	// Report opaque cgroup ID to nsId mapping. This method is intended to allow inspecting
 	// and reporting the state of the system to subsystems and tooling.
 	GetNsId(stateID StateID) (*NSID, bool)
@@ -111,6 +121,10 @@ type State interface {
 	// that for keeping the policy filter state up-to-date.
 	RegisterPodHandlers(podInformer cache.SharedIndexInformer)
 
+	// RegisterNamespaceHandlers can be used to register appropriate namespace handlers to a
+	// namespace informer for keeping the namespace labels of the policy filter state up-to-date.
+	RegisterNamespaceHandlers(namespaceInformer cache.SharedIndexInformer)
+
 	// Close releases resources allocated by the Manager. Specifically, we close and unpin the
 	// policy filter map.
 	Close() error
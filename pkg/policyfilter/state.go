@@ -33,6 +33,8 @@ import (
 //   (1) Namespaces
 //   (2) Label filters
 //   (3) Container field filters
+//   (4) Namespace label filters
+//   (5) Workload field filters
 //
 // This package maintains the 'policy_filter_maps' bpf map. Bpf checks this map
 // to decide whether a policy is applied or not. The map is a hash-of-hashes:
@@ -54,6 +56,9 @@ import (
 //  (C) Pod labels change: need to rescan policies because the result of pod label filters might have
 //  changed. See UpdatePod.
 //
+//  (D) Namespace labels change: need to rescan policies for the pods of the namespace because the
+//  result of namespace label filters might have changed. See UpdateNamespace.
+//
 // Todo:
 //  - use a goroutine and a queue
 //  (https://github.com/kubernetes/client-go/blob/master/examples/workqueue/main.go) instead locks
@@ -68,6 +73,13 @@ func init() {
 				pfState.RegisterPodHandlers(podInformer)
 			}
 		},
+		NamespaceCallbacks: func(namespaceInformer cache.SharedIndexInformer) {
+			// register namespace handlers for policyfilters
+			if pfState, err := GetState(); err == nil {
+				logger.GetLogger().Info("registering policyfilter namespace handlers")
+				pfState.RegisterNamespaceHandlers(namespaceInformer)
+			}
+		},
 	})
 }
 
@@ -203,14 +215,26 @@ type policy struct {
 
 	podSelector labels.Selector
 
+	// namespaceSelector matches the labels of the pod namespace
+	namespaceSelector labels.Selector
+
+	// workloadSelector matches the workload fields of the pod
+	workloadSelector labels.Selector
+
 	// polMap is the (inner) policy map for this policy
 	polMap polMap
 }
 
-func (pol *policy) podMatches(podNs string, podLabels labels.Labels) bool {
+func (pol *policy) podMatches(podNs string, podLabels labels.Labels, nsLabels labels.Labels) bool {
 	if pol.namespace != "" && podNs != pol.namespace {
 		return false
 	}
+	if nsLabels == nil {
+		nsLabels = make(labels.Labels)
+	}
+	if !pol.namespaceSelector.Match(nsLabels) {
+		return false
+	}
 	var podLabels1 labels.Labels
 	if podLabels != nil {
 		podLabels1 = podLabels
@@ -225,8 +249,16 @@ func (pol *policy) podMatches(podNs string, podLabels labels.Labels) bool {
 	return pol.podSelector.Match(podLabels1)
 }
 
-func (pol *policy) podInfoMatches(pod *podInfo) bool {
-	return pol.podMatches(pod.namespace, pod.labels)
+func (pol *policy) workloadMatches(workload, kind string) bool {
+	workloadFilterFields := labels.Labels{
+		"name": workload,
+		"kind": kind,
+	}
+	return pol.workloadSelector.Match(workloadFilterFields)
+}
+
+func (pol *policy) podInfoMatches(pod *podInfo, nsLabels labels.Labels) bool {
+	return pol.podMatches(pod.namespace, pod.labels, nsLabels) && pol.workloadMatches(pod.workload, pod.kind)
 }
 
 func (pol *policy) containerMatches(container *containerInfo) bool {
@@ -259,6 +291,9 @@ type state struct {
 	policies []policy
 	pods     []podInfo
 
+	// namespaces holds the labels of the namespaces, as reported by UpdateNamespace
+	namespaces map[string]labels.Labels
+
 	// polify filters (outer) map handle
 	pfMap PfMap
 
@@ -290,6 +325,7 @@ func newState(
 	ret := &state{
 		log:         log,
 		cgidFinder:  cgidFinder,
+		namespaces:  make(map[string]labels.Labels),
 		DebugLogger: logger.NewDebugLogger(log, option.Config.EnablePolicyFilterDebug),
 	}
 
@@ -387,6 +423,45 @@ func (m *state) RegisterPodHandlers(podInformer cache.SharedIndexInformer) {
 	podInformer.AddEventHandler(m.getPodEventHandlers())
 }
 
+func (m *state) getNamespaceEventHandlers() cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			ns, ok := obj.(*v1.Namespace)
+			if !ok {
+				logger.GetLogger().Warn(fmt.Sprintf("policyfilter, add-namespace handler: unexpected object type: %T", obj))
+				return
+			}
+			err := m.UpdateNamespace(ns.Name, ns.Labels)
+			policyfiltermetrics.OpInc(policyfiltermetrics.NamespaceHandlersSubsys, policyfiltermetrics.AddNamespaceOperation, ErrorLabel(err))
+		},
+		UpdateFunc: func(_, newObj any) {
+			ns, ok := newObj.(*v1.Namespace)
+			if !ok {
+				logger.GetLogger().Warn(fmt.Sprintf("policyfilter, update-namespace handler: unexpected object type: %T", newObj))
+				return
+			}
+			err := m.UpdateNamespace(ns.Name, ns.Labels)
+			policyfiltermetrics.OpInc(policyfiltermetrics.NamespaceHandlersSubsys, policyfiltermetrics.UpdateNamespaceOperation, ErrorLabel(err))
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			ns, ok := obj.(*v1.Namespace)
+			if !ok {
+				logger.GetLogger().Warn(fmt.Sprintf("policyfilter, delete-namespace handler: unexpected object type: %T", obj))
+				return
+			}
+			err := m.DelNamespace(ns.Name)
+			policyfiltermetrics.OpInc(policyfiltermetrics.NamespaceHandlersSubsys, policyfiltermetrics.DeleteNamespaceOperation, ErrorLabel(err))
+		},
+	}
+}
+
+func (m *state) RegisterNamespaceHandlers(namespaceInformer cache.SharedIndexInformer) {
+	namespaceInformer.AddEventHandler(m.getNamespaceEventHandlers())
+}
+
 // Close releases resources allocated by the Manager. Specifically, we close and unpin the policy filter map.
 func (m *state) Close() error {
 	return m.pfMap.release()
@@ -434,7 +509,8 @@ func (m *state) delPod(id PodID) *podInfo {
 
 // AddPolicy adds a policy
 func (m *state) AddPolicy(polID PolicyID, namespace string, podLabelSelector *slimv1.LabelSelector,
-	containerLabelSelector *slimv1.LabelSelector) error {
+	containerLabelSelector *slimv1.LabelSelector, namespaceLabelSelector *slimv1.LabelSelector,
+	workloadLabelSelector *slimv1.LabelSelector) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -452,11 +528,23 @@ func (m *state) AddPolicy(polID PolicyID, namespace string, podLabelSelector *sl
 		return err
 	}
 
+	namespaceSelector, err := labels.SelectorFromLabelSelector(namespaceLabelSelector)
+	if err != nil {
+		return err
+	}
+
+	workloadSelector, err := labels.SelectorFromLabelSelector(workloadLabelSelector)
+	if err != nil {
+		return err
+	}
+
 	policy := policy{
 		id:                polID,
 		namespace:         namespace,
 		podSelector:       podSelector,
 		containerSelector: containerSelector,
+		namespaceSelector: namespaceSelector,
+		workloadSelector:  workloadSelector,
 	}
 
 	cgroupIDs := make([]CgroupID, 0)
@@ -464,7 +552,7 @@ func (m *state) AddPolicy(polID PolicyID, namespace string, podLabelSelector *sl
 	matchedPods := make([]*podInfo, 0, len(m.pods))
 	for i := range m.pods {
 		pod := &m.pods[i]
-		if !policy.podInfoMatches(pod) {
+		if !policy.podInfoMatches(pod, m.namespaces[pod.namespace]) {
 			continue
 		}
 
@@ -677,7 +765,7 @@ func (m *state) addNewPod(podID PodID, namespace, workload, kind string, podLabe
 	pod := &m.pods[len(m.pods)-1]
 	for i := range m.policies {
 		pol := &m.policies[i]
-		if pol.podInfoMatches(pod) {
+		if pol.podInfoMatches(pod, m.namespaces[namespace]) {
 			pod.addCachedPolicy(pol.id)
 		}
 	}
@@ -787,7 +875,7 @@ type policiesDiffRes struct {
 	newMatchedPolicies []PolicyID
 }
 
-func (m *state) policiesDiff(pod *podInfo, newLabels labels.Labels) *policiesDiffRes {
+func (m *state) policiesDiff(pod *podInfo, newLabels labels.Labels, nsLabels labels.Labels) *policiesDiffRes {
 	addedPolicies := []*policy{}
 	deletedPolicies := []*policy{}
 
@@ -795,7 +883,7 @@ func (m *state) policiesDiff(pod *podInfo, newLabels labels.Labels) *policiesDif
 	for i := range m.policies {
 		pol := &m.policies[i]
 		podHasPolicy := pod.hasPolicy(pol.id)
-		if pol.podMatches(pod.namespace, newLabels) {
+		if pol.podMatches(pod.namespace, newLabels, nsLabels) && pol.workloadMatches(pod.workload, pod.kind) {
 			newMatchedPolicies = append(newMatchedPolicies, pol.id)
 			if !podHasPolicy {
 				// policy matches, but pod does not have it in its matched policies.
@@ -926,7 +1014,7 @@ func (m *state) UpdatePod(podID PodID, namespace, workload, kind string, podLabe
 	// - did match before, but they do not match now (delPols))
 	// and update state accordingly
 	if pod.labels.Cmp(podLabels) {
-		polDiff := m.policiesDiff(pod, podLabels)
+		polDiff := m.policiesDiff(pod, podLabels, m.namespaces[pod.namespace])
 		m.DebugLogWithCallers(1).Info("UpdatePod: pod labels changed",
 			"pod-id", pod.id,
 			"pod-old-labels", pod.labels,
@@ -963,6 +1051,50 @@ func (m *state) UpdatePod(podID PodID, namespace, workload, kind string, podLabe
 	return nil
 }
 
+// UpdateNamespace updates the labels of a namespace.
+// If the labels changed, policies are matched again against the pods of the namespace, so that
+// policies with a namespace selector apply to the pods of the namespaces that match it.
+//
+// It is intended to be used from k8s watchers.
+func (m *state) UpdateNamespace(namespace string, nsLabels labels.Labels) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	oldLabels, exists := m.namespaces[namespace]
+	if nsLabels == nil {
+		nsLabels = make(labels.Labels)
+	}
+	m.namespaces[namespace] = nsLabels
+	if exists && !oldLabels.Cmp(nsLabels) {
+		return nil
+	}
+
+	for i := range m.pods {
+		pod := &m.pods[i]
+		if pod.namespace != namespace {
+			continue
+		}
+		polDiff := m.policiesDiff(pod, pod.labels, nsLabels)
+		m.DebugLogWithCallers(1).Info("UpdateNamespace: namespace labels changed",
+			"pod-id", pod.id,
+			"namespace", namespace,
+			"namespace-old-labels", oldLabels,
+			"namespace-new-labels", nsLabels,
+			"policy-diff", fmt.Sprintf("%+v", polDiff))
+		m.applyPodPolicyDiff(pod, polDiff)
+	}
+	return nil
+}
+
+// DelNamespace informs policyfilter that a namespace has been deleted
+func (m *state) DelNamespace(namespace string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.namespaces, namespace)
+	return nil
+}
+
 func (m *state) GetNsId(stateID StateID) (*NSID, bool) {
 	if ns, ok := m.nsMap.nsIdMap.Get(stateID); ok {
 		return &ns, ok
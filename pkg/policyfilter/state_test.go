@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/tetragon/pkg/labels"
 	"github.com/cilium/tetragon/pkg/podhelpers"
 )
 
@@ -21,11 +23,11 @@ func TestState(t *testing.T) {
 	}
 	defer s.Close()
 
-	err = s.AddPolicy(PolicyID(1), "ns1", nil, nil)
+	err = s.AddPolicy(PolicyID(1), "ns1", nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = s.AddPolicy(PolicyID(2), "ns2", nil, nil)
+	err = s.AddPolicy(PolicyID(2), "ns2", nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = s.AddPolicy(PolicyID(3), "ns3", nil, nil)
+	err = s.AddPolicy(PolicyID(3), "ns3", nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	pod1 := PodID(uuid.New())
@@ -104,3 +106,99 @@ func TestState(t *testing.T) {
 	require.Empty(t, s.policies)
 	require.Empty(t, s.pods)
 }
+
+func TestStateNamespaceWorkloadSelectors(t *testing.T) {
+	s, err := New(true)
+	if err != nil {
+		t.Skipf("failed to inialize policy filter state: %s", err)
+	}
+	defer s.Close()
+
+	err = s.UpdateNamespace("ns1", labels.Labels{"tenant": "a"})
+	require.NoError(t, err)
+	err = s.UpdateNamespace("ns2", labels.Labels{"tenant": "b"})
+	require.NoError(t, err)
+
+	tenantA := &slimv1.LabelSelector{MatchLabels: map[string]slimv1.MatchLabelsValue{"tenant": "a"}}
+	err = s.AddPolicy(PolicyID(1), "", nil, nil, tenantA, nil)
+	require.NoError(t, err)
+	deployments := &slimv1.LabelSelector{MatchLabels: map[string]slimv1.MatchLabelsValue{"kind": "Deployment"}}
+	err = s.AddPolicy(PolicyID(2), "", nil, nil, tenantA, deployments)
+	require.NoError(t, err)
+
+	pod1 := PodID(uuid.New())
+	err = s.AddPodContainer(pod1, "ns1", "web", "Deployment", nil, "cont1", CgroupID(1001), podhelpers.ContainerInfo{Name: "main1"})
+	require.NoError(t, err)
+	pod2 := PodID(uuid.New())
+	err = s.AddPodContainer(pod2, "ns1", "agent", "DaemonSet", nil, "cont2", CgroupID(1002), podhelpers.ContainerInfo{Name: "main2"})
+	require.NoError(t, err)
+	pod3 := PodID(uuid.New())
+	err = s.AddPodContainer(pod3, "ns2", "db", "Deployment", nil, "cont3", CgroupID(2001), podhelpers.ContainerInfo{Name: "main3"})
+	require.NoError(t, err)
+	// namespace labels are not known yet
+	pod4 := PodID(uuid.New())
+	err = s.AddPodContainer(pod4, "ns3", "log", "Deployment", nil, "cont4", CgroupID(3001), podhelpers.ContainerInfo{Name: "main4"})
+	require.NoError(t, err)
+
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{
+		1: {1001, 1002},
+		2: {1001},
+	})
+
+	// namespace labels change
+	err = s.UpdateNamespace("ns2", labels.Labels{"tenant": "a"})
+	require.NoError(t, err)
+	err = s.UpdateNamespace("ns3", labels.Labels{"tenant": "a"})
+	require.NoError(t, err)
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{
+		1: {1001, 1002, 2001, 3001},
+		2: {1001, 2001, 3001},
+	})
+
+	err = s.UpdateNamespace("ns1", nil)
+	require.NoError(t, err)
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{
+		1: {2001, 3001},
+		2: {2001, 3001},
+	})
+
+	err = s.DelNamespace("ns1")
+	require.NoError(t, err)
+	require.NotContains(t, s.namespaces, "ns1")
+
+	err = s.DelPolicy(PolicyID(1))
+	require.NoError(t, err)
+	err = s.DelPolicy(PolicyID(2))
+	require.NoError(t, err)
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{})
+}
+
+func TestPolicyNamespaceWorkloadMatches(t *testing.T) {
+	nsSelector, err := labels.SelectorFromLabelSelector(&slimv1.LabelSelector{
+		MatchLabels: map[string]slimv1.MatchLabelsValue{"tenant": "a"},
+	})
+	require.NoError(t, err)
+	wlSelector, err := labels.SelectorFromLabelSelector(&slimv1.LabelSelector{
+		MatchExpressions: []slimv1.LabelSelectorRequirement{{
+			Key:      "kind",
+			Operator: slimv1.LabelSelectorOpIn,
+			Values:   []string{"Deployment", "StatefulSet"},
+		}},
+	})
+	require.NoError(t, err)
+	everything, err := labels.SelectorFromLabelSelector(nil)
+	require.NoError(t, err)
+
+	pol := policy{
+		podSelector:       everything,
+		containerSelector: everything,
+		namespaceSelector: nsSelector,
+		workloadSelector:  wlSelector,
+	}
+	pod := &podInfo{namespace: "ns1", workload: "web", kind: "Deployment"}
+	require.True(t, pol.podInfoMatches(pod, labels.Labels{"tenant": "a"}))
+	require.False(t, pol.podInfoMatches(pod, labels.Labels{"tenant": "b"}))
+	require.False(t, pol.podInfoMatches(pod, nil))
+	pod.kind = "DaemonSet"
+	require.False(t, pol.podInfoMatches(pod, labels.Labels{"tenant": "a"}))
+}
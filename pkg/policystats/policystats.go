@@ -34,6 +34,12 @@ const (
 
 type PolicyStats struct {
 	ActionsCount [PolicyActionsNr]uint64
+	// OmittedBytes is the number of bytes of the arguments omitted from
+	// the events of the policy (see omitArgs).
+	OmittedBytes uint64
+	// BudgetDropped is the number of events not posted because the policy
+	// exceeded its event budget.
+	BudgetDropped uint64
 }
 
 func StatsFromBPFMap(fname string) (*PolicyStats, error) {
@@ -52,6 +58,12 @@ func StatsFromBPFMap(fname string) (*PolicyStats, error) {
 }
 
 func GetPolicyStats(tp tracingpolicy.TracingPolicy) (*PolicyStats, error) {
-	fname := filepath.Join(bpf.MapPrefixPath(), tracingpolicy.PolicyDir(tracingpolicy.Namespace(tp), tp.TpName()), PolicyStatsMapName)
-	return StatsFromBPFMap(fname)
+	return GetPolicyGenerationStats(tp, 0)
+}
+
+// GetPolicyGenerationStats returns the stats of the policy loaded for the
+// given generation, see tracingpolicy.PolicyGenerationDir.
+func GetPolicyGenerationStats(tp tracingpolicy.TracingPolicy, generation uint64) (*PolicyStats, error) {
+	dir := tracingpolicy.PolicyGenerationDir(tracingpolicy.Namespace(tp), tp.TpName(), generation)
+	return StatsFromBPFMap(filepath.Join(bpf.MapPrefixPath(), dir, PolicyStatsMapName))
 }
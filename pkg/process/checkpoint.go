@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// CheckpointVersion is the version of the checkpoint format. Checkpoints of
+// other versions are ignored.
+const CheckpointVersion = 1
+
+var ErrCheckpointStale = errors.New("process cache checkpoint is stale")
+
+// CheckpointProcess identifies a running process, as tracked by the execve
+// map, so that it can be matched against procfs after a restart.
+type CheckpointProcess struct {
+	Pid   uint32 `json:"pid"`
+	Ktime uint64 `json:"ktime"`
+	// ProcKtime is the start time of the process as read from procfs, which
+	// does not change during the lifetime of the process.
+	ProcKtime   uint64 `json:"proc_ktime"`
+	Exe         string `json:"exe,omitempty"`
+	ParentPid   uint32 `json:"parent_pid"`
+	ParentKtime uint64 `json:"parent_ktime"`
+}
+
+// Checkpoint is a checkpoint of the process cache. It is written on shutdown
+// and periodically, and restored on startup so that the processes that
+// started before keep their exec IDs, start times and parents.
+type Checkpoint struct {
+	Version int `json:"version"`
+	// BootID is the boot ID of the host, checkpoints of previous boots are
+	// stale.
+	BootID    string              `json:"boot_id"`
+	Time      time.Time           `json:"time"`
+	Processes []CheckpointProcess `json:"processes"`
+	// Exited are the processes that exited but are parents of running
+	// processes.
+	Exited []*tetragon.Process `json:"exited,omitempty"`
+}
+
+// WriteCheckpoint writes the checkpoint to a file. The file is replaced
+// atomically so that a crash does not leave a partial checkpoint.
+func WriteCheckpoint(fname string, c *Checkpoint) error {
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(fname), filepath.Base(fname)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create checkpoint file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := json.NewEncoder(f).Encode(c); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return os.Rename(f.Name(), fname)
+}
+
+// ReadCheckpoint reads a checkpoint from a file. It returns
+// ErrCheckpointStale if the checkpoint is of another version or of another
+// boot.
+func ReadCheckpoint(fname string, bootID string) (*Checkpoint, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var c Checkpoint
+	if err := json.NewDecoder(f).Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint %s: %w", fname, err)
+	}
+	if c.Version != CheckpointVersion {
+		return nil, fmt.Errorf("%w: version %d, expected %d", ErrCheckpointStale, c.Version, CheckpointVersion)
+	}
+	if c.BootID != bootID {
+		return nil, fmt.Errorf("%w: boot ID %s, expected %s", ErrCheckpointStale, c.BootID, bootID)
+	}
+	return &c, nil
+}
+
+// GetCheckpointProcess returns a copy of a process of the cache, including
+// its capabilities, credentials and namespaces, to be written in a
+// checkpoint.
+func GetCheckpointProcess(execID string) (*tetragon.Process, bool) {
+	if procCache == nil {
+		return nil, false
+	}
+	pi, ok := procCache.cache.Peek(execID)
+	if !ok {
+		return nil, false
+	}
+	proc := pi.GetProcessCopy()
+	proc.Refcnt = 0
+	proc.Cap = pi.capabilities
+	proc.ProcessCredentials = pi.apiCreds
+	proc.Ns = pi.namespaces
+	return proc, true
+}
+
+// RestoreExited adds exited processes of a checkpoint to the cache. They are
+// kept while the restored processes that reference them as parent run.
+func RestoreExited(procs []*tetragon.Process) error {
+	if procCache == nil {
+		return ErrCacheNotInitialized
+	}
+	for _, p := range procs {
+		if _, ok := procCache.cache.Peek(p.ExecId); ok {
+			continue
+		}
+		proc := proto.Clone(p).(*tetragon.Process)
+		proc.Refcnt = 0
+		procCache.add(&ProcessInternal{
+			process:      proc,
+			capabilities: proc.Cap,
+			apiCreds:     proc.ProcessCredentials,
+			namespaces:   proc.Ns,
+			refcntOps:    map[string]int32{"process++": 1, "process--": 1},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/watcher"
+)
+
+func TestCheckpointFile(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "checkpoint", "processes.json")
+	c := &Checkpoint{
+		Version: CheckpointVersion,
+		BootID:  "boot1",
+		Time:    time.Now().UTC().Truncate(time.Second),
+		Processes: []CheckpointProcess{
+			{Pid: 10, Ktime: 1000, ProcKtime: 990, Exe: "/bin/sh", ParentPid: 1, ParentKtime: 10},
+		},
+		Exited: []*tetragon.Process{
+			{ExecId: "exited", Pid: &wrapperspb.UInt32Value{Value: 5}, Binary: "/bin/bash"},
+		},
+	}
+	require.NoError(t, WriteCheckpoint(fname, c))
+
+	res, err := ReadCheckpoint(fname, "boot1")
+	require.NoError(t, err)
+	assert.Equal(t, c.Processes, res.Processes)
+	assert.True(t, c.Time.Equal(res.Time))
+	require.Len(t, res.Exited, 1)
+	assert.True(t, proto.Equal(c.Exited[0], res.Exited[0]))
+
+	_, err = ReadCheckpoint(fname, "boot2")
+	require.ErrorIs(t, err, ErrCheckpointStale)
+
+	c.Version = CheckpointVersion + 1
+	require.NoError(t, WriteCheckpoint(fname, c))
+	_, err = ReadCheckpoint(fname, "boot1")
+	require.ErrorIs(t, err, ErrCheckpointStale)
+
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(fname), "*"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{fname}, matches, "temporary files are removed")
+}
+
+func TestRestoreExited(t *testing.T) {
+	require.NoError(t, InitCache(watcher.NewFakeK8sWatcher(nil), 10, defaults.DefaultProcessCacheGCInterval))
+	t.Cleanup(FreeCache)
+
+	exited := &tetragon.Process{
+		ExecId: "exited",
+		Pid:    &wrapperspb.UInt32Value{Value: 5},
+		Binary: "/bin/bash",
+		Cap:    &tetragon.Capabilities{Permitted: []tetragon.CapabilitiesType{tetragon.CapabilitiesType_CAP_CHOWN}},
+		Refcnt: 3,
+	}
+	require.NoError(t, RestoreExited([]*tetragon.Process{exited}))
+
+	proc, err := Get("exited")
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/bash", proc.UnsafeGetProcess().Binary)
+	assert.Zero(t, proc.RefGet())
+	assert.True(t, proc.exited())
+
+	restored, ok := GetCheckpointProcess("exited")
+	require.True(t, ok)
+	assert.True(t, proto.Equal(exited.Cap, restored.Cap))
+	assert.Zero(t, restored.Refcnt)
+
+	// restoring a process already in the cache keeps the cached one
+	require.NoError(t, RestoreExited([]*tetragon.Process{{ExecId: "exited", Binary: "/bin/sh"}}))
+	proc, err = Get("exited")
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/bash", proc.UnsafeGetProcess().Binary)
+}
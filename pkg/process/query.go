@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+var (
+	ErrCacheNotInitialized = errors.New("process cache not initialized")
+	ErrProcessNotFound     = errors.New("process not found in the process cache")
+)
+
+// exited returns true if the process exited. Exited processes are kept in
+// the cache while they are referenced.
+func (pi *ProcessInternal) exited() bool {
+	pi.refcntOpsLock.Lock()
+	defer pi.refcntOpsLock.Unlock()
+	return pi.refcntOps["process--"] > 0
+}
+
+// entry returns a copy of the process, without its internal fields.
+func (pi *ProcessInternal) entry() *tetragon.ProcessEntry {
+	proc := pi.GetProcessCopy()
+	proc.Refcnt = 0
+	return &tetragon.ProcessEntry{
+		Process: proc,
+		Exited:  pi.exited(),
+	}
+}
+
+type processFilter struct {
+	podNamespace string
+	podName      string
+	containerID  string
+	binary       *regexp.Regexp
+	execIDs      map[string]struct{}
+	exited       bool
+}
+
+func newProcessFilter(req *tetragon.ListProcessesRequest) (*processFilter, error) {
+	f := &processFilter{
+		podNamespace: req.GetPodNamespace(),
+		podName:      req.GetPodName(),
+		containerID:  req.GetContainerId(),
+		exited:       req.GetIncludeExited(),
+	}
+	if re := req.GetBinaryRegex(); re != "" {
+		var err error
+		if f.binary, err = regexp.Compile(re); err != nil {
+			return nil, fmt.Errorf("invalid binary regex: %w", err)
+		}
+	}
+	if ids := req.GetExecIds(); len(ids) > 0 {
+		f.execIDs = make(map[string]struct{}, len(ids))
+		for _, id := range ids {
+			f.execIDs[id] = struct{}{}
+		}
+	}
+	return f, nil
+}
+
+func (f *processFilter) match(e *tetragon.ProcessEntry) bool {
+	proc := e.Process
+	if e.Exited && !f.exited {
+		return false
+	}
+	if f.execIDs != nil {
+		if _, ok := f.execIDs[proc.ExecId]; !ok {
+			return false
+		}
+	}
+	if f.podNamespace != "" && proc.GetPod().GetNamespace() != f.podNamespace {
+		return false
+	}
+	if f.podName != "" && proc.GetPod().GetName() != f.podName {
+		return false
+	}
+	if f.containerID != "" && (proc.GetPod().GetContainer().GetId() == "" ||
+		!strings.HasPrefix(proc.GetPod().GetContainer().GetId(), f.containerID)) {
+		return false
+	}
+	if f.binary != nil && !f.binary.MatchString(proc.Binary) {
+		return false
+	}
+	return true
+}
+
+// sortEntries sorts processes by start time, and then by exec ID.
+func sortEntries(entries []*tetragon.ProcessEntry) {
+	slices.SortFunc(entries, func(a, b *tetragon.ProcessEntry) int {
+		if c := a.Process.GetStartTime().AsTime().Compare(b.Process.GetStartTime().AsTime()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Process.ExecId, b.Process.ExecId)
+	})
+}
+
+func (pc *Cache) listProcesses(req *tetragon.ListProcessesRequest) ([]*tetragon.ProcessEntry, error) {
+	filter, err := newProcessFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*tetragon.ProcessEntry
+	for _, v := range pc.cache.Values() {
+		if e := v.entry(); filter.match(e) {
+			ret = append(ret, e)
+		}
+	}
+	sortEntries(ret)
+	return ret, nil
+}
+
+func (pc *Cache) getProcessTree(req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	proc, ok := pc.cache.Peek(req.GetExecId())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProcessNotFound, req.GetExecId())
+	}
+	ret := &tetragon.GetProcessTreeResponse{Process: proc.entry()}
+
+	// ancestors, stopping on loops in case of inconsistent parent IDs
+	seen := map[string]struct{}{proc.process.ExecId: {}}
+	for p := proc; ; {
+		parentID := p.process.ParentExecId
+		if _, ok := seen[parentID]; ok {
+			break
+		}
+		if p, ok = pc.cache.Peek(parentID); !ok {
+			break
+		}
+		seen[parentID] = struct{}{}
+		ret.Ancestors = append(ret.Ancestors, p.entry())
+	}
+
+	children := map[string][]*tetragon.ProcessEntry{}
+	for _, v := range pc.cache.Values() {
+		e := v.entry()
+		if e.Exited && !req.GetIncludeExited() {
+			continue
+		}
+		parentID := e.Process.ParentExecId
+		children[parentID] = append(children[parentID], e)
+	}
+	// depth-first, so that each descendant follows its parent
+	var walk func(execID string)
+	walk = func(execID string) {
+		entries := children[execID]
+		sortEntries(entries)
+		for _, e := range entries {
+			if _, ok := seen[e.Process.ExecId]; ok {
+				continue
+			}
+			seen[e.Process.ExecId] = struct{}{}
+			ret.Descendants = append(ret.Descendants, e)
+			walk(e.Process.ExecId)
+		}
+	}
+	walk(proc.process.ExecId)
+	return ret, nil
+}
+
+// ListProcesses returns the processes of the cache matching the request,
+// sorted by start time.
+func ListProcesses(req *tetragon.ListProcessesRequest) ([]*tetragon.ProcessEntry, error) {
+	if procCache == nil {
+		return nil, ErrCacheNotInitialized
+	}
+	return procCache.listProcesses(req)
+}
+
+// GetProcessTree returns the ancestors and descendants of a process of the
+// cache. It returns ErrProcessNotFound if the process is not in the cache.
+func GetProcessTree(req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	if procCache == nil {
+		return nil, ErrCacheNotInitialized
+	}
+	return procCache.getProcessTree(req)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/defaults"
+)
+
+func newQueryTestCache(t *testing.T) *Cache {
+	cache, err := NewCache(10, defaults.DefaultProcessCacheGCInterval)
+	require.NoError(t, err)
+
+	// processes start in the order of their PIDs
+	start := time.Now()
+	add := func(pid uint32, execID, parentID, binary string, pod *tetragon.Pod, exited bool) {
+		proc := &ProcessInternal{
+			process: &tetragon.Process{
+				ExecId:       execID,
+				ParentExecId: parentID,
+				Pid:          &wrapperspb.UInt32Value{Value: pid},
+				Binary:       binary,
+				StartTime:    timestamppb.New(start.Add(time.Duration(pid) * time.Second)),
+				Pod:          pod,
+			},
+		}
+		if exited {
+			proc.refcntOps = map[string]int32{"process--": 1}
+		}
+		cache.add(proc)
+	}
+	pod := &tetragon.Pod{
+		Namespace: "default",
+		Name:      "web",
+		Container: &tetragon.Container{Id: "abcdef", Name: "nginx"},
+	}
+	add(1, "init", "", "/sbin/init", nil, false)
+	add(10, "containerd", "init", "/usr/bin/containerd", nil, false)
+	add(20, "nginx", "containerd", "/usr/sbin/nginx", pod, false)
+	add(21, "worker", "nginx", "/usr/sbin/nginx", pod, false)
+	add(22, "sh", "nginx", "/bin/sh", pod, true)
+	add(23, "ls", "sh", "/bin/ls", pod, false)
+	return cache
+}
+
+func execIDs(entries []*tetragon.ProcessEntry) []string {
+	var ret []string
+	for _, e := range entries {
+		ret = append(ret, e.Process.ExecId)
+	}
+	return ret
+}
+
+func TestListProcesses(t *testing.T) {
+	cache := newQueryTestCache(t)
+
+	tests := []struct {
+		name string
+		req  *tetragon.ListProcessesRequest
+		want []string
+	}{
+		{"all", &tetragon.ListProcessesRequest{}, []string{"init", "containerd", "nginx", "worker", "ls"}},
+		{"exited", &tetragon.ListProcessesRequest{IncludeExited: true}, []string{"init", "containerd", "nginx", "worker", "sh", "ls"}},
+		{"pod", &tetragon.ListProcessesRequest{PodNamespace: "default", PodName: "web"}, []string{"nginx", "worker", "ls"}},
+		{"other namespace", &tetragon.ListProcessesRequest{PodNamespace: "kube-system"}, nil},
+		{"container", &tetragon.ListProcessesRequest{ContainerId: "abc"}, []string{"nginx", "worker", "ls"}},
+		{"binary", &tetragon.ListProcessesRequest{BinaryRegex: "nginx$"}, []string{"nginx", "worker"}},
+		{"exec ids", &tetragon.ListProcessesRequest{ExecIds: []string{"ls", "init", "unknown"}}, []string{"init", "ls"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := cache.listProcesses(tc.req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, execIDs(res))
+		})
+	}
+
+	_, err := cache.listProcesses(&tetragon.ListProcessesRequest{BinaryRegex: "("})
+	require.ErrorContains(t, err, "invalid binary regex")
+}
+
+func TestGetProcessTree(t *testing.T) {
+	cache := newQueryTestCache(t)
+
+	res, err := cache.getProcessTree(&tetragon.GetProcessTreeRequest{ExecId: "nginx"})
+	require.NoError(t, err)
+	assert.Equal(t, "nginx", res.Process.Process.ExecId)
+	assert.Equal(t, []string{"containerd", "init"}, execIDs(res.Ancestors))
+	// the descendants of exited processes are skipped with them
+	assert.Equal(t, []string{"worker"}, execIDs(res.Descendants))
+
+	res, err = cache.getProcessTree(&tetragon.GetProcessTreeRequest{ExecId: "init", IncludeExited: true})
+	require.NoError(t, err)
+	assert.Empty(t, res.Ancestors)
+	assert.Equal(t, []string{"containerd", "nginx", "worker", "sh", "ls"}, execIDs(res.Descendants))
+	assert.True(t, res.Descendants[3].Exited)
+	assert.Zero(t, res.Descendants[3].Process.Refcnt)
+
+	_, err = cache.getProcessTree(&tetragon.GetProcessTreeRequest{ExecId: "unknown"})
+	require.ErrorIs(t, err, ErrProcessNotFound)
+}
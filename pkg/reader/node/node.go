@@ -4,7 +4,10 @@
 package node
 
 import (
+	"maps"
 	"os"
+	"sync"
+	"sync/atomic"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -20,12 +23,17 @@ const (
 var (
 	nodeName       string
 	exportNodeName string
-	nodeLabels     = map[string]string{}
+	// nodeLabels is read for every event, so it is replaced atomically
+	// rather than guarded by a lock. The maps it points to are never
+	// modified.
+	nodeLabels   atomic.Pointer[map[string]string]
+	nodeLabelsMu sync.Mutex
 )
 
 func init() {
 	SetExportNodeName()
 	SetNodeName()
+	nodeLabels.Store(&map[string]string{})
 }
 
 // SetExportNodeName initializes the exportNodeName variable. It's defined separately from
@@ -60,8 +68,26 @@ func SetNodeName() {
 	}
 }
 
-func SetNodeLabels(labels map[string]string) {
-	nodeLabels = labels
+// SetNodeLabels sets the labels of the Kubernetes node. It returns false if they did not
+// change.
+func SetNodeLabels(labels map[string]string) bool {
+	nodeLabelsMu.Lock()
+	defer nodeLabelsMu.Unlock()
+	if maps.Equal(GetNodeLabels(), labels) {
+		return false
+	}
+	l := maps.Clone(labels)
+	if l == nil {
+		l = map[string]string{}
+	}
+	nodeLabels.Store(&l)
+	return true
+}
+
+// GetNodeLabels returns the labels of the Kubernetes node, as set by SetNodeLabels. They are
+// empty when Tetragon does not run in Kubernetes. The returned map must not be modified.
+func GetNodeLabels() map[string]string {
+	return *nodeLabels.Load()
 }
 
 // GetNodeNameForExport returns node name string for JSON export. It uses the HUBBLE_NODE_NAME
@@ -86,5 +112,5 @@ func GetNodeName() string {
 func SetCommonFields(ev *tetragon.GetEventsResponse) {
 	ev.NodeName = exportNodeName
 	ev.ClusterName = option.Config.ClusterName
-	ev.NodeLabels = nodeLabels
+	ev.NodeLabels = GetNodeLabels()
 }
@@ -51,3 +51,22 @@ func TestGetKubernetesNodeName(t *testing.T) {
 	require.NoError(t, os.Unsetenv("NODE_NAME"))
 	require.NoError(t, os.Unsetenv("HUBBLE_NODE_NAME"))
 }
+
+func TestSetNodeLabels(t *testing.T) {
+	t.Cleanup(func() { SetNodeLabels(nil) })
+
+	labels := map[string]string{"pool": "gpu"}
+	assert.True(t, SetNodeLabels(labels))
+	assert.False(t, SetNodeLabels(map[string]string{"pool": "gpu"}))
+	// the labels are copied
+	labels["pool"] = "cpu"
+	assert.Equal(t, map[string]string{"pool": "gpu"}, GetNodeLabels())
+
+	ev := tetragon.GetEventsResponse{}
+	SetCommonFields(&ev)
+	assert.Equal(t, map[string]string{"pool": "gpu"}, ev.GetNodeLabels())
+
+	assert.True(t, SetNodeLabels(nil))
+	assert.NotNil(t, GetNodeLabels())
+	assert.Empty(t, GetNodeLabels())
+}
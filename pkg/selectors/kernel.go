@@ -26,6 +26,7 @@ import (
 	"github.com/cilium/tetragon/pkg/mbset"
 	"github.com/cilium/tetragon/pkg/reader/namespace"
 	"github.com/cilium/tetragon/pkg/reader/network"
+	"github.com/cilium/tetragon/pkg/sequences"
 )
 
 const (
@@ -45,6 +46,7 @@ const (
 	ActionTypeNotifyEnforcer              = 12
 	ActionTypeCleanupEnforcerNotification = 13
 	ActionTypeSet                         = 14
+	ActionTypeSetState                    = 15
 )
 
 var actionTypeTable = map[string]uint32{
@@ -63,6 +65,7 @@ var actionTypeTable = map[string]uint32{
 	"notifyenforcer":              ActionTypeNotifyEnforcer,
 	"cleanupenforcernotification": ActionTypeCleanupEnforcerNotification,
 	"set":                         ActionTypeSet,
+	"setstate":                    ActionTypeSetState,
 }
 
 var actionTypeStringTable = map[uint32]string{
@@ -80,6 +83,7 @@ var actionTypeStringTable = map[uint32]string{
 	ActionTypeUntrackSock:                 "untracksock",
 	ActionTypeCleanupEnforcerNotification: "cleanupenforcernotification",
 	ActionTypeSet:                         "set",
+	ActionTypeSetState:                    "setstate",
 }
 
 const (
@@ -94,6 +98,16 @@ var actionRateLimitScope = map[string]uint32{
 	"global":  ActionRateLimitScopeGlobal,
 }
 
+const (
+	ActionSampleScopeRandom = iota
+	ActionSampleScopeProcess
+)
+
+var actionSampleScope = map[string]uint32{
+	"random":  ActionSampleScopeRandom,
+	"process": ActionSampleScopeProcess,
+}
+
 // Action argument table entry (for URL and FQDN arguments)
 type ActionArgEntry struct {
 	arg     string
@@ -108,6 +122,20 @@ func (g *ActionArgEntry) GetArg() string {
 	return g.arg
 }
 
+// Action argument table entry for the sequence step of the SetState action
+type StateArgEntry struct {
+	step    *sequences.Step
+	tableId idtable.EntryID
+}
+
+func (g *StateArgEntry) SetID(id idtable.EntryID) {
+	g.tableId = id
+}
+
+func (g *StateArgEntry) GetStep() *sequences.Step {
+	return g.step
+}
+
 func MatchActionSigKill(spec any) bool {
 	var sels []v1alpha1.KProbeSelector
 	switch s := spec.(type) {
@@ -209,6 +237,11 @@ const (
 	// range
 	SelectorOpInRange    = 31
 	SelectorOpNotInRange = 32
+	// pattern ops
+	SelectorOpGlob     = 33
+	SelectorOpNotGlob  = 34
+	SelectorOpRegex    = 35
+	SelectorOpNotRegex = 36
 )
 
 var selectorOpStringTable = map[uint32]string{
@@ -243,6 +276,10 @@ var selectorOpStringTable = map[uint32]string{
 	SelectorOpCapabilitiesGained: "CapabilitiesGained",
 	SelectorOpInRange:            "InRange",
 	SelectorOpNotInRange:         "NoInRange",
+	SelectorOpGlob:               "Glob",
+	SelectorOpNotGlob:            "NotGlob",
+	SelectorOpRegex:              "Regex",
+	SelectorOpNotRegex:           "NotRegex",
 }
 
 func SelectorOp(op string) (uint32, error) {
@@ -309,6 +346,14 @@ func SelectorOp(op string) (uint32, error) {
 		return SelectorOpInRange, nil
 	case "NotInRange":
 		return SelectorOpNotInRange, nil
+	case "glob", "Glob":
+		return SelectorOpGlob, nil
+	case "notglob", "NotGlob":
+		return SelectorOpNotGlob, nil
+	case "regex", "Regex":
+		return SelectorOpRegex, nil
+	case "notregex", "NotRegex":
+		return SelectorOpNotRegex, nil
 	}
 
 	return 0, fmt.Errorf("unknown op '%s'", op)
@@ -468,7 +513,7 @@ func writeMatchRangesInMap(k *KernelSelectorState, values []string, ty uint32, o
 		}
 	}
 	// write the map id into the selector
-	WriteSelectorUint32(&k.data, mid)
+	k.writeMapID(mid)
 	return nil
 }
 
@@ -578,7 +623,7 @@ func writeMatchValuesInMap(k *KernelSelectorState, values []string, ty uint32, o
 		m.Data[val] = struct{}{}
 	}
 	// write the map id into the selector
-	WriteSelectorUint32(&k.data, mid)
+	k.writeMapID(mid)
 	return nil
 }
 
@@ -605,13 +650,13 @@ func writeMatchAddrsInMap(k *KernelSelectorState, values []string) error {
 	// write the map ids into the selector
 	if len(m4) != 0 {
 		m4id := k.insertAddr4Map(m4)
-		WriteSelectorUint32(&k.data, m4id)
+		k.writeMapID(m4id)
 	} else {
 		WriteSelectorUint32(&k.data, 0xffffffff)
 	}
 	if len(m6) != 0 {
 		m6id := k.insertAddr6Map(m6)
-		WriteSelectorUint32(&k.data, m6id)
+		k.writeMapID(m6id)
 	} else {
 		WriteSelectorUint32(&k.data, 0xffffffff)
 	}
@@ -738,7 +783,7 @@ func writeMatchStrings(k *KernelSelectorState, values []string, ty uint32) error
 	// write the map ids into the selector
 	mapDetails := k.insertStringMaps(maps)
 	for _, md := range mapDetails {
-		WriteSelectorUint32(&k.data, md)
+		k.writeMapID(md)
 	}
 	return nil
 }
@@ -768,7 +813,7 @@ func writePrefixStrings(k *KernelSelectorState, values []string) error {
 	if err != nil {
 		return err
 	}
-	WriteSelectorUint32(&k.data, mid)
+	k.writeMapID(mid)
 	return nil
 }
 
@@ -806,13 +851,30 @@ func writePostfixStrings(k *KernelSelectorState, values []string, ty uint32) err
 	if err != nil {
 		return err
 	}
-	WriteSelectorUint32(&k.data, mid)
+	k.writeMapID(mid)
+	return nil
+}
+
+// writePatternStrings compiles the glob or regex values into a DFA and writes its map id into
+// the selector.
+func writePatternStrings(k *KernelSelectorState, values []string, ty uint32, op uint32) error {
+	switch ty {
+	case gt.GenericStringType, gt.GenericCharBuffer, gt.GenericFileType, gt.GenericPathType:
+	default:
+		return fmt.Errorf("operator %s is only supported for string, char_buf, file, and path types", selectorOpStringTable[op])
+	}
+	p, err := CompileStringPatterns(values, op == SelectorOpRegex || op == SelectorOpNotRegex)
+	if err != nil {
+		return err
+	}
+	k.writeMapID(k.newStringPatternMap(p))
 	return nil
 }
 
 func checkOp(op uint32) error {
 	switch op {
-	case SelectorOpGT, SelectorOpLT, SelectorOpCapabilitiesGained:
+	case SelectorOpGT, SelectorOpLT, SelectorOpCapabilitiesGained,
+		SelectorOpGlob, SelectorOpNotGlob, SelectorOpRegex, SelectorOpNotRegex:
 		if !config.EnableLargeProgs() {
 			opName := selectorOpStringTable[op]
 			return fmt.Errorf(
@@ -934,6 +996,11 @@ func parseMatchArg(k *KernelSelectorState, arg *v1alpha1.ArgSelector, sig []v1al
 		if err != nil {
 			return fmt.Errorf("writePostfixStrings error: %w", err)
 		}
+	case SelectorOpGlob, SelectorOpNotGlob, SelectorOpRegex, SelectorOpNotRegex:
+		err := writePatternStrings(k, arg.Values, ty, op)
+		if err != nil {
+			return fmt.Errorf("writePatternStrings error: %w", err)
+		}
 	case SelectorOpSport, SelectorOpDport, SelectorOpNotSport, SelectorOpNotDport, SelectorOpProtocol, SelectorOpFamily, SelectorOpState:
 		if ty != gt.GenericSockType && ty != gt.GenericSkbType && ty != gt.GenericSockaddrType && ty != gt.GenericSocketType {
 			return errors.New("sock/socket/skb/sockaddr operators specified for non-sock/socket/skb/sockaddr type")
@@ -1033,8 +1100,13 @@ func ParseMatchArgs(k *KernelSelectorState, matchArgs []v1alpha1.ArgSelector, ma
 }
 
 // User specifies rateLimit in seconds, minutes or hours, but we store it in milliseconds.
-func parseRateLimit(str string, scopeStr string) (uint32, uint32, error) {
-	multiplier := uint32(0)
+// parseDurationSeconds parses a duration specified in seconds (default or with 's' suffix),
+// minutes ('m' suffix) or hours ('h' suffix) and returns it in seconds.
+func parseDurationSeconds(str string) (uint64, error) {
+	if str == "" {
+		return 0, errors.New("empty value")
+	}
+	multiplier := uint64(0)
 	switch str[len(str)-1] {
 	case 's', 'S':
 		multiplier = 1
@@ -1043,17 +1115,25 @@ func parseRateLimit(str string, scopeStr string) (uint32, uint32, error) {
 	case 'h', 'H':
 		multiplier = 60 * 60
 	}
-	var rateLimit uint64
+	var val uint64
 	var err error
 	if multiplier != 0 {
 		if len(str) == 1 {
-			return 0, 0, fmt.Errorf("parseRateLimit: rateLimit value %s is invalid", str)
+			return 0, errors.New("missing value")
 		}
-		rateLimit, err = strconv.ParseUint(str[:len(str)-1], 10, 32)
+		val, err = strconv.ParseUint(str[:len(str)-1], 10, 32)
 	} else {
-		rateLimit, err = strconv.ParseUint(str, 10, 32)
+		val, err = strconv.ParseUint(str, 10, 32)
 		multiplier = 1
 	}
+	if err != nil {
+		return 0, err
+	}
+	return val * multiplier, nil
+}
+
+func parseRateLimit(str string, scopeStr string) (uint32, uint32, error) {
+	rateLimit, err := parseDurationSeconds(str)
 	if err != nil {
 		return 0, 0, fmt.Errorf("parseRateLimit: rateLimit value %s is invalid", str)
 	}
@@ -1066,10 +1146,31 @@ func parseRateLimit(str string, scopeStr string) (uint32, uint32, error) {
 		}
 	}
 
-	rateLimit = min(rateLimit*uint64(multiplier)*1000, 0xffffffff)
+	rateLimit = min(rateLimit*1000, 0xffffffff)
 	return uint32(rateLimit), scope, nil
 }
 
+// parseSampleRate parses a sampling rate given as "1/N" or "N" and returns N.
+func parseSampleRate(str string, scopeStr string) (uint32, uint32, error) {
+	n, isFraction := strings.CutPrefix(strings.ReplaceAll(str, " ", ""), "1/")
+	if !isFraction && strings.Contains(n, "/") {
+		return 0, 0, fmt.Errorf("parseSampleRate: sampleRate value %s is invalid, expected 1/N", str)
+	}
+	rate, err := strconv.ParseUint(n, 10, 32)
+	if err != nil || rate == 0 {
+		return 0, 0, fmt.Errorf("parseSampleRate: sampleRate value %s is invalid", str)
+	}
+	scope := uint32(ActionSampleScopeRandom)
+	if scopeStr != "" {
+		var ok bool
+		scope, ok = actionSampleScope[scopeStr]
+		if !ok {
+			return 0, 0, fmt.Errorf("parseSampleRate: sampleScope value %s is invalid", scopeStr)
+		}
+	}
+	return uint32(rate), scope, nil
+}
+
 func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, actionArgTable *idtable.Table) error {
 	act, ok := actionTypeTable[strings.ToLower(action.Action)]
 	if !ok {
@@ -1090,6 +1191,24 @@ func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, a
 		}
 	}
 
+	sampleRate := uint32(0)
+	sampleScope := uint32(0)
+	if action.SampleRate != "" {
+		if act != ActionTypePost {
+			return fmt.Errorf("sampling can only applied to post action (was applied to '%s')", action.Action)
+		}
+		if !config.EnableLargeProgs() {
+			return errors.New("sampling needs large BPF progs (kernel>5.3)")
+		}
+		var err error
+		sampleRate, sampleScope, err = parseSampleRate(action.SampleRate, action.SampleScope)
+		if err != nil {
+			return err
+		}
+	} else if action.SampleScope != "" {
+		return errors.New("sampleScope can only be used with a sampleRate")
+	}
+
 	switch act {
 	case ActionTypeFollowFd, ActionTypeCopyFd:
 		WriteSelectorUint32(&k.data, action.ArgFd)
@@ -1126,6 +1245,8 @@ func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, a
 	case ActionTypePost:
 		WriteSelectorUint32(&k.data, rateLimit)
 		WriteSelectorUint32(&k.data, rateLimitScope)
+		WriteSelectorUint32(&k.data, sampleRate)
+		WriteSelectorUint32(&k.data, sampleScope)
 		kernelStackTrace := uint32(0)
 		if action.KernelStackTrace {
 			kernelStackTrace = 1
@@ -1159,6 +1280,23 @@ func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, a
 	case ActionTypeSet:
 		WriteSelectorUint32(&k.data, action.ArgIndex)
 		WriteSelectorUint32(&k.data, action.ArgValue)
+	case ActionTypeSetState:
+		if !config.EnableLargeProgs() {
+			return errors.New("SetState action needs large BPF progs (kernel>5.3)")
+		}
+		step, err := k.sequences.Step(action.ArgSequence, action.ArgStep)
+		if err != nil {
+			return fmt.Errorf("SetState action: %w", err)
+		}
+		actionArg := StateArgEntry{
+			step:    step,
+			tableId: idtable.UninitializedEntryID,
+		}
+		actionArgTable.AddEntry(&actionArg)
+		set, reset := step.StateMasks()
+		WriteSelectorUint32(&k.data, uint32(actionArg.tableId.ID))
+		WriteSelectorUint32(&k.data, set)
+		WriteSelectorUint32(&k.data, reset)
 	default:
 		return fmt.Errorf("ParseMatchAction: act %d (%s) is missing a handler", act, actionTypeStringTable[act])
 	}
@@ -1368,13 +1506,17 @@ func ParseMatchCapabilityChanges(k *KernelSelectorState, actions []v1alpha1.Capa
 }
 
 func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx int) error {
-	op, err := SelectorOp(b.Operator)
+	return parseMatchBinary(k, b.Operator, b.Values, b.FollowChildren, MatchBinariesKey(selIdx, MatchBinariesCurrent), 0)
+}
+
+func parseMatchBinary(k *KernelSelectorState, operator string, values []string, followChildren bool, key int, maxDepth uint32) error {
+	op, err := SelectorOp(operator)
 	if err != nil {
 		return fmt.Errorf("matchBinary error: %w", err)
 	}
 
 	// ignore matchBinaries selectors with no values
-	if len(b.Values) == 0 {
+	if len(values) == 0 {
 		return nil
 	}
 
@@ -1382,9 +1524,10 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 	sel := MatchBinariesSelectorOptions{}
 	sel.Op = op
 	sel.MBSetID = mbset.InvalidID
-	if b.FollowChildren {
+	sel.MaxDepth = maxDepth
+	if followChildren {
 		if op != SelectorOpIn && op != SelectorOpNotIn {
-			return fmt.Errorf("matchBinary: followChildren not yet implemented for operation '%s'", b.Operator)
+			return fmt.Errorf("matchBinary: followChildren not yet implemented for operation '%s'", operator)
 		}
 
 		sel.MBSetID, err = mbset.AllocID()
@@ -1395,17 +1538,17 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 
 	switch op {
 	case SelectorOpIn, SelectorOpNotIn:
-		for _, s := range b.Values {
+		for _, s := range values {
 			if len(s) > processapi.BINARY_PATH_MAX_LEN-1 {
 				return fmt.Errorf("matchBinary error: Binary names > %d chars do not supported", processapi.BINARY_PATH_MAX_LEN-1)
 			}
-			k.WriteMatchBinariesPath(selIdx, s)
+			k.WriteMatchBinariesPath(key, s)
 		}
 	case SelectorOpPrefix, SelectorOpNotPrefix:
 		if !config.EnableLargeProgs() {
 			return errors.New("matchBinary error: \"Prefix\" and \"NotPrefix\" operators need large BPF progs (kernel>5.3)")
 		}
-		sel.MapID, err = writePrefixBinaries(k, b.Values)
+		sel.MapID, err = writePrefixBinaries(k, values)
 		if err != nil {
 			return fmt.Errorf("failed to write the prefix operator for the matchBinaries selector: %w", err)
 		}
@@ -1413,7 +1556,7 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 		if !config.EnableLargeProgs() {
 			return errors.New("matchBinary error: \"Postfix\" and \"NotPostfix\" operators need large BPF progs (kernel>5.3)")
 		}
-		sel.MapID, err = writePostfixBinaries(k, b.Values)
+		sel.MapID, err = writePostfixBinaries(k, values)
 		if err != nil {
 			return fmt.Errorf("failed to write the prefix operator for the matchBinaries selector: %w", err)
 		}
@@ -1421,7 +1564,7 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 		return errors.New("matchBinary error: Only \"In\", \"NotIn\", \"Prefix\", \"NotPrefix\", \"Postfix\" and \"NotPostfix\" operators are supported")
 	}
 
-	k.AddMatchBinaries(selIdx, sel)
+	k.AddMatchBinaries(key, sel)
 
 	return nil
 }
@@ -1438,6 +1581,111 @@ func ParseMatchBinaries(k *KernelSelectorState, binarys []v1alpha1.BinarySelecto
 	return nil
 }
 
+func ParseMatchParentBinaries(k *KernelSelectorState, binarys []v1alpha1.BinarySelector, selIdx int) error {
+	if len(binarys) == 0 {
+		return nil
+	}
+	if len(binarys) > 1 {
+		return errors.New("only support a single matchParentBinaries per selector")
+	}
+	if !config.EnableLargeProgs() {
+		return errors.New("matchParentBinaries need large BPF progs (kernel>5.3)")
+	}
+	b := &binarys[0]
+	if b.FollowChildren {
+		return errors.New("matchParentBinaries does not support followChildren")
+	}
+	return parseMatchBinary(k, b.Operator, b.Values, false, MatchBinariesKey(selIdx, MatchBinariesParent), 1)
+}
+
+func ParseMatchAncestorBinaries(k *KernelSelectorState, binarys []v1alpha1.AncestorBinarySelector, selIdx int) error {
+	if len(binarys) == 0 {
+		return nil
+	}
+	if len(binarys) > 1 {
+		return errors.New("only support a single matchAncestorBinaries per selector")
+	}
+	if !config.EnableLargeProgs() {
+		return errors.New("matchAncestorBinaries need large BPF progs (kernel>5.3)")
+	}
+	b := &binarys[0]
+	maxDepth := b.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = MatchAncestorsMaxDepth
+	}
+	if maxDepth > MatchAncestorsMaxDepth {
+		return fmt.Errorf("matchAncestorBinaries maxDepth %d is larger than the maximum (%d)", maxDepth, MatchAncestorsMaxDepth)
+	}
+	return parseMatchBinary(k, b.Operator, b.Values, false, MatchBinariesKey(selIdx, MatchBinariesAncestors), maxDepth)
+}
+
+var thresholdScope = map[string]uint32{
+	"":        ThresholdScopeProcess,
+	"process": ThresholdScopeProcess,
+	"cgroup":  ThresholdScopeCgroup,
+	"global":  ThresholdScopeGlobal,
+}
+
+func ParseMatchThreshold(k *KernelSelectorState, threshold *v1alpha1.ThresholdSelector, selIdx int) error {
+	if threshold == nil {
+		return nil
+	}
+	if !config.EnableLargeProgs() {
+		return errors.New("matchThreshold needs large BPF progs (kernel>5.3)")
+	}
+	if threshold.Count == 0 {
+		return errors.New("matchThreshold count must be at least 1")
+	}
+	window, err := parseDurationSeconds(threshold.Window)
+	if err != nil || window == 0 {
+		return fmt.Errorf("matchThreshold window %q is invalid", threshold.Window)
+	}
+	scope, ok := thresholdScope[threshold.Scope]
+	if !ok {
+		return fmt.Errorf("matchThreshold scope %q is invalid", threshold.Scope)
+	}
+	k.thresholds[selIdx] = ThresholdSelectorOptions{
+		Window: window * 1000,
+		Count:  threshold.Count,
+		Scope:  scope,
+	}
+	return nil
+}
+
+func ParseMatchState(k *KernelSelectorState, states []v1alpha1.StateSelector, selIdx int) error {
+	if len(states) == 0 {
+		return nil
+	}
+	if !config.EnableLargeProgs() {
+		return errors.New("matchState needs large BPF progs (kernel>5.3)")
+	}
+	var opts StateSelectorOptions
+	for i := range states {
+		state := &states[i]
+		mask, err := k.sequences.StepsMask(state.Sequence, state.Values)
+		if err != nil {
+			return err
+		}
+		op, err := SelectorOp(state.Operator)
+		if err != nil {
+			return fmt.Errorf("matchState error: %w", err)
+		}
+		switch op {
+		case SelectorOpIn:
+			opts.Reached |= mask
+		case SelectorOpNotIn:
+			opts.NotReached |= mask
+		default:
+			return errors.New("matchState supports only In and NotIn operators")
+		}
+	}
+	if opts.Reached&opts.NotReached != 0 {
+		return errors.New("matchState steps cannot be both In and NotIn")
+	}
+	k.states[selIdx] = opts
+	return nil
+}
+
 type KernelSelectorArgs struct {
 	Selectors      []v1alpha1.KProbeSelector
 	Args           []v1alpha1.KProbeArg
@@ -1446,6 +1694,7 @@ type KernelSelectorArgs struct {
 	ListReader     ValueReader
 	Maps           *KernelSelectorMaps
 	IsUprobe       bool
+	Sequences      *sequences.Policy
 }
 
 // The byte array storing the selector configuration has the following format
@@ -1505,8 +1754,9 @@ func InitKernelReturnSelectors(selectors []v1alpha1.KProbeSelector, returnArg *v
 }
 
 func createKernelSelectorState(selectors []v1alpha1.KProbeSelector, listReader ValueReader, maps *KernelSelectorMaps, isUprobe bool,
-	parseSelector func(k *KernelSelectorState, selectors *v1alpha1.KProbeSelector, selIdx int) error) (*KernelSelectorState, error) {
+	seqs *sequences.Policy, parseSelector func(k *KernelSelectorState, selectors *v1alpha1.KProbeSelector, selIdx int) error) (*KernelSelectorState, error) {
 	state := NewKernelSelectorState(listReader, maps, isUprobe)
+	state.sequences = seqs
 
 	WriteSelectorUint32(&state.data, uint32(len(selectors)))
 	soff := make([]uint32, len(selectors))
@@ -1545,6 +1795,18 @@ func InitKernelSelectorState(args *KernelSelectorArgs) (*KernelSelectorState, er
 		if err := ParseMatchBinaries(k, selector.MatchBinaries, selIdx); err != nil {
 			return fmt.Errorf("parseMatchBinaries error: %w", err)
 		}
+		if err := ParseMatchParentBinaries(k, selector.MatchParentBinaries, selIdx); err != nil {
+			return fmt.Errorf("parseMatchParentBinaries error: %w", err)
+		}
+		if err := ParseMatchAncestorBinaries(k, selector.MatchAncestorBinaries, selIdx); err != nil {
+			return fmt.Errorf("parseMatchAncestorBinaries error: %w", err)
+		}
+		if err := ParseMatchState(k, selector.MatchState, selIdx); err != nil {
+			return fmt.Errorf("parseMatchState error: %w", err)
+		}
+		if err := ParseMatchThreshold(k, selector.MatchThreshold, selIdx); err != nil {
+			return fmt.Errorf("parseMatchThreshold error: %w", err)
+		}
 		if err := ParseMatchArgs(k, selector.MatchArgs, selector.MatchData, args.Args, args.Data); err != nil {
 			return fmt.Errorf("parseMatchArgs  error: %w", err)
 		}
@@ -1554,7 +1816,7 @@ func InitKernelSelectorState(args *KernelSelectorArgs) (*KernelSelectorState, er
 		return nil
 	}
 
-	return createKernelSelectorState(args.Selectors, args.ListReader, args.Maps, args.IsUprobe, parse)
+	return createKernelSelectorState(args.Selectors, args.ListReader, args.Maps, args.IsUprobe, args.Sequences, parse)
 }
 
 func InitKernelReturnSelectorState(selectors []v1alpha1.KProbeSelector, returnArg *v1alpha1.KProbeArg,
@@ -1570,7 +1832,7 @@ func InitKernelReturnSelectorState(selectors []v1alpha1.KProbeSelector, returnAr
 		return nil
 	}
 
-	return createKernelSelectorState(selectors, listReader, maps, false, parse)
+	return createKernelSelectorState(selectors, listReader, maps, false, nil, parse)
 }
 
 func CleanupKernelSelectorState(state *KernelSelectorState) error {
@@ -1597,6 +1859,16 @@ func HasOverride(selectors []v1alpha1.KProbeSelector) bool {
 	return false
 }
 
+// HasThreshold returns true if any of the selectors has a matchThreshold
+func HasThreshold(selectors []v1alpha1.KProbeSelector) bool {
+	for i := range selectors {
+		if selectors[i].MatchThreshold != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func HasSetArgIndex(spec *v1alpha1.UsdtSpec) (bool, uint32) {
 	for _, s := range spec.Selectors {
 		for _, action := range s.MatchActions {
@@ -24,6 +24,7 @@ import (
 	"github.com/cilium/tetragon/pkg/config"
 	gt "github.com/cilium/tetragon/pkg/generictypes"
 	"github.com/cilium/tetragon/pkg/idtable"
+	"github.com/cilium/tetragon/pkg/sequences"
 )
 
 func TestWriteSelectorUint32(t *testing.T) {
@@ -827,6 +828,8 @@ func TestParseMatchAction(t *testing.T) {
 		0x00, 0x00, 0x00, 0x00, // Action = "post"
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleRate = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -841,11 +844,13 @@ func TestParseMatchAction(t *testing.T) {
 		0x00, 0x00, 0x00, 0x00, // Action = "post"
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleRate = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
 	}
-	length := []byte{52, 0x00, 0x00, 0x00}
+	length := []byte{68, 0x00, 0x00, 0x00}
 	expected := append(length, expected1[:]...)
 	expected = append(expected, expected2[:]...)
 
@@ -948,11 +953,11 @@ func TestInitKernelSelectors(t *testing.T) {
 	}
 
 	expectedSelsizeSmall := []byte{
-		0x18, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + capabilities  + 4
+		0x20, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + capabilities  + 4
 	}
 
 	expectedSelsizeLarge := []byte{
-		0x4c, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + namespacesChanges + capabilities + capabilityChanges + 4
+		0x54, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + namespacesChanges + capabilities + capabilityChanges + 4
 	}
 
 	expectedFilters := []byte{
@@ -1070,10 +1075,12 @@ func TestInitKernelSelectors(t *testing.T) {
 		0x02, 0x00, 0x00, 0x00, // value 2
 
 		// actions header
-		40, 0x00, 0x00, 0x00, // size = (6 * sizeof(uint32) * number of actions) + args
+		48, 0x00, 0x00, 0x00, // size = (8 * sizeof(uint32) * number of actions) + args
 		0x00, 0x00, 0x00, 0x00, // post to userspace
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleRate = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -1109,10 +1116,12 @@ func TestInitKernelSelectors(t *testing.T) {
 		0xff, 0xff, 0xff, 0xff, // map ID for strings 2049-4096
 
 		// actions header
-		40, 0x00, 0x00, 0x00, // size = (6 * sizeof(uint32) * number of actions) + args + 4
+		48, 0x00, 0x00, 0x00, // size = (8 * sizeof(uint32) * number of actions) + args + 4
 		0x00, 0x00, 0x00, 0x00, // post to userspace
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleRate = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -1296,23 +1305,25 @@ func TestReturnSelectorArgIntActionFollowfd(t *testing.T) {
 
 	expU32Push(1)  // off: 0       number of selectors
 	expU32Push(4)  // off: 4       relative ofset of selector (4 + 4 = 8)
-	expU32Push(68) // off: 8       selector: length
+	expU32Push(76) // off: 8       selector: length
 	expU32Push(24) // off: 12      selector: matchReturnArgs length
 	expU32Push(0)  // off: 16      selector: matchReturnArgs arg offset[0]
 	expU32Push(0)  // off: 20      selector: matchReturnArgs arg offset[1]
 	expU32Push(0)  // off: 24      selector: matchReturnArgs arg offset[2]
 	expU32Push(0)  // off: 28      selector: matchReturnArgs arg offset[3]
 	expU32Push(0)  // off: 32      selector: matchReturnArgs arg offset[4]
-	expU32Push(40) // off: 36      selector: matchReturnActions length
+	expU32Push(48) // off: 36      selector: matchReturnActions length
 	expU32Push(0)  // off: 40      selector: selectors.ActionTypePost
 	expU32Push(0)  // off: 44      selector: rateLimit
-	expU32Push(0)  // off: 44      selector: rateLimitScope
-	expU32Push(0)  // off: 48      selector: stackTrace
-	expU32Push(0)  // off: 52      selector: userStackTrace
-	expU32Push(0)  // off: 56      selector: imaHash
-	expU32Push(1)  // off: 60      selector: selectors.ActionTypeFollowFd
-	expU32Push(7)  // off: 64      selector: action.ArgFd
-	expU32Push(8)  // off: 68      selector: action.ArgName
+	expU32Push(0)  // off: 48      selector: rateLimitScope
+	expU32Push(0)  // off: 52      selector: sampleRate
+	expU32Push(0)  // off: 56      selector: sampleScope
+	expU32Push(0)  // off: 60      selector: stackTrace
+	expU32Push(0)  // off: 64      selector: userStackTrace
+	expU32Push(0)  // off: 68      selector: imaHash
+	expU32Push(1)  // off: 72      selector: selectors.ActionTypeFollowFd
+	expU32Push(7)  // off: 76      selector: action.ArgFd
+	expU32Push(8)  // off: 80      selector: action.ArgName
 
 	if bytes.Equal(expected[:expectedLen], b[:expectedLen]) == false {
 		t.Errorf("\ngot: %v\nexp: %v\n", b[:expectedLen], expected[:expectedLen])
@@ -1425,3 +1436,230 @@ func TestParseCapabilityMask(t *testing.T) {
 	_, err = parseCapabilitiesMask("CAP_PIZZA")
 	assert.Error(t, err)
 }
+
+func TestParseMatchLineageBinaries(t *testing.T) {
+	k := NewKernelSelectorState(nil, nil, false)
+	parents := []v1alpha1.BinarySelector{{Operator: "In", Values: []string{"/usr/sbin/nginx", "/usr/bin/java"}}}
+	ancestors := []v1alpha1.AncestorBinarySelector{{Operator: "NotPostfix", Values: []string{"containerd-shim"}, MaxDepth: 4}}
+
+	err := ParseMatchParentBinaries(k, parents, 1)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	require.NoError(t, ParseMatchAncestorBinaries(k, ancestors, 1))
+
+	parentKey := MatchBinariesKey(1, MatchBinariesParent)
+	require.Contains(t, k.MatchBinaries(), parentKey)
+	assert.Equal(t, uint32(SelectorOpIn), k.MatchBinaries()[parentKey].Op)
+	assert.Equal(t, uint32(1), k.MatchBinaries()[parentKey].MaxDepth)
+	assert.Len(t, k.MatchBinariesPaths()[parentKey], 2)
+
+	ancestorsKey := MatchBinariesKey(1, MatchBinariesAncestors)
+	require.Contains(t, k.MatchBinaries(), ancestorsKey)
+	assert.Equal(t, uint32(SelectorOpNotPostfix), k.MatchBinaries()[ancestorsKey].Op)
+	assert.Equal(t, uint32(4), k.MatchBinaries()[ancestorsKey].MaxDepth)
+	assert.NotContains(t, k.MatchBinaries(), 1, "matchBinaries of the process are not set")
+
+	ancestors[0].MaxDepth = 0
+	require.NoError(t, ParseMatchAncestorBinaries(k, ancestors, 2))
+	assert.Equal(t, uint32(MatchAncestorsMaxDepth), k.MatchBinaries()[MatchBinariesKey(2, MatchBinariesAncestors)].MaxDepth)
+
+	ancestors[0].MaxDepth = MatchAncestorsMaxDepth + 1
+	require.Error(t, ParseMatchAncestorBinaries(k, ancestors, 3))
+
+	parents[0].FollowChildren = true
+	require.Error(t, ParseMatchParentBinaries(k, parents, 3))
+}
+
+func TestParseMatchThreshold(t *testing.T) {
+	k := NewKernelSelectorState(nil, nil, false)
+	threshold := &v1alpha1.ThresholdSelector{Count: 5, Window: "2m", Scope: "cgroup"}
+
+	err := ParseMatchThreshold(k, threshold, 1)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	require.Contains(t, k.Thresholds(), 1)
+	assert.Equal(t, ThresholdSelectorOptions{Window: 120000, Count: 5, Scope: ThresholdScopeCgroup}, k.Thresholds()[1])
+
+	require.NoError(t, ParseMatchThreshold(k, &v1alpha1.ThresholdSelector{Count: 3, Window: "10"}, 2))
+	assert.Equal(t, ThresholdSelectorOptions{Window: 10000, Count: 3, Scope: ThresholdScopeProcess}, k.Thresholds()[2])
+
+	require.NoError(t, ParseMatchThreshold(k, nil, 3))
+	assert.NotContains(t, k.Thresholds(), 3)
+
+	for _, invalid := range []v1alpha1.ThresholdSelector{
+		{Count: 0, Window: "10s"},
+		{Count: 1, Window: ""},
+		{Count: 1, Window: "0"},
+		{Count: 1, Window: "m"},
+		{Count: 1, Window: "10d"},
+		{Count: 1, Window: "10s", Scope: "thread"},
+	} {
+		require.Error(t, ParseMatchThreshold(k, &invalid, 4), invalid)
+	}
+}
+
+func testSequences(t *testing.T) *sequences.Policy {
+	seqs, err := sequences.New(&v1alpha1.TracingPolicySpec{
+		KProbes: []v1alpha1.KProbeSpec{{Call: "sys_memfd_create"}},
+		Sequences: []v1alpha1.SequenceSpec{
+			{Name: "memfd-exec", Steps: []string{"create", "write", "exec"}},
+			{Name: "ptrace-write", Steps: []string{"attach", "write"}},
+		},
+	})
+	require.NoError(t, err)
+	return seqs
+}
+
+func TestParseMatchState(t *testing.T) {
+	k := NewKernelSelectorState(nil, nil, false)
+	k.sequences = testSequences(t)
+	states := []v1alpha1.StateSelector{
+		{Sequence: "memfd-exec", Operator: "In", Values: []string{"create", "write"}},
+		{Sequence: "ptrace-write", Operator: "NotIn", Values: []string{"attach"}},
+	}
+
+	err := ParseMatchState(k, states, 1)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	assert.Equal(t, StateSelectorOptions{Reached: 0b011, NotReached: 0b01000}, k.States()[1])
+
+	require.NoError(t, ParseMatchState(k, nil, 2))
+	assert.NotContains(t, k.States(), 2)
+
+	for _, invalid := range [][]v1alpha1.StateSelector{
+		{{Sequence: "unknown", Operator: "In", Values: []string{"create"}}},
+		{{Sequence: "memfd-exec", Operator: "In", Values: []string{"attach"}}},
+		{{Sequence: "memfd-exec", Operator: "Equal", Values: []string{"create"}}},
+		{
+			{Sequence: "memfd-exec", Operator: "In", Values: []string{"create"}},
+			{Sequence: "memfd-exec", Operator: "NotIn", Values: []string{"create"}},
+		},
+	} {
+		require.Error(t, ParseMatchState(k, invalid, 3), invalid)
+	}
+
+	k = NewKernelSelectorState(nil, nil, false)
+	require.Error(t, ParseMatchState(k, states, 1), "policy without sequences")
+}
+
+func TestParseMatchActionSetState(t *testing.T) {
+	var actionArgTable idtable.Table
+
+	k := NewKernelSelectorState(nil, nil, false)
+	k.sequences = testSequences(t)
+	actions := []v1alpha1.ActionSelector{
+		{Action: "SetState", ArgSequence: "memfd-exec", ArgStep: "write"},
+		{Action: "SetState", ArgSequence: "memfd-exec", ArgStep: "exec"},
+	}
+	err := ParseMatchActions(k, actions, &actionArgTable)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+
+	d := &k.data
+	// actions length, then the type, the argument id, the set and reset masks of each action
+	expected := []uint32{36, ActionTypeSetState, 0, 0b010, 0, ActionTypeSetState, 1, 0, 0b111}
+	for i, v := range expected {
+		assert.Equal(t, v, binary.LittleEndian.Uint32(d.e[i*4:]), "word %d", i)
+	}
+	entry, err := actionArgTable.GetEntry(idtable.EntryID{ID: 1})
+	require.NoError(t, err)
+	step := entry.(*StateArgEntry).GetStep()
+	assert.Equal(t, "exec", step.Name())
+	assert.Equal(t, "memfd-exec", step.Sequence())
+
+	for _, invalid := range []v1alpha1.ActionSelector{
+		{Action: "SetState", ArgSequence: "memfd-exec", ArgStep: "attach"},
+		{Action: "SetState", ArgSequence: "unknown", ArgStep: "create"},
+	} {
+		k = NewKernelSelectorState(nil, nil, false)
+		k.sequences = testSequences(t)
+		require.Error(t, ParseMatchActions(k, []v1alpha1.ActionSelector{invalid}, &actionArgTable), invalid)
+	}
+}
+
+func TestParseSampleRate(t *testing.T) {
+	for _, tc := range []struct {
+		rate, scope string
+		expRate     uint32
+		expScope    uint32
+	}{
+		{"1/100", "", 100, ActionSampleScopeRandom},
+		{"1 / 10", "random", 10, ActionSampleScopeRandom},
+		{"50", "process", 50, ActionSampleScopeProcess},
+		{"1/1", "", 1, ActionSampleScopeRandom},
+	} {
+		rate, scope, err := parseSampleRate(tc.rate, tc.scope)
+		require.NoError(t, err, tc.rate)
+		assert.Equal(t, tc.expRate, rate, tc.rate)
+		assert.Equal(t, tc.expScope, scope, tc.rate)
+	}
+
+	for _, tc := range [][2]string{{"0", ""}, {"1/0", ""}, {"2/100", ""}, {"abc", ""}, {"-1", ""}, {"1/100", "thread"}} {
+		_, _, err := parseSampleRate(tc[0], tc[1])
+		require.Error(t, err, tc)
+	}
+}
+
+func TestParseMatchActionSampleRate(t *testing.T) {
+	var actionArgTable idtable.Table
+
+	k := NewKernelSelectorState(nil, nil, false)
+	err := ParseMatchAction(k, &v1alpha1.ActionSelector{Action: "Post", SampleRate: "1/100", SampleScope: "process"}, &actionArgTable)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	// type, rateLimit, rateLimitScope, sampleRate, sampleScope
+	expected := []uint32{ActionTypePost, 0, 0, 100, ActionSampleScopeProcess}
+	for i, v := range expected {
+		assert.Equal(t, v, binary.LittleEndian.Uint32(k.data.e[i*4:]), "word %d", i)
+	}
+
+	for _, invalid := range []v1alpha1.ActionSelector{
+		{Action: "Sigkill", SampleRate: "1/100"},
+		{Action: "Post", SampleScope: "process"},
+	} {
+		k = NewKernelSelectorState(nil, nil, false)
+		require.Error(t, ParseMatchAction(k, &invalid, &actionArgTable), invalid)
+	}
+}
+
+func TestSlotBuffer(t *testing.T) {
+	sig := []v1alpha1.KProbeArg{
+		{Index: 1, Type: "string"},
+		{Index: 5, Type: "sock"},
+	}
+	k := NewKernelSelectorState(nil, nil, false)
+	require.NoError(t, ParseMatchArg(k, &v1alpha1.ArgSelector{Index: 1, Operator: "Equal", Values: []string{"foobar"}}, sig))
+	strOff := uint32(16) // map ID for strings <25
+	addrOff := k.data.off + 16
+	require.NoError(t, ParseMatchArg(k, &v1alpha1.ArgSelector{Index: 5, Operator: "SAddr", Values: []string{"127.0.0.1"}}, sig))
+
+	assert.Equal(t, k.Buffer(), k.SlotBuffer(0))
+
+	buf := k.SlotBuffer(1)
+	assert.Equal(t, uint32(SelectorMapsSlotEntries), binary.LittleEndian.Uint32(buf[strOff:]), "string map id")
+	assert.Equal(t, uint32(0xffffffff), binary.LittleEndian.Uint32(buf[strOff+4:]), "no string map")
+	assert.Equal(t, uint32(SelectorMapsSlotEntries), binary.LittleEndian.Uint32(buf[addrOff:]), "addr4 map id")
+	assert.Equal(t, uint32(0xffffffff), binary.LittleEndian.Uint32(buf[addrOff+4:]), "no addr6 map")
+
+	// only the map ids are moved to the slot
+	orig := k.Buffer()
+	for _, off := range []uint32{strOff, addrOff} {
+		copy(buf[off:off+4], orig[off:off+4])
+	}
+	assert.Equal(t, orig, buf)
+}
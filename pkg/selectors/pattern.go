@@ -0,0 +1,495 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package selectors
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// StringPatternMaxLength is the maximum length of an argument that the Glob and Regex
+	// operators can match. Longer arguments never match.
+	StringPatternMaxLength = 255
+	// StringPatternMaxStates is the maximum number of states, including the dead state, of
+	// the DFA that the values of a Glob or Regex selector compile to.
+	StringPatternMaxStates = 128
+	// StringPatternAccept is set in a transition entry if the next state is accepting.
+	StringPatternAccept = 0x8000
+
+	stringPatternDeadState  = 0
+	stringPatternStartState = 1
+	// entry of the unused row of the dead state that holds whether the start state is
+	// accepting, i.e. whether the empty string matches
+	stringPatternEmptyEntry = 0
+
+	// maximum number of NFA states built while compiling the values of a single selector
+	stringPatternMaxNFAStates = 4096
+)
+
+// KernelStringPattern is the DFA that the values of a Glob or Regex selector compile to. The
+// BPF side walks it one byte of the argument at a time. Trans is indexed by state<<8|byte and
+// holds the next state, with StringPatternAccept set if that state is accepting. State 0 is the
+// dead state (no match is possible from it) and state 1 is the start state. Since the dead state
+// is never walked from, its first entry has StringPatternAccept set if the start state is
+// accepting, i.e. if the empty string matches.
+type KernelStringPattern struct {
+	States uint32
+	Trans  []uint16
+}
+
+// Entries returns the number of entries of the BPF array holding the DFA.
+func (p *KernelStringPattern) Entries() int {
+	return int(p.States) << 8
+}
+
+// Match runs the DFA over s, in the same way the BPF code does.
+func (p *KernelStringPattern) Match(s []byte) bool {
+	if len(s) > StringPatternMaxLength {
+		return false
+	}
+	if len(s) == 0 {
+		return p.Trans[stringPatternEmptyEntry]&StringPatternAccept != 0
+	}
+	state := uint16(stringPatternStartState)
+	for _, c := range s {
+		state = p.Trans[int(state&^StringPatternAccept)<<8|int(c)]
+		if state&^StringPatternAccept == stringPatternDeadState {
+			return false
+		}
+	}
+	return state&StringPatternAccept != 0
+}
+
+// byteSet is a set of byte values
+type byteSet [4]uint64
+
+func (s *byteSet) addRange(lo, hi int) {
+	for b := lo; b <= hi; b++ {
+		s[b>>6] |= 1 << (b & 63)
+	}
+}
+
+func (s *byteSet) has(b int) bool {
+	return s[b>>6]&(1<<(b&63)) != 0
+}
+
+func allBytes() byteSet {
+	var s byteSet
+	s.addRange(0, 255)
+	return s
+}
+
+// nfaState has at most one transition on a set of bytes, and any number of epsilon transitions
+type nfaState struct {
+	set  byteSet
+	next int
+	eps  []int
+}
+
+type nfa struct {
+	states []nfaState
+}
+
+func (n *nfa) newState() (int, error) {
+	if len(n.states) >= stringPatternMaxNFAStates {
+		return 0, fmt.Errorf("pattern is too complex: more than %d NFA states", stringPatternMaxNFAStates)
+	}
+	n.states = append(n.states, nfaState{next: -1})
+	return len(n.states) - 1, nil
+}
+
+func (n *nfa) addEps(from, to int) {
+	n.states[from].eps = append(n.states[from].eps, to)
+}
+
+// byteFrag returns a fragment matching a single byte of set
+func (n *nfa) byteFrag(set byteSet) (int, int, error) {
+	s, err := n.newState()
+	if err != nil {
+		return 0, 0, err
+	}
+	e, err := n.newState()
+	if err != nil {
+		return 0, 0, err
+	}
+	n.states[s].set = set
+	n.states[s].next = e
+	return s, e, nil
+}
+
+// runeSet returns the byte set for a character class. Patterns match bytes, so classes can only
+// contain ASCII characters, except for ranges that extend to the last unicode character (as in
+// negated classes) which match any non-ASCII byte. The non-ASCII characters that case folding
+// adds to a class (e.g., the Kelvin sign for 'k') are ignored.
+func runeSet(ranges []rune, fold bool) (byteSet, error) {
+	var set byteSet
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < utf8.RuneSelf {
+			set.addRange(int(lo), int(min(hi, utf8.RuneSelf-1)))
+		}
+		if hi >= utf8.RuneSelf {
+			if hi != unicode.MaxRune {
+				if fold {
+					continue
+				}
+				return set, errors.New("non-ASCII characters are not supported in character classes")
+			}
+			set.addRange(utf8.RuneSelf, 255)
+		}
+	}
+	return set, nil
+}
+
+// compile builds the Thompson NFA fragment of re, returning its start and end states
+func (n *nfa) compile(re *syntax.Regexp) (int, int, error) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		s, err := n.newState()
+		return s, s, err
+	case syntax.OpNoMatch:
+		// the end state is not reachable from the start state
+		return n.byteFrag(byteSet{})
+	case syntax.OpLiteral:
+		s, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		e := s
+		for _, r := range re.Rune {
+			var buf [utf8.UTFMax]byte
+			l := utf8.EncodeRune(buf[:], r)
+			for _, b := range buf[:l] {
+				var set byteSet
+				set.addRange(int(b), int(b))
+				if re.Flags&syntax.FoldCase != 0 && r < utf8.RuneSelf {
+					lower, upper := unicode.ToLower(r), unicode.ToUpper(r)
+					set.addRange(int(lower), int(lower))
+					set.addRange(int(upper), int(upper))
+				}
+				bs, be, err := n.byteFrag(set)
+				if err != nil {
+					return 0, 0, err
+				}
+				n.addEps(e, bs)
+				e = be
+			}
+		}
+		return s, e, nil
+	case syntax.OpCharClass:
+		set, err := runeSet(re.Rune, re.Flags&syntax.FoldCase != 0)
+		if err != nil {
+			return 0, 0, err
+		}
+		return n.byteFrag(set)
+	case syntax.OpAnyChar:
+		return n.byteFrag(allBytes())
+	case syntax.OpAnyCharNotNL:
+		set := allBytes()
+		set[0] &^= 1 << '\n'
+		return n.byteFrag(set)
+	case syntax.OpCapture:
+		return n.compile(re.Sub[0])
+	case syntax.OpConcat:
+		s, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		e := s
+		for _, sub := range re.Sub {
+			ss, se, err := n.compile(sub)
+			if err != nil {
+				return 0, 0, err
+			}
+			n.addEps(e, ss)
+			e = se
+		}
+		return s, e, nil
+	case syntax.OpAlternate:
+		s, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		e, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, sub := range re.Sub {
+			ss, se, err := n.compile(sub)
+			if err != nil {
+				return 0, 0, err
+			}
+			n.addEps(s, ss)
+			n.addEps(se, e)
+		}
+		return s, e, nil
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest:
+		ss, se, err := n.compile(re.Sub[0])
+		if err != nil {
+			return 0, 0, err
+		}
+		s, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		e, err := n.newState()
+		if err != nil {
+			return 0, 0, err
+		}
+		n.addEps(s, ss)
+		n.addEps(se, e)
+		if re.Op != syntax.OpPlus {
+			n.addEps(s, e)
+		}
+		if re.Op != syntax.OpQuest {
+			n.addEps(se, ss)
+		}
+		return s, e, nil
+	case syntax.OpBeginText, syntax.OpEndText:
+		return 0, 0, errors.New("anchors are only supported at the start and at the end of a pattern")
+	}
+	return 0, 0, fmt.Errorf("unsupported pattern operation '%s'", re)
+}
+
+// stripAnchors removes the leading ^ and the trailing $ of re and reports whether they were present
+func stripAnchors(re *syntax.Regexp) (*syntax.Regexp, bool, bool) {
+	switch re.Op {
+	case syntax.OpBeginText:
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch}, true, false
+	case syntax.OpEndText:
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch}, false, true
+	case syntax.OpConcat:
+		sub := re.Sub
+		begin, end := false, false
+		if len(sub) > 0 && sub[0].Op == syntax.OpBeginText {
+			sub, begin = sub[1:], true
+		}
+		if len(sub) > 0 && sub[len(sub)-1].Op == syntax.OpEndText {
+			sub, end = sub[:len(sub)-1], true
+		}
+		return &syntax.Regexp{Op: syntax.OpConcat, Sub: sub}, begin, end
+	}
+	return re, false, false
+}
+
+// GlobToRegex translates a glob pattern into an anchored regular expression. A '*' matches
+// any sequence of characters except '/', a '**' any sequence of characters, and a '?' any
+// character except '/'. Character classes ('[abc]', '[a-z]', '[!abc]') and '\' escapes are
+// supported.
+func GlobToRegex(glob string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("(?s)^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '\\':
+			if i+1 == len(glob) {
+				return "", fmt.Errorf("glob '%s': trailing escape character", glob)
+			}
+			i++
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case '[':
+			// a ']' right after the opening bracket, or its negation, is part of the class
+			j := i + 1
+			if j < len(glob) && (glob[j] == '!' || glob[j] == '^') {
+				j++
+			}
+			if j < len(glob) && glob[j] == ']' {
+				j++
+			}
+			end := strings.IndexByte(glob[j:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("glob '%s': unterminated character class", glob)
+			}
+			end += j
+			class := glob[i+1 : end]
+			sb.WriteByte('[')
+			if class[0] == '!' || class[0] == '^' {
+				sb.WriteByte('^')
+				class = class[1:]
+			}
+			for k := 0; k < len(class); k++ {
+				switch c := class[k]; c {
+				case '-':
+					sb.WriteByte(c)
+				case '\\':
+					if k+1 < len(class) {
+						k++
+					}
+					sb.WriteString(regexp.QuoteMeta(class[k : k+1]))
+				default:
+					sb.WriteString(regexp.QuoteMeta(class[k : k+1]))
+				}
+			}
+			sb.WriteByte(']')
+			i = end
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	sb.WriteByte('$')
+	return sb.String(), nil
+}
+
+// addPattern parses a regular expression and adds it to the NFA, linking it from start and to
+// accept. Unless anchored with '^' and '$', a regular expression matches anywhere in the string.
+func (n *nfa) addPattern(pattern string, start, accept int) error {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return err
+	}
+	re, begin, end := stripAnchors(re.Simplify())
+	s, e, err := n.compile(re)
+	if err != nil {
+		return err
+	}
+	if !begin {
+		// .* prefix
+		loop, err := n.newState()
+		if err != nil {
+			return err
+		}
+		n.states[loop].set = allBytes()
+		n.states[loop].next = loop
+		n.addEps(loop, s)
+		s = loop
+	}
+	if !end {
+		// .* suffix
+		loop, err := n.newState()
+		if err != nil {
+			return err
+		}
+		n.states[loop].set = allBytes()
+		n.states[loop].next = loop
+		n.addEps(e, loop)
+		e = loop
+	}
+	n.addEps(start, s)
+	n.addEps(e, accept)
+	return nil
+}
+
+func (n *nfa) closure(set []int) []int {
+	seen := make(map[int]bool, len(set))
+	stack := slices.Clone(set)
+	for _, s := range set {
+		seen[s] = true
+	}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, t := range n.states[s].eps {
+			if !seen[t] {
+				seen[t] = true
+				stack = append(stack, t)
+			}
+		}
+	}
+	ret := make([]int, 0, len(seen))
+	for s := range seen {
+		ret = append(ret, s)
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+func stateSetKey(set []int) string {
+	var sb strings.Builder
+	for _, s := range set {
+		sb.WriteString(strconv.Itoa(s))
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+// toDFA builds the DFA of the NFA using the subset construction
+func (n *nfa) toDFA(start, accept int) (*KernelStringPattern, error) {
+	sets := [][]int{nil, n.closure([]int{start})}
+	index := map[string]int{
+		stateSetKey(sets[stringPatternDeadState]):  stringPatternDeadState,
+		stateSetKey(sets[stringPatternStartState]): stringPatternStartState,
+	}
+	var trans []uint16
+	for cur := 0; cur < len(sets); cur++ {
+		row := make([]uint16, 256)
+		for b := range 256 {
+			var next []int
+			for _, s := range sets[cur] {
+				if n.states[s].next >= 0 && n.states[s].set.has(b) {
+					next = append(next, n.states[s].next)
+				}
+			}
+			next = n.closure(next)
+			key := stateSetKey(next)
+			idx, ok := index[key]
+			if !ok {
+				if len(sets) >= StringPatternMaxStates {
+					return nil, fmt.Errorf("pattern is too complex: more than %d DFA states", StringPatternMaxStates)
+				}
+				idx = len(sets)
+				index[key] = idx
+				sets = append(sets, next)
+			}
+			row[b] = uint16(idx)
+			if slices.Contains(next, accept) {
+				row[b] |= StringPatternAccept
+			}
+		}
+		trans = append(trans, row...)
+	}
+	if slices.Contains(sets[stringPatternStartState], accept) {
+		trans[stringPatternEmptyEntry] |= StringPatternAccept
+	}
+	return &KernelStringPattern{
+		States: uint32(len(sets)),
+		Trans:  trans,
+	}, nil
+}
+
+// CompileStringPatterns compiles a set of glob patterns, or regular expressions if regex is
+// true, into a single DFA that matches if any of the patterns matches.
+func CompileStringPatterns(values []string, regex bool) (*KernelStringPattern, error) {
+	if len(values) == 0 {
+		return nil, errors.New("no patterns specified")
+	}
+	n := &nfa{}
+	start, err := n.newState()
+	if err != nil {
+		return nil, err
+	}
+	accept, err := n.newState()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		pattern := v
+		if !regex {
+			pattern, err = GlobToRegex(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := n.addPattern(pattern, start, accept); err != nil {
+			return nil, fmt.Errorf("pattern '%s' invalid: %w", v, err)
+		}
+	}
+	return n.toDFA(start, accept)
+}
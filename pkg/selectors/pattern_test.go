@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package selectors
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func TestGlobToRegex(t *testing.T) {
+	tests := []struct {
+		glob  string
+		regex string
+	}{
+		{"/etc/passwd", `(?s)^/etc/passwd$`},
+		{"/etc/*.conf", `(?s)^/etc/[^/]*\.conf$`},
+		{"/home/**/.ssh/*", `(?s)^/home/.*/\.ssh/[^/]*$`},
+		{"/dev/tty?", `(?s)^/dev/tty[^/]$`},
+		{"/dev/sd[a-c]", `(?s)^/dev/sd[a-c]$`},
+		{"/dev/sd[!a]", `(?s)^/dev/sd[^a]$`},
+		{"/tmp/[]]", `(?s)^/tmp/[\]]$`},
+		{`/tmp/\*`, `(?s)^/tmp/\*$`},
+	}
+	for _, test := range tests {
+		regex, err := GlobToRegex(test.glob)
+		require.NoError(t, err, test.glob)
+		assert.Equal(t, test.regex, regex, test.glob)
+	}
+
+	for _, glob := range []string{"/dev/sd[a", `/tmp/\`} {
+		_, err := GlobToRegex(glob)
+		require.Error(t, err, glob)
+	}
+}
+
+func TestCompileStringPatternsGlob(t *testing.T) {
+	p, err := CompileStringPatterns([]string{"/etc/*.conf", "/home/**/.ssh/*", "/dev/sd[a-c]"}, false)
+	require.NoError(t, err)
+
+	for _, s := range []string{
+		"/etc/resolv.conf",
+		"/etc/.conf",
+		"/home/user/.ssh/id_rsa",
+		"/home/a/b/c/.ssh/authorized_keys",
+		"/dev/sdb",
+	} {
+		assert.True(t, p.Match([]byte(s)), s)
+	}
+	for _, s := range []string{
+		"",
+		"/etc/resolv.conf.bak",
+		"/etc/ssh/sshd.conf",
+		"/home/user/.ssh",
+		"/home/user/.ssh/keys/id_rsa",
+		"/dev/sdd",
+		"/dev/sda1",
+		"/etc/" + strings.Repeat("a", StringPatternMaxLength) + ".conf",
+	} {
+		assert.False(t, p.Match([]byte(s)), s)
+	}
+}
+
+func TestCompileStringPatternsRegex(t *testing.T) {
+	p, err := CompileStringPatterns([]string{`^/usr/bin/(python|perl)[0-9.]*$`, `\.ssh/`}, true)
+	require.NoError(t, err)
+
+	for _, s := range []string{
+		"/usr/bin/python3.12",
+		"/usr/bin/perl",
+		"/root/.ssh/id_rsa",
+		".ssh/",
+	} {
+		assert.True(t, p.Match([]byte(s)), s)
+	}
+	for _, s := range []string{
+		"/usr/bin/python3-config",
+		"/usr/local/bin/perl",
+		"/root/.sshd",
+	} {
+		assert.False(t, p.Match([]byte(s)), s)
+	}
+
+	p, err = CompileStringPatterns([]string{`(?i)^/tmp/[a-z]+\.sh$`}, true)
+	require.NoError(t, err)
+	assert.True(t, p.Match([]byte("/TMP/Payload.SH")))
+	assert.False(t, p.Match([]byte("/tmp/payload.sh.txt")))
+}
+
+func TestCompileStringPatternsEmpty(t *testing.T) {
+	for _, tt := range []struct {
+		values []string
+		regex  bool
+		want   bool
+	}{
+		{[]string{"*"}, false, true},
+		{[]string{"**"}, false, true},
+		{[]string{"/etc/*", ""}, false, true},
+		{[]string{"?"}, false, false},
+		{[]string{"/etc/*"}, false, false},
+		{[]string{"^$"}, true, true},
+		{[]string{"a*"}, true, true},
+		{[]string{"^a+$"}, true, false},
+		{[]string{`\.ssh/`}, true, false},
+	} {
+		p, err := CompileStringPatterns(tt.values, tt.regex)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, p.Match(nil), tt.values)
+		assert.Equal(t, tt.want, p.Trans[stringPatternEmptyEntry]&StringPatternAccept != 0, tt.values)
+		// the dead state must stay dead
+		assert.Equal(t, uint16(stringPatternDeadState), p.Trans[stringPatternEmptyEntry]&^StringPatternAccept, tt.values)
+	}
+}
+
+func TestCompileStringPatternsErrors(t *testing.T) {
+	tests := []struct {
+		values []string
+		regex  bool
+		err    string
+	}{
+		{nil, true, "no patterns"},
+		{[]string{`(foo`}, true, "missing closing )"},
+		{[]string{`foo\bbar`}, true, "unsupported pattern operation"},
+		{[]string{`a^b`}, true, "anchors are only supported"},
+		{[]string{`[àé]`}, true, "non-ASCII"},
+		{[]string{`a.{30}b`}, true, "pattern is too complex"},
+		{[]string{"/dev/sd[a"}, false, "unterminated character class"},
+	}
+	for _, test := range tests {
+		_, err := CompileStringPatterns(test.values, test.regex)
+		require.Error(t, err, test.values)
+		assert.Contains(t, err.Error(), test.err, test.values)
+	}
+}
+
+func TestParseMatchArgPattern(t *testing.T) {
+	sig := []v1alpha1.KProbeArg{
+		{Index: 0, Type: "string"},
+		{Index: 1, Type: "int"},
+		{Index: 2, Type: "file"},
+	}
+
+	for op, value := range map[string]string{"Glob": "/etc/**", "NotGlob": "/etc/**", "Regex": "^/etc/", "NotRegex": "^/etc/"} {
+		k := NewKernelSelectorState(nil, nil, false)
+		arg := &v1alpha1.ArgSelector{Index: 2, Operator: op, Values: []string{value}}
+		err := ParseMatchArg(k, arg, sig)
+		if !config.EnableLargeProgs() {
+			require.Error(t, err, op)
+			continue
+		}
+		require.NoError(t, err, op)
+		require.Len(t, k.StringPatternMaps(), 1)
+		d := &k.data
+		opCode, _ := SelectorOp(op)
+		assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(d.e[0:]), "index")
+		assert.Equal(t, opCode, binary.LittleEndian.Uint32(d.e[4:]), "operator")
+		assert.Equal(t, uint32(12), binary.LittleEndian.Uint32(d.e[8:]), "length")
+		assert.Equal(t, uint32(0), binary.LittleEndian.Uint32(d.e[16:]), "map id")
+		assert.Equal(t, k.StringPatternMaps()[0].Entries(), k.StringPatternMapsMaxEntries())
+	}
+
+	if !config.EnableLargeProgs() {
+		return
+	}
+
+	k := NewKernelSelectorState(nil, nil, false)
+	arg := &v1alpha1.ArgSelector{Index: 1, Operator: "Glob", Values: []string{"1*"}}
+	require.Error(t, ParseMatchArg(k, arg, sig), "Glob on int argument")
+
+	arg = &v1alpha1.ArgSelector{Index: 0, Operator: "Regex", Values: []string{"(foo"}}
+	require.Error(t, ParseMatchArg(k, arg, sig), "invalid regex")
+}
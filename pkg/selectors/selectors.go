@@ -11,6 +11,7 @@ import (
 
 	"github.com/cilium/tetragon/pkg/api/processapi"
 	"github.com/cilium/tetragon/pkg/kernels"
+	"github.com/cilium/tetragon/pkg/sequences"
 )
 
 type KernelLPMTrie4 struct {
@@ -93,6 +94,8 @@ type KernelSelectorMaps struct {
 	stringPrefixMaps []map[KernelLPMTrieStringPrefix]struct{}
 	// stringPostfixMaps are used to populate string and char buf postfix matches
 	stringPostfixMaps []map[KernelLPMTrieStringPostfix]struct{}
+	// stringPatternMaps are used to populate string and char buf glob and regex matches
+	stringPatternMaps []*KernelStringPattern
 }
 
 type MatchBinariesSelectorOptions struct {
@@ -100,6 +103,59 @@ type MatchBinariesSelectorOptions struct {
 	MapID uint32
 	// matchBinaries set for the selector
 	MBSetID uint32
+	// number of ancestors checked by matchAncestorBinaries
+	MaxDepth uint32
+}
+
+const (
+	// MaxSelectors is the maximum number of selectors of a hook (see MAX_SELECTORS in
+	// bpf/process/types/basic.h)
+	MaxSelectors = 5
+	// MatchAncestorsMaxDepth is the maximum number of ancestors checked by matchAncestorBinaries
+	MatchAncestorsMaxDepth = 16
+	// SelectorMapsSlotEntries is the number of entries of each slot of the outer maps of the
+	// selectors (argfilter_maps, string_maps_*, ...). See bpf/process/selector_slots.h.
+	SelectorMapsSlotEntries = 8
+)
+
+// The matchBinaries, matchParentBinaries, and matchAncestorBinaries selectors share the same
+// maps, each using MaxSelectors entries.
+const (
+	MatchBinariesCurrent = iota
+	MatchBinariesParent
+	MatchBinariesAncestors
+	// MatchBinariesKinds is the number of kinds of matchBinaries selectors
+	MatchBinariesKinds
+)
+
+// MatchBinariesKey returns the key of the matchBinaries maps for the given selector and kind
+// (MatchBinariesCurrent, MatchBinariesParent, or MatchBinariesAncestors).
+func MatchBinariesKey(selIdx int, kind int) int {
+	return kind*MaxSelectors + selIdx
+}
+
+// ThresholdSelectorOptions holds the matchThreshold configuration of a selector (see struct
+// threshold_sel_opts in bpf/process/threshold_maps.h)
+type ThresholdSelectorOptions struct {
+	// window length in milliseconds
+	Window uint64
+	Count  uint32
+	Scope  uint32
+}
+
+const (
+	ThresholdScopeProcess = iota
+	ThresholdScopeCgroup
+	ThresholdScopeGlobal
+)
+
+// StateSelectorOptions holds the matchState configuration of a selector (see struct
+// state_sel_opts in bpf/process/state_maps.h)
+type StateSelectorOptions struct {
+	// steps the process must have reached
+	Reached uint32
+	// steps the process must not have reached
+	NotReached uint32
 }
 
 type KernelSelectorData struct {
@@ -110,6 +166,9 @@ type KernelSelectorData struct {
 type KernelSelectorState struct {
 	data KernelSelectorData
 
+	// mapIDOffs are the offsets of the map ids written into the encoding
+	mapIDOffs []uint32
+
 	// valueMaps are used to populate value maps for InMap and NotInMap operators
 	valueMaps []ValueMap
 
@@ -122,6 +181,12 @@ type KernelSelectorState struct {
 	matchBinaries      map[int]MatchBinariesSelectorOptions
 	matchBinariesPaths map[int][][processapi.BINARY_PATH_MAX_LEN]byte
 
+	thresholds map[int]ThresholdSelectorOptions
+	states     map[int]StateSelectorOptions
+
+	// sequences of the policy, used by the matchState selectors and SetState actions
+	sequences *sequences.Policy
+
 	listReader ValueReader
 
 	maps *KernelSelectorMaps
@@ -138,6 +203,8 @@ func NewKernelSelectorState(listReader ValueReader, maps *KernelSelectorMaps, is
 	return &KernelSelectorState{
 		matchBinaries:      make(map[int]MatchBinariesSelectorOptions),
 		matchBinariesPaths: make(map[int][][processapi.BINARY_PATH_MAX_LEN]byte),
+		thresholds:         make(map[int]ThresholdSelectorOptions),
+		states:             make(map[int]StateSelectorOptions),
 		listReader:         listReader,
 		maps:               maps,
 		isUprobe:           isUprobe,
@@ -173,10 +240,40 @@ func (k *KernelSelectorState) MatchBinariesPathsMaxEntries() int {
 	return maxEntries
 }
 
+// Thresholds returns the matchThreshold options, indexed by selector
+func (k *KernelSelectorState) Thresholds() map[int]ThresholdSelectorOptions {
+	return k.thresholds
+}
+
+// States returns the matchState options, indexed by selector
+func (k *KernelSelectorState) States() map[int]StateSelectorOptions {
+	return k.states
+}
+
 func (k *KernelSelectorState) Buffer() [4096]byte {
 	return k.data.e
 }
 
+// SlotBuffer returns the kernel encoding of the selectors for the given selector slot, where the
+// ids of the inner maps are offset by the SelectorMapsSlotEntries entries of each previous slot.
+func (k *KernelSelectorState) SlotBuffer(slot uint32) [4096]byte {
+	buf := k.data.e
+	for _, off := range k.mapIDOffs {
+		id := binary.LittleEndian.Uint32(buf[off:])
+		binary.LittleEndian.PutUint32(buf[off:], id+slot*SelectorMapsSlotEntries)
+	}
+	return buf
+}
+
+// writeMapID writes the id of an inner map into the selector, unless it is 0xffffffff (no map),
+// and records its offset so that it can be moved to another slot by SlotBuffer.
+func (k *KernelSelectorState) writeMapID(id uint32) {
+	if id != 0xffffffff {
+		k.mapIDOffs = append(k.mapIDOffs, k.data.off)
+	}
+	WriteSelectorUint32(&k.data, id)
+}
+
 func (k *KernelSelectorState) ValueMaps() []ValueMap {
 	return k.valueMaps
 }
@@ -201,6 +298,10 @@ func (k *KernelSelectorState) StringPostfixMaps() []map[KernelLPMTrieStringPostf
 	return k.maps.stringPostfixMaps
 }
 
+func (k *KernelSelectorState) StringPatternMaps() []*KernelStringPattern {
+	return k.maps.stringPatternMaps
+}
+
 func (k *KernelSelectorState) Regs() []processapi.RegAssignment {
 	return k.regs
 }
@@ -271,6 +372,17 @@ func (k *KernelSelectorState) StringPostfixMapsMaxEntries() int {
 	return maxEntries
 }
 
+// StringPatternMapsMaxEntries returns the maximum entries over all maps
+func (k *KernelSelectorState) StringPatternMapsMaxEntries() int {
+	maxEntries := 1
+	for _, p := range k.maps.stringPatternMaps {
+		if l := p.Entries(); l > maxEntries {
+			maxEntries = l
+		}
+	}
+	return maxEntries
+}
+
 func WriteSelectorInt32(k *KernelSelectorData, v int32) {
 	binary.LittleEndian.PutUint32(k.e[k.off:], uint32(v))
 	k.off += 4
@@ -502,3 +614,9 @@ func (k *KernelSelectorState) newStringPostfixMap() (uint32, map[KernelLPMTrieSt
 	k.maps.stringPostfixMaps = append(k.maps.stringPostfixMaps, map[KernelLPMTrieStringPostfix]struct{}{})
 	return uint32(mapid), k.maps.stringPostfixMaps[mapid]
 }
+
+func (k *KernelSelectorState) newStringPatternMap(p *KernelStringPattern) uint32 {
+	mapid := len(k.maps.stringPatternMaps)
+	k.maps.stringPatternMaps = append(k.maps.stringPatternMaps, p)
+	return uint32(mapid)
+}
@@ -0,0 +1,762 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package selectors
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/reader/network"
+)
+
+// ErrNotEvaluable is returned when a selector relies on information that is
+// not available in a recorded event, so it cannot be evaluated in userspace.
+var ErrNotEvaluable = errors.New("selector cannot be evaluated against a recorded event")
+
+func notEvaluable(format string, a ...any) error {
+	return fmt.Errorf("%w: %s", ErrNotEvaluable, fmt.Sprintf(format, a...))
+}
+
+// RecordedEvent holds the fields of a recorded kprobe or tracepoint event that
+// selectors are evaluated against.
+type RecordedEvent struct {
+	Process   *tetragon.Process
+	Parent    *tetragon.Process
+	Ancestors []*tetragon.Process
+	// Args are the arguments of the event, in the order of the arguments
+	// of the hook in the policy.
+	Args []*tetragon.KprobeArgument
+}
+
+// RecordedKprobe returns the fields of a kprobe event.
+func RecordedKprobe(ev *tetragon.ProcessKprobe) *RecordedEvent {
+	return &RecordedEvent{Process: ev.Process, Parent: ev.Parent, Ancestors: ev.Ancestors, Args: ev.Args}
+}
+
+// RecordedTracepoint returns the fields of a tracepoint event.
+func RecordedTracepoint(ev *tetragon.ProcessTracepoint) *RecordedEvent {
+	return &RecordedEvent{Process: ev.Process, Parent: ev.Parent, Ancestors: ev.Ancestors, Args: ev.Args}
+}
+
+// UndeterminedSelector is a selector that could not be evaluated against a
+// recorded event.
+type UndeterminedSelector struct {
+	Index int
+	// Err wraps ErrNotEvaluable and explains why the selector could not be
+	// evaluated.
+	Err error
+}
+
+// SelectorsResult is the result of the evaluation of the selectors of a hook
+// against a recorded event.
+type SelectorsResult struct {
+	// Matched is true if a selector matched, or if there are no selectors.
+	Matched bool
+	// Selector is the index of the first matching selector, or -1.
+	Selector int
+	// Undetermined are the selectors preceding Selector (or all the
+	// selectors if none matched) that could not be evaluated. If any of them
+	// would have matched, it would have been applied instead of Selector.
+	Undetermined []UndeterminedSelector
+}
+
+// Determined reports whether the outcome of the evaluation is known: the
+// event matched a selector, or no selector could have matched it.
+func (r *SelectorsResult) Determined() bool {
+	return r.Matched || len(r.Undetermined) == 0
+}
+
+// MatchSelectors evaluates selectors against a recorded event, with the
+// semantics of the BPF filters: selectors are ORed, and the filters of a
+// selector are ANDed. Selectors that cannot be evaluated, because they use
+// filters other than matchBinaries, matchArgs, matchPIDs, matchNamespaces and
+// matchCapabilities or need information missing from the event, are skipped
+// and reported in the result. An error is returned only for invalid
+// selectors.
+func MatchSelectors(selectors []v1alpha1.KProbeSelector, specArgs []v1alpha1.KProbeArg, ev *RecordedEvent) (*SelectorsResult, error) {
+	res := &SelectorsResult{Selector: -1}
+	if len(selectors) == 0 {
+		res.Matched = true
+		return res, nil
+	}
+	for i := range selectors {
+		ok, err := MatchSelector(&selectors[i], specArgs, ev)
+		if errors.Is(err, ErrNotEvaluable) {
+			res.Undetermined = append(res.Undetermined, UndeterminedSelector{Index: i, Err: err})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("selector %d: %w", i, err)
+		}
+		if ok {
+			res.Matched = true
+			res.Selector = i
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+// filterResult accumulates the results of the filters of a selector: the
+// selector does not match as soon as one filter does not, even if other
+// filters cannot be evaluated.
+type filterResult struct {
+	mismatch     bool
+	undetermined error
+}
+
+// add records the result of a filter, and returns a non-nil error for
+// invalid filters.
+func (r *filterResult) add(ok bool, err error) error {
+	switch {
+	case errors.Is(err, ErrNotEvaluable):
+		if r.undetermined == nil {
+			r.undetermined = err
+		}
+	case err != nil:
+		return err
+	case !ok:
+		r.mismatch = true
+	}
+	return nil
+}
+
+func (r *filterResult) result() (bool, error) {
+	if r.mismatch {
+		return false, nil
+	}
+	if r.undetermined != nil {
+		return false, r.undetermined
+	}
+	return true, nil
+}
+
+// MatchSelector evaluates a single selector against a recorded event. If some
+// of its filters cannot be evaluated and none of the others rules out a
+// match, it returns an error wrapping ErrNotEvaluable.
+func MatchSelector(sel *v1alpha1.KProbeSelector, specArgs []v1alpha1.KProbeArg, ev *RecordedEvent) (bool, error) {
+	var r filterResult
+	switch {
+	case len(sel.MatchData) > 0:
+		r.add(false, notEvaluable("matchData is not supported"))
+	case len(sel.MatchReturnArgs) > 0:
+		r.add(false, notEvaluable("matchReturnArgs is not supported"))
+	case len(sel.MatchNamespaceChanges) > 0:
+		r.add(false, notEvaluable("matchNamespaceChanges is not supported"))
+	case len(sel.MatchCapabilityChanges) > 0:
+		r.add(false, notEvaluable("matchCapabilityChanges is not supported"))
+	case sel.MatchThreshold != nil:
+		r.add(false, notEvaluable("matchThreshold depends on previous events and is not supported"))
+	case len(sel.MatchState) > 0:
+		r.add(false, notEvaluable("matchState depends on previous events and is not supported"))
+	}
+	if ev.Process == nil {
+		return false, notEvaluable("event has no process information")
+	}
+	if len(sel.MatchBinaries) > 1 {
+		return false, errors.New("only support a single matchBinaries per selector")
+	}
+	for i := range sel.MatchPIDs {
+		if err := r.add(matchPID(&sel.MatchPIDs[i], ev)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchNamespaces {
+		if err := r.add(matchNamespace(&sel.MatchNamespaces[i], ev.Process)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchCapabilities {
+		if err := r.add(matchCapabilities(&sel.MatchCapabilities[i], ev.Process)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchBinaries {
+		if err := r.add(matchBinary(&sel.MatchBinaries[i], ev)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchParentBinaries {
+		if err := r.add(matchParentBinary(&sel.MatchParentBinaries[i], ev)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchAncestorBinaries {
+		if err := r.add(matchAncestorBinary(&sel.MatchAncestorBinaries[i], ev)); err != nil {
+			return false, err
+		}
+	}
+	for i := range sel.MatchArgs {
+		if err := r.add(matchArg(&sel.MatchArgs[i], specArgs, ev.Args)); err != nil {
+			return false, err
+		}
+	}
+	return r.result()
+}
+
+// lineage returns the process followed by its known ancestors, used to
+// evaluate followChildren and followForks.
+func lineage(ev *RecordedEvent) []*tetragon.Process {
+	ret := []*tetragon.Process{ev.Process}
+	if ev.Parent != nil {
+		ret = append(ret, ev.Parent)
+	}
+	return append(ret, ev.Ancestors...)
+}
+
+func matchPID(sel *v1alpha1.PIDSelector, ev *RecordedEvent) (bool, error) {
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchPIDs error: %w", err)
+	}
+	if sel.IsNamespacePID {
+		return false, notEvaluable("matchPIDs with isNamespacePID is not supported")
+	}
+	// Like the BPF filter, operators other than In and NotIn accept every
+	// process.
+	if op != SelectorOpIn && op != SelectorOpNotIn {
+		return true, nil
+	}
+	procs := []*tetragon.Process{ev.Process}
+	if sel.FollowForks {
+		procs = lineage(ev)
+	}
+	found := false
+	for _, p := range procs {
+		if p.GetPid() != nil && slices.Contains(sel.Values, p.GetPid().GetValue()) {
+			found = true
+			break
+		}
+	}
+	return found == (op == SelectorOpIn), nil
+}
+
+func processNamespace(p *tetragon.Process, nstype string) *tetragon.Namespace {
+	ns := p.GetNs()
+	switch nstype {
+	case "uts":
+		return ns.GetUts()
+	case "ipc":
+		return ns.GetIpc()
+	case "mnt":
+		return ns.GetMnt()
+	case "pid":
+		return ns.GetPid()
+	case "pidforchildren":
+		return ns.GetPidForChildren()
+	case "net":
+		return ns.GetNet()
+	case "time":
+		return ns.GetTime()
+	case "timeforchildren":
+		return ns.GetTimeForChildren()
+	case "cgroup":
+		return ns.GetCgroup()
+	case "user":
+		return ns.GetUser()
+	}
+	return nil
+}
+
+func matchNamespace(sel *v1alpha1.NamespaceSelector, p *tetragon.Process) (bool, error) {
+	nstype := strings.ToLower(sel.Namespace)
+	if _, ok := namespaceTypeTable[nstype]; !ok {
+		return false, fmt.Errorf("matchNamespaces: namespace %s unknown", sel.Namespace)
+	}
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchNamespaces error: %w", err)
+	}
+	if op != SelectorOpIn && op != SelectorOpNotIn {
+		return false, errors.New("matchNamespaces supports only In and NotIn operators")
+	}
+	ns := processNamespace(p, nstype)
+	if ns == nil {
+		return false, notEvaluable("event has no %s namespace information (see --enable-process-ns)", sel.Namespace)
+	}
+	found := false
+	for _, v := range sel.Values {
+		if v == "host_ns" {
+			found = ns.IsHost
+		} else {
+			inum, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return false, fmt.Errorf("values for matchNamespace can only be numeric or \"host_ns\". (%w)", err)
+			}
+			found = uint32(inum) == ns.Inum
+		}
+		if found {
+			break
+		}
+	}
+	return found == (op == SelectorOpIn), nil
+}
+
+func matchCapabilities(sel *v1alpha1.CapabilitiesSelector, p *tetragon.Process) (bool, error) {
+	var set []tetragon.CapabilitiesType
+	caps := p.GetCap()
+	switch strings.ToLower(sel.Type) {
+	case "effective":
+		set = caps.GetEffective()
+	case "inheritable":
+		set = caps.GetInheritable()
+	case "permitted":
+		set = caps.GetPermitted()
+	default:
+		return false, fmt.Errorf("matchCapabilities: type %s unknown", sel.Type)
+	}
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchCapabilities error: %w", err)
+	}
+	if op != SelectorOpIn && op != SelectorOpNotIn {
+		return false, errors.New("matchCapabilities supports only In and NotIn operators")
+	}
+	mask, err := capsStrToUint64(sel.Values)
+	if err != nil {
+		return false, err
+	}
+	if caps == nil {
+		return false, notEvaluable("event has no capabilities information (see --enable-process-cred)")
+	}
+	if sel.IsNamespaceCapability {
+		user := p.GetNs().GetUser()
+		if user == nil {
+			return false, notEvaluable("isNamespaceCapability needs user namespace information (see --enable-process-ns)")
+		}
+		if user.IsHost {
+			// only processes outside the host user namespace match
+			return false, nil
+		}
+	}
+	var have uint64
+	for _, c := range set {
+		have |= 1 << uint(c)
+	}
+	found := have&mask != 0
+	return found == (op == SelectorOpIn), nil
+}
+
+func matchBinary(sel *v1alpha1.BinarySelector, ev *RecordedEvent) (bool, error) {
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchBinary error: %w", err)
+	}
+	// matchBinaries selectors with no values are ignored
+	if len(sel.Values) == 0 {
+		return true, nil
+	}
+	procs := []*tetragon.Process{ev.Process}
+	if sel.FollowChildren {
+		if op != SelectorOpIn && op != SelectorOpNotIn {
+			return false, fmt.Errorf("matchBinary: followChildren not yet implemented for operation '%s'", sel.Operator)
+		}
+		procs = lineage(ev)
+	}
+	return matchBinaryProcs(op, sel.Values, procs)
+}
+
+// matchBinaryProcs reports whether the binary of any of procs matches values,
+// or, for the negated operators, whether none of them does.
+func matchBinaryProcs(op uint32, values []string, procs []*tetragon.Process) (bool, error) {
+	var match func(string) bool
+	var negate bool
+	switch op {
+	case SelectorOpIn, SelectorOpNotIn:
+		match = func(b string) bool { return slices.Contains(values, b) }
+		negate = op == SelectorOpNotIn
+	case SelectorOpPrefix, SelectorOpNotPrefix:
+		match = func(b string) bool { return hasAnyPrefix(b, values) }
+		negate = op == SelectorOpNotPrefix
+	case SelectorOpPostfix, SelectorOpNotPostfix:
+		match = func(b string) bool { return hasAnySuffix(b, values) }
+		negate = op == SelectorOpNotPostfix
+	default:
+		return false, errors.New("matchBinary error: Only \"In\", \"NotIn\", \"Prefix\", \"NotPrefix\", \"Postfix\" and \"NotPostfix\" operators are supported")
+	}
+	found := slices.ContainsFunc(procs, func(p *tetragon.Process) bool { return match(p.GetBinary()) })
+	return found != negate, nil
+}
+
+func matchParentBinary(sel *v1alpha1.BinarySelector, ev *RecordedEvent) (bool, error) {
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchParentBinaries error: %w", err)
+	}
+	if len(sel.Values) == 0 {
+		return true, nil
+	}
+	if ev.Parent == nil {
+		return false, notEvaluable("event has no parent information")
+	}
+	return matchBinaryProcs(op, sel.Values, []*tetragon.Process{ev.Parent})
+}
+
+func matchAncestorBinary(sel *v1alpha1.AncestorBinarySelector, ev *RecordedEvent) (bool, error) {
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchAncestorBinaries error: %w", err)
+	}
+	if len(sel.Values) == 0 {
+		return true, nil
+	}
+	if ev.Parent == nil {
+		return false, notEvaluable("event has no parent information")
+	}
+	maxDepth := int(sel.MaxDepth)
+	if maxDepth == 0 {
+		maxDepth = MatchAncestorsMaxDepth
+	}
+	procs := lineage(ev)[1:]
+	if len(procs) > maxDepth {
+		procs = procs[:maxDepth]
+	}
+	ok, err := matchBinaryProcs(op, sel.Values, procs)
+	if err != nil {
+		return false, err
+	}
+	// without ancestors information, only a match on the parent is conclusive
+	if maxDepth > 1 && len(ev.Ancestors) == 0 && ok == (op == SelectorOpNotIn || op == SelectorOpNotPrefix || op == SelectorOpNotPostfix) {
+		return false, notEvaluable("matchAncestorBinaries needs ancestors information (see --enable-ancestors)")
+	}
+	return ok, nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	return slices.ContainsFunc(prefixes, func(p string) bool { return strings.HasPrefix(s, p) })
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	return slices.ContainsFunc(suffixes, func(p string) bool { return strings.HasSuffix(s, p) })
+}
+
+// argPosition returns the position in the event arguments of the argument
+// a matchArgs filter applies to.
+func argPosition(sel *v1alpha1.ArgSelector, specArgs []v1alpha1.KProbeArg) (int, error) {
+	if len(sel.Args) > 0 {
+		if int(sel.Args[0]) >= len(specArgs) {
+			return 0, fmt.Errorf("matchArgs: args %d out of range", sel.Args[0])
+		}
+		return int(sel.Args[0]), nil
+	}
+	for i := range specArgs {
+		if specArgs[i].Index == sel.Index {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("matchArgs: no argument with index %d", sel.Index)
+}
+
+func matchArg(sel *v1alpha1.ArgSelector, specArgs []v1alpha1.KProbeArg, args []*tetragon.KprobeArgument) (bool, error) {
+	op, err := SelectorOp(sel.Operator)
+	if err != nil {
+		return false, fmt.Errorf("matchArgs error: %w", err)
+	}
+	pos, err := argPosition(sel, specArgs)
+	if err != nil {
+		return false, err
+	}
+	if pos >= len(args) || args[pos] == nil {
+		return false, notEvaluable("event has no argument at position %d", pos)
+	}
+	arg := args[pos]
+
+	switch a := arg.Arg.(type) {
+	case *tetragon.KprobeArgument_SockArg:
+		return matchNetwork(op, sel.Values, a.SockArg.Family, a.SockArg.Protocol, a.SockArg.State,
+			a.SockArg.Saddr, a.SockArg.Daddr, a.SockArg.Sport, a.SockArg.Dport)
+	case *tetragon.KprobeArgument_SkbArg:
+		return matchNetwork(op, sel.Values, "", strconv.FormatUint(uint64(a.SkbArg.Proto), 10), "",
+			a.SkbArg.Saddr, a.SkbArg.Daddr, a.SkbArg.Sport, a.SkbArg.Dport)
+	case *tetragon.KprobeArgument_SockaddrArg:
+		return matchNetwork(op, sel.Values, a.SockaddrArg.Family, "", "",
+			a.SockaddrArg.Addr, "", a.SockaddrArg.Port, 0)
+	}
+	if s, ok := argString(arg); ok {
+		// Like the BPF filter, a file without a path, such as an unnamed
+		// pipe, matches no operator.
+		if s == "" && isFileArg(arg) {
+			return false, nil
+		}
+		return matchString(op, sel.Values, s)
+	}
+	if n, ok := argNumber(arg); ok {
+		return matchNumber(op, sel.Values, n)
+	}
+	return false, notEvaluable("argument type %T is not supported", arg.Arg)
+}
+
+func argString(arg *tetragon.KprobeArgument) (string, bool) {
+	switch a := arg.Arg.(type) {
+	case *tetragon.KprobeArgument_StringArg:
+		return a.StringArg, true
+	case *tetragon.KprobeArgument_BytesArg:
+		return string(a.BytesArg), true
+	case *tetragon.KprobeArgument_TruncatedBytesArg:
+		return string(a.TruncatedBytesArg.GetBytesArg()), true
+	case *tetragon.KprobeArgument_PathArg:
+		return a.PathArg.GetPath(), true
+	case *tetragon.KprobeArgument_FileArg:
+		return a.FileArg.GetPath(), true
+	case *tetragon.KprobeArgument_LinuxBinprmArg:
+		return a.LinuxBinprmArg.GetPath(), true
+	case *tetragon.KprobeArgument_NetDevArg:
+		return a.NetDevArg.GetName(), true
+	}
+	return "", false
+}
+
+func isFileArg(arg *tetragon.KprobeArgument) bool {
+	switch arg.Arg.(type) {
+	case *tetragon.KprobeArgument_PathArg, *tetragon.KprobeArgument_FileArg, *tetragon.KprobeArgument_LinuxBinprmArg:
+		return true
+	}
+	return false
+}
+
+func argNumber(arg *tetragon.KprobeArgument) (*big.Int, bool) {
+	switch a := arg.Arg.(type) {
+	case *tetragon.KprobeArgument_IntArg:
+		return big.NewInt(int64(a.IntArg)), true
+	case *tetragon.KprobeArgument_UintArg:
+		return new(big.Int).SetUint64(uint64(a.UintArg)), true
+	case *tetragon.KprobeArgument_SizeArg:
+		return new(big.Int).SetUint64(a.SizeArg), true
+	case *tetragon.KprobeArgument_LongArg:
+		return big.NewInt(a.LongArg), true
+	case *tetragon.KprobeArgument_SyscallId:
+		return new(big.Int).SetUint64(uint64(a.SyscallId.GetId())), true
+	case *tetragon.KprobeArgument_KernelCapTArg:
+		return parseHexCaps(a.KernelCapTArg)
+	case *tetragon.KprobeArgument_CapEffectiveArg:
+		return parseHexCaps(a.CapEffectiveArg)
+	case *tetragon.KprobeArgument_CapPermittedArg:
+		return parseHexCaps(a.CapPermittedArg)
+	case *tetragon.KprobeArgument_CapInheritableArg:
+		return parseHexCaps(a.CapInheritableArg)
+	}
+	return nil, false
+}
+
+func parseHexCaps(s string) (*big.Int, bool) {
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	if err != nil {
+		return nil, false
+	}
+	return new(big.Int).SetUint64(v), true
+}
+
+func matchString(op uint32, values []string, s string) (bool, error) {
+	switch op {
+	case SelectorOpEQ:
+		return slices.Contains(values, s), nil
+	case SelectorOpNEQ:
+		return !slices.Contains(values, s), nil
+	case SelectorOpPrefix:
+		return hasAnyPrefix(s, values), nil
+	case SelectorOpNotPrefix:
+		return !hasAnyPrefix(s, values), nil
+	case SelectorOpPostfix:
+		return hasAnySuffix(s, values), nil
+	case SelectorOpNotPostfix:
+		return !hasAnySuffix(s, values), nil
+	case SelectorOpGlob, SelectorOpNotGlob, SelectorOpRegex, SelectorOpNotRegex:
+		p, err := CompileStringPatterns(values, op == SelectorOpRegex || op == SelectorOpNotRegex)
+		if err != nil {
+			return false, err
+		}
+		match := p.Match([]byte(s))
+		return match == (op == SelectorOpGlob || op == SelectorOpRegex), nil
+	}
+	return false, notEvaluable("operator %s on string arguments is not supported", selectorOpStringTable[op])
+}
+
+func parseBig(v string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(v, 0)
+	if !ok {
+		return nil, fmt.Errorf("MatchArgs value %s invalid", v)
+	}
+	return n, nil
+}
+
+// inRanges reports whether n is equal to one of values, or in one of the
+// "min:max" ranges of values.
+func inRanges(values []string, n *big.Int) (bool, error) {
+	for _, v := range values {
+		lo, hi, isRange := strings.Cut(v, ":")
+		if !isRange {
+			hi = lo
+		}
+		min, err := parseBig(lo)
+		if err != nil {
+			return false, err
+		}
+		max, err := parseBig(hi)
+		if err != nil {
+			return false, err
+		}
+		if n.Cmp(min) >= 0 && n.Cmp(max) <= 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchNumber(op uint32, values []string, n *big.Int) (bool, error) {
+	switch op {
+	case SelectorOpEQ, SelectorOpNEQ:
+		found := false
+		for _, v := range values {
+			val, err := parseMaskOrNumber(v)
+			if err != nil {
+				return false, err
+			}
+			if n.Cmp(val) == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (op == SelectorOpEQ), nil
+	case SelectorOpGT, SelectorOpLT:
+		if len(values) == 0 {
+			return false, errors.New("MatchArgs: GT and LT need a value")
+		}
+		val, err := parseBig(values[0])
+		if err != nil {
+			return false, err
+		}
+		if op == SelectorOpGT {
+			return n.Cmp(val) > 0, nil
+		}
+		return n.Cmp(val) < 0, nil
+	case SelectorOpMASK:
+		for _, v := range values {
+			val, err := parseMaskOrNumber(v)
+			if err != nil {
+				return false, err
+			}
+			if new(big.Int).And(n, val).Sign() != 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case SelectorInMap, SelectorOpInRange:
+		return inRanges(values, n)
+	case SelectorNotInMap, SelectorOpNotInRange:
+		found, err := inRanges(values, n)
+		return !found, err
+	}
+	return false, notEvaluable("operator %s on integer arguments is not supported", selectorOpStringTable[op])
+}
+
+// parseMaskOrNumber parses a number, or a comma separated list of
+// capabilities as accepted for capability arguments.
+func parseMaskOrNumber(v string) (*big.Int, error) {
+	if n, err := parseBig(v); err == nil {
+		return n, nil
+	}
+	mask, err := parseCapabilitiesMask(v)
+	if err != nil {
+		return nil, fmt.Errorf("MatchArgs value %s invalid: %w", v, err)
+	}
+	return new(big.Int).SetUint64(mask), nil
+}
+
+func parseNamedNumber(v string, byName func(string) (uint64, error)) (uint64, error) {
+	if n, err := strconv.ParseUint(v, 0, 32); err == nil {
+		return n, nil
+	}
+	return byName(v)
+}
+
+func matchNamed(values []string, got string, byName func(string) (uint64, error)) (bool, error) {
+	if got == "" {
+		return false, notEvaluable("event argument has no such field")
+	}
+	gotNum, err := parseNamedNumber(got, byName)
+	if err != nil {
+		return false, notEvaluable("unknown value %q in event", got)
+	}
+	for _, v := range values {
+		n, err := parseNamedNumber(v, byName)
+		if err != nil {
+			return false, fmt.Errorf("MatchArgs value %s invalid: %w", v, err)
+		}
+		if n == gotNum {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchAddr(values []string, addr string) (bool, error) {
+	if addr == "" {
+		return false, notEvaluable("event argument has no address")
+	}
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return false, notEvaluable("invalid address %q in event", addr)
+	}
+	for _, v := range values {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			a, aerr := netip.ParseAddr(v)
+			if aerr != nil {
+				return false, fmt.Errorf("MatchArgs value %s invalid: %w", v, err)
+			}
+			prefix = netip.PrefixFrom(a, a.BitLen())
+		}
+		if prefix.Contains(ip.Unmap()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchNetwork(op uint32, values []string, family, protocol, state, saddr, daddr string, sport, dport uint32) (bool, error) {
+	switch op {
+	case SelectorOpSport, SelectorOpNotSport, SelectorOpDport, SelectorOpNotDport:
+		port := sport
+		if op == SelectorOpDport || op == SelectorOpNotDport {
+			port = dport
+		}
+		found, err := inRanges(values, big.NewInt(int64(port)))
+		return found == (op == SelectorOpSport || op == SelectorOpDport), err
+	case SelectorOpSportPriv, SelectorOpNotSportPriv:
+		return (sport < 1024) == (op == SelectorOpSportPriv), nil
+	case SelectorOpDportPriv, SelectorOpNotDportPriv:
+		return (dport < 1024) == (op == SelectorOpDportPriv), nil
+	case SelectorOpSaddr, SelectorOpNotSaddr:
+		found, err := matchAddr(values, saddr)
+		return found == (op == SelectorOpSaddr), err
+	case SelectorOpDaddr, SelectorOpNotDaddr:
+		found, err := matchAddr(values, daddr)
+		return found == (op == SelectorOpDaddr), err
+	case SelectorOpProtocol:
+		return matchNamed(values, protocol, func(s string) (uint64, error) {
+			n, err := network.InetProtocolNumber(s)
+			return uint64(n), err
+		})
+	case SelectorOpFamily:
+		return matchNamed(values, family, func(s string) (uint64, error) {
+			n, err := network.InetFamilyNumber(s)
+			return uint64(n), err
+		})
+	case SelectorOpState:
+		return matchNamed(values, state, func(s string) (uint64, error) {
+			n, err := network.TcpStateNumber(s)
+			return uint64(n), err
+		})
+	}
+	return false, notEvaluable("operator %s on network arguments is not supported", selectorOpStringTable[op])
+}
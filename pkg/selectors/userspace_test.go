@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package selectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/idtable"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+func recordedOpen(binary, path string, flags int32) *RecordedEvent {
+	return &RecordedEvent{
+		Process: &tetragon.Process{
+			Binary: binary,
+			Pid:    wrapperspb.UInt32(42),
+			Ns: &tetragon.Namespaces{
+				Mnt: &tetragon.Namespace{Inum: 4026531841, IsHost: true},
+			},
+			Cap: &tetragon.Capabilities{
+				Effective: []tetragon.CapabilitiesType{tetragon.CapabilitiesType_CAP_SYS_ADMIN},
+			},
+		},
+		Parent: &tetragon.Process{Binary: "/bin/bash", Pid: wrapperspb.UInt32(1)},
+		Args: []*tetragon.KprobeArgument{
+			{Arg: &tetragon.KprobeArgument_IntArg{IntArg: -100}},
+			{Arg: &tetragon.KprobeArgument_StringArg{StringArg: path}},
+			{Arg: &tetragon.KprobeArgument_IntArg{IntArg: flags}},
+		},
+	}
+}
+
+var openArgs = []v1alpha1.KProbeArg{
+	{Index: 0, Type: "int"},
+	{Index: 1, Type: "string"},
+	{Index: 2, Type: "int"},
+}
+
+func TestMatchSelector(t *testing.T) {
+	ev := recordedOpen("/usr/bin/cat", "/etc/shadow", 0x241)
+	tests := []struct {
+		name string
+		sel  v1alpha1.KProbeSelector
+		want bool
+	}{
+		{"empty", v1alpha1.KProbeSelector{}, true},
+		{"binary in", v1alpha1.KProbeSelector{MatchBinaries: []v1alpha1.BinarySelector{
+			{Operator: "In", Values: []string{"/usr/bin/cat"}}}}, true},
+		{"binary not in", v1alpha1.KProbeSelector{MatchBinaries: []v1alpha1.BinarySelector{
+			{Operator: "NotIn", Values: []string{"/usr/bin/cat"}}}}, false},
+		{"binary prefix", v1alpha1.KProbeSelector{MatchBinaries: []v1alpha1.BinarySelector{
+			{Operator: "Prefix", Values: []string{"/sbin/", "/usr/"}}}}, true},
+		{"binary follow children", v1alpha1.KProbeSelector{MatchBinaries: []v1alpha1.BinarySelector{
+			{Operator: "In", Values: []string{"/bin/bash"}, FollowChildren: true}}}, true},
+		{"parent binary", v1alpha1.KProbeSelector{MatchParentBinaries: []v1alpha1.BinarySelector{
+			{Operator: "Postfix", Values: []string{"/bash"}}}}, true},
+		{"parent binary not in", v1alpha1.KProbeSelector{MatchParentBinaries: []v1alpha1.BinarySelector{
+			{Operator: "NotIn", Values: []string{"/bin/bash"}}}}, false},
+		{"ancestor binary", v1alpha1.KProbeSelector{MatchAncestorBinaries: []v1alpha1.AncestorBinarySelector{
+			{Operator: "In", Values: []string{"/bin/bash"}, MaxDepth: 1}}}, true},
+		{"arg equal", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 1, Operator: "Equal", Values: []string{"/etc/passwd", "/etc/shadow"}}}}, true},
+		{"arg postfix", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 1, Operator: "Postfix", Values: []string{"passwd"}}}}, false},
+		{"arg glob", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 1, Operator: "Glob", Values: []string{"/etc/*"}}}}, true},
+		{"arg not regex", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 1, Operator: "NotRegex", Values: []string{"^/etc/(passwd|shadow)$"}}}}, false},
+		{"arg mask", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 2, Operator: "Mask", Values: []string{"0x40"}}}}, true},
+		{"arg gt", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 0, Operator: "GT", Values: []string{"0"}}}}, false},
+		{"arg in range", v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 2, Operator: "InRange", Values: []string{"0x200:0x300"}}}}, true},
+		{"pid", v1alpha1.KProbeSelector{MatchPIDs: []v1alpha1.PIDSelector{
+			{Operator: "In", Values: []uint32{1}}}}, false},
+		{"pid follow forks", v1alpha1.KProbeSelector{MatchPIDs: []v1alpha1.PIDSelector{
+			{Operator: "In", Values: []uint32{1}, FollowForks: true}}}, true},
+		{"host mnt ns", v1alpha1.KProbeSelector{MatchNamespaces: []v1alpha1.NamespaceSelector{
+			{Namespace: "Mnt", Operator: "In", Values: []string{"host_ns"}}}}, true},
+		{"mnt ns inum", v1alpha1.KProbeSelector{MatchNamespaces: []v1alpha1.NamespaceSelector{
+			{Namespace: "Mnt", Operator: "NotIn", Values: []string{"4026531841"}}}}, false},
+		{"capabilities", v1alpha1.KProbeSelector{MatchCapabilities: []v1alpha1.CapabilitiesSelector{
+			{Type: "Effective", Operator: "In", Values: []string{"CAP_SYS_ADMIN", "CAP_BPF"}}}}, true},
+		{"all filters are required", v1alpha1.KProbeSelector{
+			MatchBinaries: []v1alpha1.BinarySelector{{Operator: "In", Values: []string{"/usr/bin/cat"}}},
+			MatchArgs:     []v1alpha1.ArgSelector{{Index: 1, Operator: "Prefix", Values: []string{"/tmp/"}}},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchSelector(&tt.sel, openArgs, ev)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchSelectorEmptyArg(t *testing.T) {
+	ev := recordedOpen("/usr/bin/cat", "", 0)
+	tests := []struct {
+		op     string
+		values []string
+		want   bool
+	}{
+		{"Glob", []string{"*"}, true},
+		{"NotGlob", []string{"*"}, false},
+		{"Glob", []string{"?*"}, false},
+		{"NotGlob", []string{"?*"}, true},
+		{"Regex", []string{"^$"}, true},
+		{"NotRegex", []string{"^$"}, false},
+		{"Regex", []string{"^.+$"}, false},
+		{"NotRegex", []string{"^.+$"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op+" "+tt.values[0], func(t *testing.T) {
+			sel := v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{{Index: 1, Operator: tt.op, Values: tt.values}}}
+			got, err := MatchSelector(&sel, openArgs, ev)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// files without a path never match, as in the BPF filter
+	fileEv := &RecordedEvent{
+		Process: ev.Process,
+		Args:    []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_FileArg{FileArg: &tetragon.KprobeFile{}}}},
+	}
+	fileArgs := []v1alpha1.KProbeArg{{Index: 0, Type: "file"}}
+	for _, op := range []string{"Glob", "NotGlob", "NotEqual"} {
+		sel := v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{{Index: 0, Operator: op, Values: []string{"*"}}}}
+		got, err := MatchSelector(&sel, fileArgs, fileEv)
+		require.NoError(t, err)
+		assert.False(t, got, op)
+	}
+}
+
+func TestMatchSelectorNetwork(t *testing.T) {
+	ev := &RecordedEvent{
+		Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+		Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+			Family: "AF_INET", Protocol: "IPPROTO_TCP", State: "TCP_SYN_SENT",
+			Saddr: "10.0.0.2", Daddr: "1.1.1.1", Sport: 43210, Dport: 443,
+		}}}},
+	}
+	args := []v1alpha1.KProbeArg{{Index: 0, Type: "sock"}}
+	tests := []struct {
+		op     string
+		values []string
+		want   bool
+	}{
+		{"DAddr", []string{"1.1.1.0/24"}, true},
+		{"NotDAddr", []string{"1.1.1.1"}, false},
+		{"SAddr", []string{"192.168.0.0/16"}, false},
+		{"DPort", []string{"80", "443"}, true},
+		{"NotSPort", []string{"40000:50000"}, false},
+		{"DPortPriv", nil, true},
+		{"Protocol", []string{"IPPROTO_TCP"}, true},
+		{"Family", []string{"AF_INET6"}, false},
+		{"State", []string{"TCP_SYN_SENT"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op, func(t *testing.T) {
+			sel := v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{{Index: 0, Operator: tt.op, Values: tt.values}}}
+			got, err := MatchSelector(&sel, args, ev)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchSelectors(t *testing.T) {
+	ev := recordedOpen("/usr/bin/cat", "/etc/shadow", 0)
+	selectors := []v1alpha1.KProbeSelector{
+		{MatchBinaries: []v1alpha1.BinarySelector{{Operator: "In", Values: []string{"/usr/bin/vi"}}}},
+		{MatchArgs: []v1alpha1.ArgSelector{{Index: 1, Operator: "Prefix", Values: []string{"/etc/"}}}},
+		{},
+	}
+	res, err := MatchSelectors(selectors, openArgs, ev)
+	require.NoError(t, err)
+	assert.Equal(t, &SelectorsResult{Matched: true, Selector: 1}, res)
+
+	res, err = MatchSelectors(nil, openArgs, ev)
+	require.NoError(t, err)
+	assert.Equal(t, &SelectorsResult{Matched: true, Selector: -1}, res)
+
+	res, err = MatchSelectors(selectors[:1], openArgs, ev)
+	require.NoError(t, err)
+	assert.Equal(t, &SelectorsResult{Selector: -1}, res)
+	assert.True(t, res.Determined())
+
+	_, err = MatchSelectors([]v1alpha1.KProbeSelector{
+		{MatchBinaries: []v1alpha1.BinarySelector{{Operator: "Glob", Values: []string{"*"}}}},
+	}, openArgs, ev)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotEvaluable)
+}
+
+func TestMatchSelectorsUndetermined(t *testing.T) {
+	ev := recordedOpen("/usr/bin/cat", "/etc/shadow", 0)
+	matchData := []v1alpha1.ArgSelector{{Index: 0, Operator: "Equal", Values: []string{"1"}}}
+	selectors := []v1alpha1.KProbeSelector{
+		// cannot be evaluated
+		{MatchData: matchData},
+		// does not match, whatever the result of matchData
+		{MatchData: matchData, MatchBinaries: []v1alpha1.BinarySelector{{Operator: "In", Values: []string{"/usr/bin/vi"}}}},
+		{MatchArgs: []v1alpha1.ArgSelector{{Index: 1, Operator: "Prefix", Values: []string{"/etc/"}}}},
+		// cannot be evaluated, but follows the matching selector
+		{MatchThreshold: &v1alpha1.ThresholdSelector{Count: 3, Window: "10s"}},
+	}
+	res, err := MatchSelectors(selectors, openArgs, ev)
+	require.NoError(t, err)
+	assert.True(t, res.Matched)
+	assert.Equal(t, 2, res.Selector)
+	require.Len(t, res.Undetermined, 1)
+	assert.Equal(t, 0, res.Undetermined[0].Index)
+	require.ErrorIs(t, res.Undetermined[0].Err, ErrNotEvaluable)
+
+	res, err = MatchSelectors([]v1alpha1.KProbeSelector{selectors[0], selectors[1], selectors[3]}, openArgs, ev)
+	require.NoError(t, err)
+	assert.False(t, res.Matched)
+	assert.False(t, res.Determined())
+	assert.Equal(t, []int{0, 2}, []int{res.Undetermined[0].Index, res.Undetermined[1].Index})
+}
+
+func TestMatchSelectorNotEvaluable(t *testing.T) {
+	ev := recordedOpen("/usr/bin/cat", "/etc/shadow", 0)
+	for _, sel := range []v1alpha1.KProbeSelector{
+		{MatchData: []v1alpha1.ArgSelector{{Index: 0, Operator: "Equal", Values: []string{"1"}}}},
+		{MatchNamespaces: []v1alpha1.NamespaceSelector{{Namespace: "Net", Operator: "In", Values: []string{"host_ns"}}}},
+		{MatchPIDs: []v1alpha1.PIDSelector{{Operator: "In", Values: []uint32{1}, IsNamespacePID: true}}},
+		{MatchAncestorBinaries: []v1alpha1.AncestorBinarySelector{{Operator: "In", Values: []string{"/usr/sbin/nginx"}}}},
+		{MatchThreshold: &v1alpha1.ThresholdSelector{Count: 3, Window: "10s"}},
+		{MatchState: []v1alpha1.StateSelector{{Sequence: "memfd-exec", Operator: "In", Values: []string{"create"}}}},
+	} {
+		_, err := MatchSelector(&sel, openArgs, ev)
+		require.ErrorIs(t, err, ErrNotEvaluable)
+	}
+}
+
+// TestMatchSelectorParity checks that the userspace evaluation accepts the
+// same selectors as the kernel encoding: a selector that can be encoded must
+// not be rejected in userspace, and a selector that cannot be encoded must
+// not be evaluated.
+func TestMatchSelectorParity(t *testing.T) {
+	oldLarge := option.Config.ForceLargeProgs
+	option.Config.ForceLargeProgs = true
+	t.Cleanup(func() { option.Config.ForceLargeProgs = oldLarge })
+
+	sockArgs := []v1alpha1.KProbeArg{{Index: 0, Type: "sock"}}
+	sockEv := &RecordedEvent{
+		Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+		Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+			Family: "AF_INET", Protocol: "IPPROTO_TCP", State: "TCP_SYN_SENT",
+			Saddr: "10.0.0.2", Daddr: "1.1.1.1", Sport: 43210, Dport: 443,
+		}}}},
+	}
+	openEv := recordedOpen("/usr/bin/cat", "/etc/shadow", 0x241)
+
+	values := map[string][]string{
+		"SAddr": {"10.0.0.0/8"}, "DAddr": {"1.1.1.1"}, "NotSAddr": {"10.0.0.0/8"}, "NotDAddr": {"1.1.1.1"},
+		"SPort": {"443"}, "DPort": {"80:443"}, "NotSPort": {"443"}, "NotDPort": {"443"},
+		"Protocol": {"IPPROTO_TCP"}, "Family": {"AF_INET"}, "State": {"TCP_SYN_SENT"},
+		"InRange": {"1:10"}, "NoInRange": {"1:10"}, "InMap": {"1:10"}, "NotInMap": {"1:10"},
+		"SPortPriv": nil, "NotSPortPriv": nil, "DPortPriv": nil, "NotDPortPriv": nil,
+		"Glob": {"/etc/*"}, "NotGlob": {"/etc/*"}, "Regex": {"^/etc/"}, "NotRegex": {"^/etc/"},
+	}
+	type testCase struct {
+		name string
+		sel  v1alpha1.KProbeSelector
+		args []v1alpha1.KProbeArg
+		ev   *RecordedEvent
+	}
+	var cases []testCase
+	for _, op := range selectorOpStringTable {
+		if op == "CapabilitiesGained" {
+			continue
+		}
+		vals, ok := values[op]
+		if !ok {
+			vals = []string{"1"}
+		}
+		for i, argType := range []string{"int", "string"} {
+			cases = append(cases, testCase{
+				name: "matchArgs " + op + " " + argType,
+				sel:  v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{{Index: uint32(i * 2), Operator: op, Values: vals}}},
+				args: openArgs,
+				ev:   openEv,
+			})
+		}
+		cases = append(cases, testCase{
+			name: "matchArgs " + op + " sock",
+			sel:  v1alpha1.KProbeSelector{MatchArgs: []v1alpha1.ArgSelector{{Index: 0, Operator: op, Values: vals}}},
+			args: sockArgs,
+			ev:   sockEv,
+		})
+		cases = append(cases, testCase{
+			name: "matchBinaries " + op,
+			sel:  v1alpha1.KProbeSelector{MatchBinaries: []v1alpha1.BinarySelector{{Operator: op, Values: []string{"/usr/bin/cat"}}}},
+			args: openArgs,
+			ev:   openEv,
+		})
+		cases = append(cases, testCase{
+			name: "matchPIDs " + op,
+			sel:  v1alpha1.KProbeSelector{MatchPIDs: []v1alpha1.PIDSelector{{Operator: op, Values: []uint32{1}}}},
+			args: openArgs,
+			ev:   openEv,
+		})
+		cases = append(cases, testCase{
+			name: "matchNamespaces " + op,
+			sel:  v1alpha1.KProbeSelector{MatchNamespaces: []v1alpha1.NamespaceSelector{{Namespace: "Mnt", Operator: op, Values: []string{"4026531841"}}}},
+			args: openArgs,
+			ev:   openEv,
+		})
+		cases = append(cases, testCase{
+			name: "matchCapabilities " + op,
+			sel:  v1alpha1.KProbeSelector{MatchCapabilities: []v1alpha1.CapabilitiesSelector{{Type: "Effective", Operator: op, Values: []string{"CAP_SYS_ADMIN"}}}},
+			args: openArgs,
+			ev:   openEv,
+		})
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actionArgTable idtable.Table
+			_, kernelErr := InitKernelSelectors([]v1alpha1.KProbeSelector{tc.sel}, tc.args, nil, &actionArgTable)
+			_, err := MatchSelector(&tc.sel, tc.args, tc.ev)
+			if errors.Is(err, ErrNotEvaluable) {
+				return
+			}
+			if kernelErr == nil {
+				assert.NoError(t, err, "accepted by the kernel encoding")
+			} else {
+				assert.Error(t, err, "rejected by the kernel encoding: %v", kernelErr)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ const (
 	ErrorState
 	LoadingState
 	UnloadingState
+	NotApplicableState
 )
 
 func (s TracingPolicyState) ToTetragonState() tetragon.TracingPolicyState {
@@ -46,6 +47,8 @@ func (s TracingPolicyState) ToTetragonState() tetragon.TracingPolicyState {
 		return tetragon.TracingPolicyState_TP_STATE_LOADING
 	case UnloadingState:
 		return tetragon.TracingPolicyState_TP_STATE_UNLOADING
+	case NotApplicableState:
+		return tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE
 	default:
 		return tetragon.TracingPolicyState_TP_STATE_UNKNOWN
 	}
@@ -90,6 +93,14 @@ type collection struct {
 	policyfilterID uint64
 	// state indicates the state of the collection
 	state TracingPolicyState
+	// generation of the tracing policy, incremented each time it is updated
+	generation uint64
+	// generation of the tracing policy the sensors were created for, which
+	// determines where their maps are pinned. It is not incremented when the
+	// policy is updated in place.
+	pinGeneration uint64
+	// updating indicates that a new version of the policy is being loaded
+	updating bool
 
 	warnedOnModeRetrievalFailure  atomic.Bool
 	warnedOnStatsRetrievalFailure atomic.Bool
@@ -118,7 +129,7 @@ func (c *collection) mode() tetragon.TracingPolicyMode {
 	if c.tracingpolicy == nil || c.state != EnabledState {
 		return tetragon.TracingPolicyMode_TP_MODE_UNKNOWN
 	}
-	mode, err := policyconf.PolicyMode(c.tracingpolicy)
+	mode, err := policyconf.PolicyGenerationMode(c.tracingpolicy, c.pinGeneration)
 	if err != nil {
 		if !c.warnedOnModeRetrievalFailure.Load() {
 			logger.GetLogger().Warn("failed to retrieve policy mode", "err", err, "policy", c.name)
@@ -145,7 +156,7 @@ func (c *collection) stats() *tetragon.TracingPolicyStats {
 		return nil
 	}
 
-	stats, err := policystats.GetPolicyStats(c.tracingpolicy)
+	stats, err := policystats.GetPolicyGenerationStats(c.tracingpolicy, c.pinGeneration)
 	if err != nil {
 		if !c.warnedOnStatsRetrievalFailure.Load() {
 			c.warnedOnStatsRetrievalFailure.Store(true)
@@ -163,6 +174,8 @@ func (c *collection) stats() *tetragon.TracingPolicyStats {
 			NotifyEnforcer:        stats.ActionsCount[policystats.PolicyNotifyEnforcer],
 			MonitorNotifyEnforcer: stats.ActionsCount[policystats.PolicyMonitorNotifyEnforcer],
 		},
+		OmittedArgsBytes:    stats.OmittedBytes,
+		BudgetDroppedEvents: stats.BudgetDropped,
 	}
 }
 
@@ -188,7 +201,7 @@ func (c *collection) setMode(mode tetragon.TracingPolicyMode) error {
 		return err
 	}
 
-	return policyconf.SetPolicyMode(c.tracingpolicy, m)
+	return policyconf.SetPolicyGenerationMode(c.tracingpolicy, c.pinGeneration, m)
 }
 
 // load will attempt to load a collection of sensors. If loading one of the sensors fails, it
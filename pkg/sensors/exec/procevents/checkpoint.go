@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/process"
+)
+
+// restoreFromCheckpoint matches the processes read from procfs with the ones
+// of a checkpoint, using their PID, procfs start time and binary. Matched
+// processes get back their original ktime, and so their exec ID, and their
+// original parent if it is still known: either a matched process or an
+// exited process of the checkpoint. It returns the exited processes to
+// restore and the number of matched processes.
+func restoreFromCheckpoint(c *process.Checkpoint, ps []procs) ([]*tetragon.Process, int) {
+	byPid := make(map[uint32]*process.CheckpointProcess, len(c.Processes))
+	for i := range c.Processes {
+		byPid[c.Processes[i].Pid] = &c.Processes[i]
+	}
+	matched := make(map[uint32]*process.CheckpointProcess)
+	for i := range ps {
+		p := &ps[i]
+		cp, ok := byPid[p.pid]
+		if !ok || p.pid == kernelPid || cp.ProcKtime != p.ktime {
+			continue
+		}
+		// the process called execve since the checkpoint
+		if cp.Exe != "" && cp.Exe != string(p.exe) {
+			continue
+		}
+		matched[p.pid] = cp
+	}
+
+	exited := make(map[string]*tetragon.Process, len(c.Exited))
+	for _, e := range c.Exited {
+		exited[e.ExecId] = e
+	}
+	var restoredExited []*tetragon.Process
+	for i := range ps {
+		p := &ps[i]
+		// the parent read from procfs has been restored
+		if parent, ok := matched[p.ppid]; ok && parent.ProcKtime == p.pktime {
+			p.pktime = parent.Ktime
+		}
+		cp, ok := matched[p.pid]
+		if !ok {
+			continue
+		}
+		p.ktime = cp.Ktime
+		// the original parent, that procfs reports as the reaper if it
+		// exited
+		if parent, ok := matched[cp.ParentPid]; ok && parent.Ktime == cp.ParentKtime {
+			p.ppid, p.pktime = cp.ParentPid, cp.ParentKtime
+		} else if e, ok := exited[process.GetProcessID(cp.ParentPid, cp.ParentKtime)]; ok {
+			p.ppid, p.pktime = cp.ParentPid, cp.ParentKtime
+			restoredExited = append(restoredExited, e)
+			delete(exited, e.ExecId)
+		}
+	}
+	return restoredExited, len(matched)
+}
+
+// StartCheckpoints writes checkpoints of the process cache every interval
+// until the context is done.
+func StartCheckpoints(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := WriteCheckpoint(); err != nil {
+					logger.GetLogger().Warn("Failed to checkpoint the process cache", logfields.Error, err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cilium/ebpf"
+
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/bpf"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/proc"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/exec/execvemap"
+)
+
+func readBootID() (string, error) {
+	b, err := os.ReadFile(filepath.Join(option.Config.ProcFS, "sys/kernel/random/boot_id"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read boot ID: %w", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// restoreCheckpoint restores the process cache checkpoint, if configured, in
+// the processes read from procfs before they are pushed.
+func restoreCheckpoint(ps []procs) {
+	fname := option.Config.ProcessCacheCheckpointFile
+	if fname == "" {
+		return
+	}
+	bootID, err := readBootID()
+	if err != nil {
+		logger.GetLogger().Warn("Not restoring the process cache checkpoint", logfields.Error, err)
+		return
+	}
+	c, err := process.ReadCheckpoint(fname, bootID)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.GetLogger().Info("No process cache checkpoint to restore", "file", fname)
+		return
+	} else if err != nil {
+		logger.GetLogger().Warn("Not restoring the process cache checkpoint", "file", fname, logfields.Error, err)
+		return
+	}
+
+	exited, restored := restoreFromCheckpoint(c, ps)
+	if err := process.RestoreExited(exited); err != nil {
+		logger.GetLogger().Warn("Failed to restore exited processes of the process cache checkpoint", logfields.Error, err)
+	}
+	logger.GetLogger().Info("Restored the process cache checkpoint",
+		"file", fname,
+		"checkpointTime", c.Time,
+		"restored", restored,
+		"exited", len(exited),
+		"running", len(ps))
+}
+
+// WriteCheckpoint writes a checkpoint of the processes of the execve map
+// that are still running, and of their exited parents in the process cache.
+func WriteCheckpoint() error {
+	fname := option.Config.ProcessCacheCheckpointFile
+	if fname == "" {
+		return errors.New("process cache checkpoint file not configured")
+	}
+	bootID, err := readBootID()
+	if err != nil {
+		return err
+	}
+	m, err := ebpf.LoadPinnedMap(filepath.Join(bpf.MapPrefixPath(), base.GetExecveMap().Name), &ebpf.LoadPinOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open execve map: %w", err)
+	}
+	defer m.Close()
+
+	c := &process.Checkpoint{
+		Version: process.CheckpointVersion,
+		BootID:  bootID,
+		Time:    time.Now(),
+	}
+	running := map[string]struct{}{}
+	var parents []processapi.MsgExecveKey
+	var key execvemap.ExecveKey
+	var val execvemap.ExecveValue
+	iter := m.Iterate()
+	for iter.Next(&key, &val) {
+		if key.Pid == kernelPid {
+			continue
+		}
+		pathName := filepath.Join(option.Config.ProcFS, strconv.FormatUint(uint64(key.Pid), 10))
+		stats, err := proc.GetProcStatStrings(pathName)
+		if err != nil {
+			// the process exited
+			continue
+		}
+		procKtime, err := proc.GetStatsKtime(stats)
+		if err != nil {
+			continue
+		}
+		exe, _ := os.Readlink(filepath.Join(pathName, "exe"))
+		c.Processes = append(c.Processes, process.CheckpointProcess{
+			Pid:         key.Pid,
+			Ktime:       val.Process.Ktime,
+			ProcKtime:   procKtime,
+			Exe:         exe,
+			ParentPid:   val.Parent.Pid,
+			ParentKtime: val.Parent.Ktime,
+		})
+		running[process.GetExecIDFromKey(&val.Process)] = struct{}{}
+		parents = append(parents, val.Parent)
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to iterate execve map: %w", err)
+	}
+
+	for _, parent := range parents {
+		execID := process.GetExecIDFromKey(&parent)
+		if _, ok := running[execID]; ok || parent.Pid == kernelPid {
+			continue
+		}
+		// running processes and exited parents are written once
+		running[execID] = struct{}{}
+		if p, ok := process.GetCheckpointProcess(execID); ok {
+			c.Exited = append(c.Exited, p)
+		}
+	}
+	return process.WriteCheckpoint(fname, c)
+}
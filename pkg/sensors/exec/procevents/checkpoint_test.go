@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/process"
+)
+
+func TestRestoreFromCheckpoint(t *testing.T) {
+	exitedParent := &tetragon.Process{
+		ExecId: process.GetProcessID(20, 2000),
+		Pid:    &wrapperspb.UInt32Value{Value: 20},
+	}
+	c := &process.Checkpoint{
+		Processes: []process.CheckpointProcess{
+			{Pid: 1, Ktime: 105, ProcKtime: 100, Exe: "/sbin/init"},
+			{Pid: 10, Ktime: 1005, ProcKtime: 1000, Exe: "/usr/bin/containerd", ParentPid: 1, ParentKtime: 105},
+			// reparented to init after its parent exited
+			{Pid: 30, Ktime: 3005, ProcKtime: 3000, Exe: "/bin/sleep", ParentPid: 20, ParentKtime: 2000},
+			// called execve since the checkpoint
+			{Pid: 40, Ktime: 4005, ProcKtime: 4000, Exe: "/bin/sh", ParentPid: 10, ParentKtime: 1005},
+			// exited since the checkpoint, and the PID was reused
+			{Pid: 50, Ktime: 5005, ProcKtime: 5000, Exe: "/bin/cat", ParentPid: 10, ParentKtime: 1005},
+		},
+		Exited: []*tetragon.Process{exitedParent},
+	}
+	ps := []procs{
+		{pid: 1, ktime: 100, exe: []byte("/sbin/init")},
+		{pid: 10, ktime: 1000, exe: []byte("/usr/bin/containerd"), ppid: 1, pktime: 100},
+		{pid: 30, ktime: 3000, exe: []byte("/bin/sleep"), ppid: 1, pktime: 100},
+		{pid: 40, ktime: 4000, exe: []byte("/bin/ls"), ppid: 10, pktime: 1000},
+		{pid: 50, ktime: 6000, exe: []byte("/bin/cat"), ppid: 10, pktime: 1000},
+		// started after the checkpoint
+		{pid: 60, ktime: 7000, exe: []byte("/bin/bash"), ppid: 10, pktime: 1000},
+	}
+
+	exited, restored := restoreFromCheckpoint(c, ps)
+	assert.Equal(t, 3, restored)
+	assert.Equal(t, []*tetragon.Process{exitedParent}, exited)
+
+	type ids struct {
+		ppid   uint32
+		pktime uint64
+		ktime  uint64
+	}
+	var got []ids
+	for _, p := range ps {
+		got = append(got, ids{p.ppid, p.pktime, p.ktime})
+	}
+	assert.Equal(t, []ids{
+		{0, 0, 105},
+		{1, 105, 1005},
+		{20, 2000, 3005},
+		{10, 1005, 4000},
+		{10, 1005, 6000},
+		{10, 1005, 7000},
+	}, got)
+}
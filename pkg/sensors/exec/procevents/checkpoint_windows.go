@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"errors"
+)
+
+func restoreCheckpoint(_ []procs) {
+}
+
+func WriteCheckpoint() error {
+	return errors.New("process cache checkpoints are not supported on windows")
+}
@@ -264,6 +264,7 @@ func GetRunningProcs() error {
 		return err
 	}
 
+	restoreCheckpoint(procs)
 	pushEvents(procs)
 	return nil
 }
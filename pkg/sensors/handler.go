@@ -4,6 +4,7 @@
 package sensors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,7 +12,11 @@ import (
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/tetragon/pkg/labels"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -67,7 +72,7 @@ func SensorsFromPolicy(tp tracingpolicy.TracingPolicy, filterID policyfilter.Pol
 // revive:enable:exported
 
 // updatePolicyFilter will update the policyfilter state so that filtering for
-// i) namespaced policies and ii) pod label filters happens.
+// i) namespaced policies and ii) pod, container, namespace and workload filters happens.
 //
 // It returns:
 //
@@ -94,21 +99,51 @@ func (h *handler) updatePolicyFilter(tp tracingpolicy.TracingPolicy, tpID uint64
 		}
 	}
 
+	var namespaceSelector *slimv1.LabelSelector
+	if ps := tp.TpSpec().NamespaceSelector; ps != nil {
+		if len(ps.MatchLabels)+len(ps.MatchExpressions) > 0 {
+			namespaceSelector = ps
+		}
+	}
+
+	var workloadSelector *slimv1.LabelSelector
+	if ps := tp.TpSpec().WorkloadSelector; ps != nil {
+		if len(ps.MatchLabels)+len(ps.MatchExpressions) > 0 {
+			workloadSelector = ps
+		}
+	}
+
 	// we do not call AddPolicy unless filtering is actually needed. This
 	// means that if policyfilter is disabled
 	// (option.Config.EnablePolicyFilter is false) then loading the policy
 	// will only fail if filtering is required.
-	if namespace == "" && podSelector == nil && containerSelector == nil {
+	if namespace == "" && podSelector == nil && containerSelector == nil &&
+		namespaceSelector == nil && workloadSelector == nil {
 		return policyfilter.NoFilterID, nil
 	}
 
 	filterID := policyfilter.PolicyID(tpID)
-	if err := h.pfState.AddPolicy(filterID, namespace, podSelector, containerSelector); err != nil {
+	if err := h.pfState.AddPolicy(filterID, namespace, podSelector, containerSelector,
+		namespaceSelector, workloadSelector); err != nil {
 		return policyfilter.NoFilterID, err
 	}
 	return filterID, nil
 }
 
+// nodeSelectorMatches returns true if the policy applies to the local node, i.e., if it has no
+// node selector or if the labels of the node match its node selector.
+func nodeSelectorMatches(tp tracingpolicy.TracingPolicy) (bool, error) {
+	ns := tp.TpSpec().NodeSelector
+	if ns == nil || len(ns.MatchLabels)+len(ns.MatchExpressions) == 0 {
+		return true, nil
+	}
+	selector, err := labels.SelectorFromLabelSelector(ns)
+	if err != nil {
+		return false, fmt.Errorf("invalid nodeSelector: %w", err)
+	}
+	return selector.Match(labels.Labels(node.GetNodeLabels())), nil
+}
+
 func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	h.collections.mu.Lock()
 	defer h.collections.mu.Unlock()
@@ -124,9 +159,24 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 		name:            op.ck.name,
 		tracingpolicy:   op.tp,
 		tracingpolicyID: uint64(tpID),
+		generation:      1,
+		pinGeneration:   1,
 	}
 	collections[op.ck] = &col
 
+	// policies that do not apply to this node are kept, so that they can
+	// be listed, but their sensors are not loaded.
+	applicable, err := nodeSelectorMatches(op.tp)
+	if err != nil {
+		col.err = err
+		col.state = LoadErrorState
+		return err
+	}
+	if !applicable {
+		col.state = NotApplicableState
+		return nil
+	}
+
 	// update policy filter state before loading the sensors of the policy.
 	//
 	// The filterID is set to a non-zero value only if we need to apply
@@ -168,6 +218,172 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	return nil
 }
 
+// newPolicyVersion creates the collection of a new version of the policy of
+// col, without loading its sensors. Its sensors are pinned under the directory
+// of the new generation, so that they can be loaded while the ones of col are
+// still loaded.
+func (h *handler) newPolicyVersion(col *collection, tp tracingpolicy.TracingPolicy) (*collection, error) {
+	generation := col.generation + 1
+	tpID := h.allocPolicyID()
+	newCol := &collection{
+		name:            col.name,
+		tracingpolicy:   tp,
+		tracingpolicyID: tpID,
+		generation:      generation,
+		pinGeneration:   generation,
+	}
+
+	applicable, err := nodeSelectorMatches(tp)
+	if err != nil {
+		return nil, err
+	}
+	if !applicable {
+		newCol.state = NotApplicableState
+		return newCol, nil
+	}
+
+	filterID, err := h.updatePolicyFilter(tp, tpID)
+	if err != nil {
+		return nil, err
+	}
+	newCol.policyfilterID = uint64(filterID)
+
+	sensors, err := sensorsFromPolicyHandlers(tp, filterID)
+	if err != nil {
+		h.delPolicyFilter(newCol)
+		return nil, err
+	}
+	setGeneration(sensors, generation)
+	newCol.sensors = sensors
+	return newCol, nil
+}
+
+func (h *handler) delPolicyFilter(col *collection) {
+	filterID := policyfilter.PolicyID(col.policyfilterID)
+	if err := h.pfState.DelPolicy(filterID); err != nil {
+		logger.GetLogger().Warn("failed to remove from policyfilter", "policy", col.name, logfields.Error, err)
+	}
+}
+
+// updateTracingPolicy replaces the policy of an existing collection with a
+// new version of it.
+//
+// If the policy is enabled and only its selectors changed, its sensors are
+// updated in place so that its programs remain attached. Otherwise, the
+// sensors of the new version are loaded before the ones of the previous
+// version are unloaded. If loading them fails, the previous version is kept.
+// Disabled policies are updated without loading the sensors of the new
+// version, and policies that failed to load are loaded again.
+func (h *handler) updateTracingPolicy(op *tracingPolicyUpdate) error {
+	h.collections.mu.Lock()
+	defer h.collections.mu.Unlock()
+	collections := h.collections.c
+	col, exists := collections[op.ck]
+	if !exists {
+		return fmt.Errorf("tracing policy %s does not exist", op.ck)
+	}
+	if op.from != nil && col != op.from {
+		return nil
+	}
+	if col.tracingpolicy == nil {
+		return fmt.Errorf("%s is not a tracing policy", op.ck)
+	}
+	if col.state == LoadingState || col.state == UnloadingState || col.updating {
+		return fmt.Errorf("failed to update tracing policy %s: policy is being loaded or unloaded", op.ck)
+	}
+
+	newCol, err := h.newPolicyVersion(col, op.tp)
+	if err != nil {
+		return fmt.Errorf("failed to update tracing policy %s: %w", op.ck, err)
+	}
+
+	inPlace := false
+	switch {
+	case newCol.state == NotApplicableState:
+	case col.state == DisabledState:
+		newCol.state = DisabledState
+	default:
+		if col.state == EnabledState {
+			err = updateSensors(col.sensors, newCol.sensors)
+			if err == nil {
+				inPlace = true
+				newCol.sensors = col.sensors
+				newCol.pinGeneration = col.pinGeneration
+				newCol.state = EnabledState
+				break
+			}
+			if !errors.Is(err, ErrNotUpdatable) {
+				// the sensors might be partially updated, so load
+				// the new version with new sensors.
+				logger.GetLogger().Warn("failed to update tracing policy in place, reloading it",
+					"policy", op.ck.String(), logfields.Error, err)
+				sensors, err := sensorsFromPolicyHandlers(op.tp, policyfilter.PolicyID(newCol.policyfilterID))
+				if err != nil {
+					h.delPolicyFilter(newCol)
+					return fmt.Errorf("failed to update tracing policy %s: %w", op.ck, err)
+				}
+				setGeneration(sensors, newCol.generation)
+				newCol.sensors = sensors
+			}
+		}
+
+		newCol.state = LoadingState
+		col.updating = true
+		// unlock so that policyLister can access the collections (read-only) while we are loading.
+		h.collections.mu.Unlock()
+		err = h.load(newCol)
+		h.collections.mu.Lock()
+		col.updating = false
+
+		if err == nil && collections[op.ck] != col {
+			err = errors.New("policy was modified concurrently")
+		}
+		if err != nil {
+			newCol.destroy(true)
+			h.delPolicyFilter(newCol)
+			return fmt.Errorf("failed to update tracing policy %s: %w", op.ck, err)
+		}
+		newCol.state = EnabledState
+	}
+
+	collections[op.ck] = newCol
+	if !inPlace {
+		col.destroy(true)
+	}
+	h.delPolicyFilter(col)
+	return nil
+}
+
+// reevaluateNodeSelectors updates the policies whose node selector matches the
+// labels of the node differently than when they were added, so that their
+// sensors are loaded or unloaded.
+func (h *handler) reevaluateNodeSelectors(ctx context.Context) error {
+	h.collections.mu.Lock()
+	var ops []*tracingPolicyUpdate
+	for ck, col := range h.collections.c {
+		if col.tracingpolicy == nil {
+			continue
+		}
+		applicable, err := nodeSelectorMatches(col.tracingpolicy)
+		if err != nil || applicable == (col.state != NotApplicableState) {
+			continue
+		}
+		ops = append(ops, &tracingPolicyUpdate{
+			ctx:  ctx,
+			ck:   ck,
+			tp:   col.tracingpolicy,
+			from: col,
+		})
+	}
+	h.collections.mu.Unlock()
+
+	var errs error
+	for _, op := range ops {
+		errs = errors.Join(errs, h.updateTracingPolicy(op))
+	}
+	return errs
+}
+
 func (h *handler) deleteTracingPolicy(op *tracingPolicyDelete) error {
 	h.collections.mu.Lock()
 	collections := h.collections.c
@@ -403,13 +619,14 @@ func (h *handler) listPolicies() []*tetragon.TracingPolicyStatus {
 
 		col.tracingpolicy.TpSpec()
 		pol := tetragon.TracingPolicyStatus{
-			Id:       col.tracingpolicyID,
-			Name:     ck.name,
-			Enabled:  col.state == EnabledState,
-			FilterId: col.policyfilterID,
-			State:    col.state.ToTetragonState(),
-			Mode:     col.mode(),
-			Stats:    col.stats(),
+			Id:         col.tracingpolicyID,
+			Name:       ck.name,
+			Enabled:    col.state == EnabledState,
+			FilterId:   col.policyfilterID,
+			State:      col.state.ToTetragonState(),
+			Mode:       col.mode(),
+			Stats:      col.stats(),
+			Generation: col.generation,
 		}
 
 		if col.err != nil {
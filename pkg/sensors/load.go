@@ -60,7 +60,7 @@ func LoadConfig(bpfDir string, sens []*Sensor) error {
 }
 
 func (s *Sensor) policyDir() string {
-	return tracingpolicy.PolicyDir(s.Namespace, s.Policy)
+	return tracingpolicy.PolicyGenerationDir(s.Namespace, s.Policy, s.Generation)
 }
 
 func (s *Sensor) createDirs(bpfDir string) {
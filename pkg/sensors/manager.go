@@ -134,6 +134,30 @@ func (h *Manager) AddTracingPolicy(ctx context.Context, tp tracingpolicy.Tracing
 	return h.handler.addTracingPolicy(op)
 }
 
+// UpdateTracingPolicy replaces a loaded tracing policy with a new version of
+// it, with the same name and namespace
+func (h *Manager) UpdateTracingPolicy(ctx context.Context, tp tracingpolicy.TracingPolicy) error {
+	var namespace string
+	if tpNs, ok := tp.(tracingpolicy.TracingPolicyNamespaced); ok {
+		namespace = tpNs.TpNamespace()
+	}
+	ck := collectionKey{tp.TpName(), namespace}
+	op := &tracingPolicyUpdate{
+		ctx: ctx,
+		ck:  ck,
+		tp:  tp,
+	}
+
+	return h.handler.updateTracingPolicy(op)
+}
+
+// NodeLabelsChanged loads the tracing policies whose node selector now matches
+// the labels of the node, and unloads the ones whose node selector no longer
+// does.
+func (h *Manager) NodeLabelsChanged(ctx context.Context) error {
+	return h.handler.reevaluateNodeSelectors(ctx)
+}
+
 // DeleteTracingPolicy deletes a new sensor based on a tracing policy
 func (h *Manager) DeleteTracingPolicy(ctx context.Context, name string, namespace string) error {
 	ck := collectionKey{name, namespace}
@@ -240,6 +264,15 @@ type tracingPolicyAdd struct {
 	tp  tracingpolicy.TracingPolicy
 }
 
+// tracingPolicyUpdate replaces the tracing policy of a sensor collection
+type tracingPolicyUpdate struct {
+	ctx context.Context
+	ck  collectionKey
+	tp  tracingpolicy.TracingPolicy
+	// if set, the update is skipped if the collection is no longer from
+	from *collection
+}
+
 type tracingPolicyDelete struct {
 	ctx context.Context
 	ck  collectionKey
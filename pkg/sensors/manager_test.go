@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/tetragon/pkg/bpf"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/sensors/program"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 
@@ -302,6 +303,120 @@ func TestPolicyListCollections(t *testing.T) {
 	assert.Equal(t, kprobes, collections[0].TracingpolicySpec.KProbes)
 }
 
+func TestPolicyNodeSelector(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	RegisterPolicyHandlerAtInit("dummy", &dummyHandler{s: &Sensor{Name: "dummy-sensor"}})
+	t.Cleanup(func() {
+		delete(registeredPolicyHandlers, "dummy")
+	})
+
+	oldLabels := node.GetNodeLabels()
+	node.SetNodeLabels(map[string]string{"pool": "gpu"})
+	t.Cleanup(func() {
+		node.SetNodeLabels(oldLabels)
+	})
+
+	mgr, err := StartSensorManager("")
+	require.NoError(t, err)
+
+	gpuPolicy := v1alpha1.TracingPolicy{}
+	gpuPolicy.Name = "gpu-policy"
+	gpuPolicy.Spec.NodeSelector = &slimv1.LabelSelector{
+		MatchLabels: map[string]slimv1.MatchLabelsValue{"pool": "gpu"},
+	}
+	err = mgr.AddTracingPolicy(ctx, &gpuPolicy)
+	require.NoError(t, err)
+
+	cpuPolicy := v1alpha1.TracingPolicy{}
+	cpuPolicy.Name = "cpu-policy"
+	cpuPolicy.Spec.NodeSelector = &slimv1.LabelSelector{
+		MatchLabels: map[string]slimv1.MatchLabelsValue{"pool": "cpu"},
+	}
+	err = mgr.AddTracingPolicy(ctx, &cpuPolicy)
+	require.NoError(t, err)
+
+	l, err := mgr.ListTracingPolicies(ctx)
+	require.NoError(t, err)
+	require.Len(t, l.Policies, 2)
+	for _, pol := range l.Policies {
+		switch pol.Name {
+		case "gpu-policy":
+			assert.Equal(t, EnabledState.ToTetragonState(), pol.State)
+		case "cpu-policy":
+			assert.Equal(t, NotApplicableState.ToTetragonState(), pol.State)
+			assert.Empty(t, pol.Error)
+			assert.Empty(t, pol.Sensors)
+		}
+	}
+
+	require.Error(t, mgr.EnableTracingPolicy(ctx, "cpu-policy", ""))
+	require.NoError(t, mgr.DeleteTracingPolicy(ctx, "cpu-policy", ""))
+	require.NoError(t, mgr.DeleteTracingPolicy(ctx, "gpu-policy", ""))
+}
+
+type policySensorHandler struct{}
+
+func (policySensorHandler) PolicyHandler(tp tracingpolicy.TracingPolicy, _ policyfilter.PolicyID) (SensorIface, error) {
+	return &Sensor{Name: tp.TpName() + "-sensor"}, nil
+}
+
+func TestPolicyNodeLabelsChanged(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	RegisterPolicyHandlerAtInit("dummy", policySensorHandler{})
+	t.Cleanup(func() {
+		delete(registeredPolicyHandlers, "dummy")
+	})
+
+	oldLabels := node.GetNodeLabels()
+	node.SetNodeLabels(map[string]string{"pool": "gpu"})
+	t.Cleanup(func() {
+		node.SetNodeLabels(oldLabels)
+	})
+
+	mgr, err := StartSensorManager("")
+	require.NoError(t, err)
+	for _, pool := range []string{"gpu", "cpu"} {
+		policy := v1alpha1.TracingPolicy{}
+		policy.Name = pool + "-policy"
+		policy.Spec.NodeSelector = &slimv1.LabelSelector{
+			MatchLabels: map[string]slimv1.MatchLabelsValue{"pool": pool},
+		}
+		require.NoError(t, mgr.AddTracingPolicy(ctx, &policy))
+	}
+
+	states := func() map[string]tetragon.TracingPolicyState {
+		l, err := mgr.ListTracingPolicies(ctx)
+		require.NoError(t, err)
+		ret := map[string]tetragon.TracingPolicyState{}
+		for _, pol := range l.Policies {
+			ret[pol.Name] = pol.State
+		}
+		return ret
+	}
+	assert.Equal(t, map[string]tetragon.TracingPolicyState{
+		"gpu-policy": EnabledState.ToTetragonState(),
+		"cpu-policy": NotApplicableState.ToTetragonState(),
+	}, states())
+
+	require.True(t, node.SetNodeLabels(map[string]string{"pool": "cpu"}))
+	require.NoError(t, mgr.NodeLabelsChanged(ctx))
+	assert.Equal(t, map[string]tetragon.TracingPolicyState{
+		"gpu-policy": NotApplicableState.ToTetragonState(),
+		"cpu-policy": EnabledState.ToTetragonState(),
+	}, states())
+
+	// nothing to do when the labels do not change the selected policies
+	require.NoError(t, mgr.NodeLabelsChanged(ctx))
+	assert.Equal(t, EnabledState.ToTetragonState(), states()["cpu-policy"])
+
+	require.NoError(t, mgr.DeleteTracingPolicy(ctx, "cpu-policy", ""))
+	require.NoError(t, mgr.DeleteTracingPolicy(ctx, "gpu-policy", ""))
+}
+
 func TestPolicyListingWhileLoadUnload(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -466,3 +581,81 @@ func TestPolicyKernelMemoryBytes(t *testing.T) {
 	require.Len(t, l.Policies, 1)
 	assert.Equal(t, uint64(500), l.Policies[0].KernelMemoryBytes)
 }
+
+// newSensorHandler creates a new sensor each time a policy is loaded
+type newSensorHandler struct {
+	newSensor func() *Sensor
+}
+
+func (d *newSensorHandler) PolicyHandler(_ tracingpolicy.TracingPolicy, _ policyfilter.PolicyID) (SensorIface, error) {
+	return d.newSensor(), nil
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	loadFail := false
+	RegisterPolicyHandlerAtInit("dummy", &newSensorHandler{newSensor: func() *Sensor {
+		if loadFail {
+			return &Sensor{
+				Name:  "dummy-sensor",
+				Progs: []*program.Program{{Name: "bpf-program-that-does-not-exist"}},
+			}
+		}
+		return &Sensor{Name: "dummy-sensor"}
+	}})
+	t.Cleanup(func() {
+		delete(registeredPolicyHandlers, "dummy")
+	})
+
+	mgr, err := StartSensorManager("")
+	require.NoError(t, err)
+
+	policy := v1alpha1.TracingPolicy{}
+	policy.Name = "test-policy"
+	require.Error(t, mgr.UpdateTracingPolicy(ctx, &policy), "policy does not exist")
+	require.NoError(t, mgr.AddTracingPolicy(ctx, &policy))
+
+	checkPolicy := func(state TracingPolicyState, generation uint64) {
+		l, err := mgr.ListTracingPolicies(ctx)
+		require.NoError(t, err)
+		require.Len(t, l.Policies, 1)
+		assert.Equal(t, state.ToTetragonState(), l.Policies[0].State)
+		assert.Equal(t, generation, l.Policies[0].Generation)
+	}
+	checkPolicy(EnabledState, 1)
+	col := mgr.handler.collections.c[collectionKey{name: policy.Name}]
+	sensor := col.sensors[0]
+
+	// the sensor of the policy is updated in place
+	require.NoError(t, mgr.UpdateTracingPolicy(ctx, &policy))
+	checkPolicy(EnabledState, 2)
+	col = mgr.handler.collections.c[collectionKey{name: policy.Name}]
+	assert.Same(t, sensor, col.sensors[0])
+	assert.Equal(t, uint64(1), col.pinGeneration)
+
+	// the sensor of the new version cannot be updated in place and fails to
+	// load, so the previous version is kept
+	loadFail = true
+	require.Error(t, mgr.UpdateTracingPolicy(ctx, &policy))
+	checkPolicy(EnabledState, 2)
+	col = mgr.handler.collections.c[collectionKey{name: policy.Name}]
+	assert.Same(t, sensor, col.sensors[0])
+	assert.True(t, sensor.IsLoaded())
+
+	// disabled policies are updated without loading the new version
+	require.NoError(t, mgr.DisableTracingPolicy(ctx, policy.Name, ""))
+	require.NoError(t, mgr.UpdateTracingPolicy(ctx, &policy))
+	checkPolicy(DisabledState, 3)
+	col = mgr.handler.collections.c[collectionKey{name: policy.Name}]
+	assert.False(t, col.sensors[0].IsLoaded())
+	assert.Equal(t, uint64(3), col.pinGeneration)
+	assert.True(t, sensor.(*Sensor).Destroyed)
+
+	loadFail = false
+	require.NoError(t, mgr.UpdateTracingPolicy(ctx, &policy))
+	require.NoError(t, mgr.EnableTracingPolicy(ctx, policy.Name, ""))
+	checkPolicy(EnabledState, 4)
+	require.NoError(t, mgr.DeleteTracingPolicy(ctx, policy.Name, ""))
+}
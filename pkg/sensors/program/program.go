@@ -227,6 +227,22 @@ func (p *Program) Unload(unpin bool) error {
 	return nil
 }
 
+// UpdateMaps loads the values of mapLoads in the maps of the loaded program,
+// as done when it was loaded, so that its configuration can be updated
+// without reloading it.
+func (p *Program) UpdateMaps(mapLoads []*MapLoad) error {
+	for _, mapLoad := range mapLoads {
+		pm, ok := p.PinMap[mapLoad.Name]
+		if !ok || pm.MapHandle == nil {
+			return fmt.Errorf("updating map failed as map '%s' is not loaded", mapLoad.Name)
+		}
+		if err := mapLoad.Load(pm.MapHandle, pm.PinPath); err != nil {
+			return fmt.Errorf("map update for %s failed: %w", mapLoad.Name, err)
+		}
+	}
+	return nil
+}
+
 func (p *Program) Unlink() error {
 	ul, ok := p.unloader.(interface{ Unlink() error })
 	if !ok {
@@ -46,6 +46,9 @@ type Sensor struct {
 	Namespace string
 	// Policy name the sensor is part of.
 	Policy string
+	// Generation of the policy the sensor was loaded for, which determines
+	// where its BPF objects are pinned (see tracingpolicy.PolicyGenerationDir).
+	Generation uint64
 	// When loaded this contains bpffs root directory
 	BpfDir string
 	// Progs are all the BPF programs that exist on the filesystem.
@@ -74,6 +77,21 @@ type Sensor struct {
 	DestroyHook SensorHook
 }
 
+func (s *Sensor) AddPostLoadHook(hook SensorHook) {
+	if s.PostLoadHook == nil {
+		s.PostLoadHook = hook
+		return
+	}
+
+	oldLoadHook := s.PostLoadHook
+	s.PostLoadHook = func() error {
+		if err := oldLoadHook(); err != nil {
+			return err
+		}
+		return hook()
+	}
+}
+
 func (s *Sensor) AddPostUnloadHook(hook SensorHook) {
 	if s.PostUnloadHook == nil {
 		s.PostUnloadHook = hook
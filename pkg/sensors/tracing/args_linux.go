@@ -29,6 +29,7 @@ type argPrinter struct {
 	maxData  bool
 	label    string
 	data     bool
+	omit     bool
 }
 
 const (
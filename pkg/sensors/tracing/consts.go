@@ -15,6 +15,8 @@ const (
 	// much kernel memory when enabled.
 	stackTraceMapMaxEntries    = 32768
 	ratelimitMapMaxEntries     = 32768
+	thresholdMapMaxEntries     = 32768
+	stateMapMaxEntries         = 32768
 	fdInstallMapMaxEntries     = 32000
 	enforcerMapMaxEntries      = 32768
 	overrideMapMaxEntries      = 32768
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cilium/ebpf"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/grpc/tracing"
+	"github.com/cilium/tetragon/pkg/idtable"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+const (
+	keyEventBudget           = "event-budget"
+	keyEventBudgetMode       = "event-budget-mode"
+	keyEventBudgetSampleRate = "event-budget-sample-rate"
+
+	valBudgetCount  = "count"
+	valBudgetSample = "sample"
+
+	defaultEventBudgetSampleRate = 100
+
+	eventBudgetMapName = "tg_budget_map"
+)
+
+type eventBudgetMode uint32
+
+// NB: values below should match the ones in bpf/process/event_budget.h
+const (
+	eventBudgetModeCount  eventBudgetMode = 0
+	eventBudgetModeSample eventBudgetMode = 1
+
+	eventBudgetThrottleStart = 1
+	eventBudgetThrottleStop  = 2
+)
+
+func eventBudgetModeParse(s string) (eventBudgetMode, error) {
+	switch s {
+	case valBudgetCount:
+		return eventBudgetModeCount, nil
+	case valBudgetSample:
+		return eventBudgetModeSample, nil
+	default:
+		return 0, fmt.Errorf("invalid event budget mode: '%s'", s)
+	}
+}
+
+// eventBudgetConf is the value of the tg_budget_map, see struct event_budget
+// in bpf/process/event_budget.h. The agent only sets the options, the rest is
+// the BPF side state.
+type eventBudgetConf struct {
+	Events    uint64
+	Interval  uint64
+	Mode      uint32
+	Sample    uint32
+	Curr      uint64
+	Prev      uint64
+	Time      uint64
+	Throttled uint64
+	Over      uint64
+}
+
+// eventBudget is the events per second budget of a policy. Once the policy
+// events go over the budget, the BPF programs of the policy stop posting them
+// (count mode) or only post 1 out of every sampleRate events (sample mode),
+// and notify the agent with throttle events.
+type eventBudget struct {
+	policyName      string
+	policyNamespace string
+	events          uint64
+	mode            eventBudgetMode
+	sampleRate      uint32
+}
+
+func newEventBudget(namespace, name string, spec *v1alpha1.TracingPolicySpec, opts *specOptions) (*eventBudget, error) {
+	if opts.eventBudget == 0 {
+		return nil, nil
+	}
+	if len(spec.KProbes) == 0 && len(spec.Tracepoints) == 0 ||
+		len(spec.UProbes) != 0 || len(spec.LsmHooks) != 0 || len(spec.Usdts) != 0 {
+		return nil, errors.New("event budget is only supported in policies with kprobes or tracepoints")
+	}
+	if !config.EnableLargeProgs() {
+		return nil, errors.New("event budget requires kernel >=5.3")
+	}
+	return &eventBudget{
+		policyName:      name,
+		policyNamespace: namespace,
+		events:          opts.eventBudget,
+		mode:            opts.eventBudgetMode,
+		sampleRate:      opts.eventBudgetSampleRate,
+	}, nil
+}
+
+func (b *eventBudget) conf() *eventBudgetConf {
+	return &eventBudgetConf{
+		Events:   b.events,
+		Interval: uint64(time.Second),
+		Mode:     uint32(b.mode),
+		Sample:   b.sampleRate,
+	}
+}
+
+func (pi *policyInfo) policyBudgetMap(prog *program.Program) *program.Map {
+	if pi.policyBudget != nil {
+		return program.MapUserFrom(pi.policyBudget)
+	}
+	pi.policyBudget = program.MapBuilderPolicy(eventBudgetMapName, prog)
+	if pi.budget != nil {
+		prog.MapLoad = append(prog.MapLoad, &program.MapLoad{
+			Name: eventBudgetMapName,
+			Load: func(m *ebpf.Map, _ string) error {
+				key := uint32(0)
+				return m.Update(key, pi.budget.conf(), ebpf.UpdateAny)
+			},
+		})
+	}
+	return pi.policyBudget
+}
+
+// budgetMaps returns the event budget map of the policy. The map is created
+// even if the policy has no budget, so that all the programs of the policy
+// share it instead of each getting its own anonymous map. Its zero value
+// disables the budget.
+func budgetMaps(prog *program.Program, polInfo *policyInfo) []*program.Map {
+	// tg_budget_map only exists in the large programs
+	if !config.EnableLargeProgs() {
+		return nil
+	}
+	return []*program.Map{polInfo.policyBudgetMap(prog)}
+}
+
+// checkEventBudgetUpdate returns ErrNotUpdatable if the event budget of a
+// policy changed. The budget map also holds the state of the budget, such as
+// whether the policy is throttled, so it cannot be rewritten by an in-place
+// update, and the hooks would otherwise be left with a budget that their
+// policy no longer has.
+func checkEventBudgetUpdate(oldBudget, newBudget *eventBudget) error {
+	if oldBudget == nil && newBudget == nil {
+		return nil
+	}
+	if oldBudget == nil || newBudget == nil ||
+		oldBudget.events != newBudget.events ||
+		oldBudget.mode != newBudget.mode ||
+		oldBudget.sampleRate != newBudget.sampleRate {
+		return fmt.Errorf("%w: event budget changed", sensors.ErrNotUpdatable)
+	}
+	return nil
+}
+
+// withoutEventBudgetLoad returns loads without the load of the event budget
+// map, which keeps its value and state when a policy is updated in place.
+func withoutEventBudgetLoad(loads []*program.MapLoad) []*program.MapLoad {
+	return slices.DeleteFunc(slices.Clone(loads), func(l *program.MapLoad) bool {
+		return l.Name == eventBudgetMapName
+	})
+}
+
+func (b *eventBudget) throttleEvent(m *tracingapi.MsgPolicyThrottle) *tracing.MsgProcessThrottleUnix {
+	var ty tetragon.ThrottleType
+	switch m.Type {
+	case eventBudgetThrottleStart:
+		ty = tetragon.ThrottleType_THROTTLE_START
+	case eventBudgetThrottleStop:
+		ty = tetragon.ThrottleType_THROTTLE_STOP
+	default:
+		ty = tetragon.ThrottleType_THROTTLE_UNKNOWN
+	}
+	return &tracing.MsgProcessThrottleUnix{
+		Type:            ty,
+		PolicyName:      b.policyName,
+		PolicyNamespace: b.policyNamespace,
+		Ktime:           m.Common.Ktime,
+	}
+}
+
+func handlePolicyThrottle(r *bytes.Reader) ([]observer.Event, error) {
+	m := tracingapi.MsgPolicyThrottle{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy throttle msg: %w", err)
+	}
+
+	var budget *eventBudget
+	id := idtable.EntryID{ID: int(m.FuncId)}
+	switch ops.OpCode(m.HookOp) {
+	case ops.MSG_OP_GENERIC_KPROBE:
+		gk, err := genericKprobeTableGet(id)
+		if err != nil {
+			return nil, err
+		}
+		budget = gk.budget
+	case ops.MSG_OP_GENERIC_TRACEPOINT:
+		tp, err := genericTracepointTableGet(id)
+		if err != nil {
+			return nil, err
+		}
+		budget = tp.budget
+	}
+
+	if budget == nil {
+		logger.GetLogger().Warn("policy throttle event for a hook without event budget",
+			"op", m.HookOp, "id", m.FuncId)
+		return nil, nil
+	}
+	return []observer.Event{budget.throttleEvent(&m)}, nil
+}
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_POLICY_THROTTLE, handlePolicyThrottle)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/grpc/tracing"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+func Test_eventBudgetOptions(t *testing.T) {
+	opts, err := getSpecOptions(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), opts.eventBudget)
+	assert.Equal(t, eventBudgetModeCount, opts.eventBudgetMode)
+	assert.Equal(t, uint32(defaultEventBudgetSampleRate), opts.eventBudgetSampleRate)
+
+	opts, err = getSpecOptions([]v1alpha1.OptionSpec{
+		{Name: keyEventBudget, Value: "1000"},
+		{Name: keyEventBudgetMode, Value: "sample"},
+		{Name: keyEventBudgetSampleRate, Value: "10"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), opts.eventBudget)
+	assert.Equal(t, eventBudgetModeSample, opts.eventBudgetMode)
+	assert.Equal(t, uint32(10), opts.eventBudgetSampleRate)
+
+	for _, opt := range []v1alpha1.OptionSpec{
+		{Name: keyEventBudget, Value: "-1"},
+		{Name: keyEventBudgetMode, Value: "drop"},
+		{Name: keyEventBudgetSampleRate, Value: "0"},
+	} {
+		_, err = getSpecOptions([]v1alpha1.OptionSpec{opt})
+		require.Error(t, err, opt)
+	}
+}
+
+func Test_newEventBudget(t *testing.T) {
+	budgetOpts := []v1alpha1.OptionSpec{{Name: keyEventBudget, Value: "100"}}
+
+	spec := &v1alpha1.TracingPolicySpec{
+		KProbes: []v1alpha1.KProbeSpec{{Call: "sys_read", Syscall: true}},
+	}
+	pi, err := newPolicyInfoFromSpec("", "test", policyfilter.NoFilterID, spec, nil)
+	require.NoError(t, err)
+	assert.Nil(t, pi.budget)
+
+	spec.Options = budgetOpts
+	pi, err = newPolicyInfoFromSpec("ns", "test", policyfilter.NoFilterID, spec, nil)
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	require.NotNil(t, pi.budget)
+	assert.Equal(t, &eventBudgetConf{
+		Events:   100,
+		Interval: 1_000_000_000,
+		Mode:     uint32(eventBudgetModeCount),
+		Sample:   defaultEventBudgetSampleRate,
+	}, pi.budget.conf())
+
+	spec = &v1alpha1.TracingPolicySpec{
+		Options:  budgetOpts,
+		LsmHooks: []v1alpha1.LsmHookSpec{{Hook: "file_open"}},
+	}
+	_, err = newPolicyInfoFromSpec("", "test", policyfilter.NoFilterID, spec, nil)
+	require.Error(t, err)
+}
+
+func Test_budgetMaps(t *testing.T) {
+	if !config.EnableLargeProgs() {
+		t.Skip("event budget requires large programs")
+	}
+	newProg := func(label string) *program.Program {
+		return program.Builder("bpf_generic_kprobe_v53.o", "sys_read", label, label, "generic_kprobe")
+	}
+
+	// the map is shared by the programs of a policy without budget, but not loaded
+	pi := &policyInfo{}
+	p0, p1 := newProg("kprobe/0"), newProg("kprobe/1")
+	m0, m1 := budgetMaps(p0, pi), budgetMaps(p1, pi)
+	require.Len(t, m0, 1)
+	require.Len(t, m1, 1)
+	assert.True(t, m0[0].IsOwner())
+	assert.False(t, m1[0].IsOwner())
+	assert.Equal(t, m0[0].PinPath, m1[0].PinPath)
+	assert.Empty(t, p0.MapLoad)
+
+	pi = &policyInfo{budget: &eventBudget{events: 10}}
+	p0 = newProg("kprobe/0")
+	require.Len(t, budgetMaps(p0, pi), 1)
+	require.Len(t, p0.MapLoad, 1)
+	assert.Equal(t, eventBudgetMapName, p0.MapLoad[0].Name)
+}
+
+func Test_handlePolicyThrottle(t *testing.T) {
+	budget := &eventBudget{policyName: "budget", policyNamespace: "ns", events: 10}
+	tp := &genericTracepoint{budget: budget}
+	genericTracepointTable.AddEntry(tp)
+	defer genericTracepointTable.RemoveEntry(tp.tableId)
+
+	msg := func(ty uint32) *bytes.Reader {
+		var buf bytes.Buffer
+		m := tracingapi.MsgPolicyThrottle{
+			Common: processapi.MsgCommon{Op: ops.MSG_OP_POLICY_THROTTLE, Ktime: 42},
+			FuncId: uint64(tp.tableId.ID),
+			HookOp: ops.MSG_OP_GENERIC_TRACEPOINT,
+			Type:   ty,
+		}
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	evs, err := handlePolicyThrottle(msg(eventBudgetThrottleStart))
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, &tracing.MsgProcessThrottleUnix{
+		Type:            tetragon.ThrottleType_THROTTLE_START,
+		PolicyName:      "budget",
+		PolicyNamespace: "ns",
+		Ktime:           42,
+	}, evs[0])
+
+	evs, err = handlePolicyThrottle(msg(eventBudgetThrottleStop))
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, tetragon.ThrottleType_THROTTLE_STOP, evs[0].(*tracing.MsgProcessThrottleUnix).Type)
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/cilium/tetragon/pkg/ksyms"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/metrics/kprobemetrics"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
@@ -47,6 +48,7 @@ import (
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
 	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/cilium/tetragon/pkg/sequences"
 
 	gt "github.com/cilium/tetragon/pkg/generictypes"
 )
@@ -114,6 +116,9 @@ type genericKprobe struct {
 	// tags field of the Tracing Policy
 	tags []string
 
+	// policy metrics updated by the kprobe
+	metrics *hookmetrics.Hook
+
 	// is there override defined for the kprobe
 	hasOverride bool
 
@@ -128,6 +133,9 @@ type genericKprobe struct {
 	hasStackTrace bool
 
 	customHandler eventhandler.Handler
+
+	// event budget of the policy, nil if the policy has none
+	budget *eventBudget
 }
 
 // pendingEvent is an event waiting to be merged with another event.
@@ -246,9 +254,47 @@ func filterMaps(load *program.Program, kprobeEntry *genericKprobe) []*program.Ma
 	}
 	maps = append(maps, stringPostfixFilterMaps)
 
+	stringPatternFilterMaps := program.MapBuilderProgram("string_pattern_maps", load)
+	if state != nil && !kernels.MinKernelVersion("5.9") {
+		// Versions before 5.9 do not allow inner maps to have different sizes.
+		// See: https://lore.kernel.org/bpf/20200828011800.1970018-1-kafai@fb.com/
+		maxEntries := state.StringPatternMapsMaxEntries()
+		stringPatternFilterMaps.SetInnerMaxEntries(maxEntries)
+	}
+	maps = append(maps, stringPatternFilterMaps)
+
 	return maps
 }
 
+// stateMaps returns the maps used by the matchState selectors and SetState actions of the
+// program. The policy-wide tg_state_map is only expanded if the policy has sequences.
+func stateMaps(load *program.Program, polInfo *policyInfo) []*program.Map {
+	selStateMap := program.MapBuilderProgram("tg_state_sel_opts", load)
+	return []*program.Map{selStateMap, polInfo.policyStateMap(load)}
+}
+
+// thresholdMaps returns the maps used by the matchThreshold selectors of the program. The
+// sensor-wide threshold_map is only expanded if some selector has a matchThreshold.
+func thresholdMaps(load *program.Program, hasThreshold bool) []*program.Map {
+	selThresholdMap := program.MapBuilderProgram("tg_threshold_sel_opts", load)
+	thresholdMap := program.MapBuilderSensor("threshold_map", load)
+	if hasThreshold {
+		thresholdMap.SetMaxEntries(thresholdMapMaxEntries)
+	}
+	return []*program.Map{selThresholdMap, thresholdMap}
+}
+
+// retSelectorMaps returns the maps of the selector options of a return probe program. They are
+// owned by the program, like the ones of the entry probe, so that they can be updated in place.
+func retSelectorMaps(loadret *program.Program) []*program.Map {
+	return []*program.Map{
+		program.MapBuilderProgram("tg_mb_sel_opts", loadret),
+		program.MapBuilderProgram("tg_threshold_sel_opts", loadret),
+		program.MapBuilderProgram("tg_state_sel_opts", loadret),
+		program.MapBuilderProgram(selectorSlotMapName, loadret),
+	}
+}
+
 func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, has hasMaps) ([]*program.Program, []*program.Map, error) {
 	var multiRetIDs []idtable.EntryID
 	var progs []*program.Program
@@ -296,6 +342,9 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 	filterMap := program.MapBuilderProgram("filter_map", load)
 	maps = append(maps, filterMap)
 
+	selSlotMap := program.MapBuilderProgram(selectorSlotMapName, load)
+	maps = append(maps, selSlotMap)
+
 	maps = append(maps, filterMaps(load, nil)...)
 
 	retProbe := program.MapBuilderSensor("retprobe_map", load)
@@ -310,6 +359,9 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 	matchBinariesPaths := program.MapBuilderProgram("tg_mb_paths", load)
 	maps = append(maps, matchBinariesPaths)
 
+	maps = append(maps, thresholdMaps(load, has.threshold)...)
+	maps = append(maps, stateMaps(load, polInfo)...)
+
 	stackTraceMap := program.MapBuilderProgram("stack_trace_map", load)
 	if has.stackTrace {
 		stackTraceMap.SetMaxEntries(stackTraceMapMaxEntries)
@@ -342,8 +394,8 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 		maps = append(maps, program.MapUser(cgtracker.MapName, load))
 	}
 
-	filterMap.SetMaxEntries(len(multiIDs))
-	configMap.SetMaxEntries(len(multiIDs))
+	filterMap.SetMaxEntries(len(multiIDs) * selectorSlots)
+	configMap.SetMaxEntries(len(multiIDs) * selectorSlots)
 
 	overrideTasksMap := program.MapBuilderProgram("override_tasks", load)
 	if has.override {
@@ -352,6 +404,7 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 	maps = append(maps, overrideTasksMap)
 
 	maps = append(maps, polInfo.policyConfMap(load), polInfo.policyStatsMap(load))
+	maps = append(maps, budgetMaps(load, polInfo)...)
 
 	if len(multiRetIDs) != 0 {
 		loadret := program.Builder(
@@ -376,6 +429,10 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 
 		maps = append(maps, filterMaps(loadret, nil)...)
 
+		matchBinariesPaths := program.MapBuilderProgram("tg_mb_paths", loadret)
+		maps = append(maps, matchBinariesPaths)
+		maps = append(maps, retSelectorMaps(loadret)...)
+
 		callHeap := program.MapBuilderSensor("process_call_heap", loadret)
 		maps = append(maps, callHeap)
 
@@ -394,8 +451,8 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 		tailCalls := program.MapBuilderProgram("retkprobe_calls", loadret)
 		maps = append(maps, tailCalls)
 
-		retConfigMap.SetMaxEntries(len(multiRetIDs))
-		retFilterMap.SetMaxEntries(len(multiRetIDs))
+		retConfigMap.SetMaxEntries(len(multiRetIDs) * selectorSlots)
+		retFilterMap.SetMaxEntries(len(multiRetIDs) * selectorSlots)
 	}
 
 	return progs, maps, nil
@@ -587,11 +644,15 @@ type addKprobeIn struct {
 	policyID      policyfilter.PolicyID
 	customHandler eventhandler.Handler
 	selMaps       *selectors.KernelSelectorMaps
+	metrics       *hookmetrics.Policy
+	sequences     *sequences.Policy
+	budget        *eventBudget
 }
 
 type hasMaps struct {
 	stackTrace bool
 	rateLimit  bool
+	threshold  bool
 	fdInstall  bool
 	enforcer   bool
 	override   bool
@@ -606,6 +667,7 @@ func hasMapsSetup(spec *v1alpha1.TracingPolicySpec) hasMaps {
 		has.fdInstall = has.fdInstall || selectors.HasFDInstall(kprobe.Selectors)
 		has.enforcer = has.enforcer || len(spec.Enforcers) != 0
 		has.rateLimit = has.rateLimit || selectors.HasRateLimit(kprobe.Selectors)
+		has.threshold = has.threshold || selectors.HasThreshold(kprobe.Selectors)
 		has.sockTrack = has.sockTrack || selectors.HasSockTrack(&kprobe)
 		has.override = has.override || selectors.HasOverride(kprobe.Selectors)
 	}
@@ -656,6 +718,9 @@ func createGenericKprobeSensor(
 		policyName:    polInfo.name,
 		customHandler: polInfo.customHandler,
 		selMaps:       selMaps,
+		metrics:       polInfo.metrics,
+		sequences:     polInfo.sequences,
+		budget:        polInfo.budget,
 	}
 
 	dups := make(map[string]int)
@@ -737,6 +802,32 @@ func initEventConfig() *api.EventConfig {
 	}
 }
 
+// omitArgsMask returns the mask, by position in args, of the args whose index
+// is in omitArgs. These args are read and matched by the selectors, but the
+// BPF programs remove them from the events before sending them.
+func omitArgsMask(args []v1alpha1.KProbeArg, omitArgs []uint32) (uint32, error) {
+	if len(omitArgs) == 0 {
+		return 0, nil
+	}
+	if !config.EnableLargeProgs() {
+		return 0, errors.New("omitArgs requires kernel >=5.3")
+	}
+	mask := uint32(0)
+	for _, index := range omitArgs {
+		pos := slices.IndexFunc(args, func(a v1alpha1.KProbeArg) bool {
+			return a.Index == index
+		})
+		if pos == -1 {
+			return 0, fmt.Errorf("omitArgs: argument %d not found in the args", index)
+		}
+		if args[pos].ReturnCopy {
+			return 0, fmt.Errorf("omitArgs: argument %d has returnCopy set and cannot be omitted", index)
+		}
+		mask |= 1 << pos
+	}
+	return mask, nil
+}
+
 // addKprobe will, amongst other things, create a generic kprobe entry and add
 // it to the genericKprobeTable. The caller should make sure that this entry is
 // properly removed on kprobe removal.
@@ -757,6 +848,10 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 
 	eventConfig := initEventConfig()
 	eventConfig.PolicyID = uint32(in.policyID)
+	eventConfig.OmitArgs, err = omitArgsMask(f.Args, f.OmitArgs)
+	if err != nil {
+		return errFn(err)
+	}
 	if len(f.ReturnArgAction) > 0 {
 		if !config.EnableLargeProgs() {
 			return errFn(errors.New("ReturnArgAction requires kernel >=5.3"))
@@ -861,6 +956,7 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 			maxData:  a.MaxData,
 			label:    a.Label,
 			data:     data,
+			omit:     eventConfig.OmitArgs&(1<<j) != 0,
 		}
 		argSigPrinters = append(argSigPrinters, argP)
 
@@ -970,7 +1066,9 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 		customHandler:     in.customHandler,
 		message:           msgField,
 		tags:              tagsField,
+		metrics:           in.metrics.Kprobe(f.Call),
 		hasStackTrace:     selectors.HasStackTrace(f.Selectors),
+		budget:            in.budget,
 	}
 
 	// Parse Filters into kernel filter logic
@@ -980,6 +1078,7 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 		Data:           f.Data,
 		ActionArgTable: &kprobeEntry.actionArgs,
 		Maps:           in.selMaps,
+		Sequences:      in.sequences,
 	})
 	if err != nil {
 		return errFn(err)
@@ -1047,6 +1146,9 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 	filterMap := program.MapBuilderProgram("filter_map", load)
 	maps = append(maps, filterMap)
 
+	selSlotMap := program.MapBuilderProgram(selectorSlotMapName, load)
+	maps = append(maps, selSlotMap)
+
 	maps = append(maps, filterMaps(load, kprobeEntry)...)
 
 	retProbe := program.MapBuilderSensor("retprobe_map", load)
@@ -1066,6 +1168,9 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 	}
 	maps = append(maps, matchBinariesPaths)
 
+	maps = append(maps, thresholdMaps(load, has.threshold)...)
+	maps = append(maps, stateMaps(load, polInfo)...)
+
 	// loading the stack trace map in any case so that it does not end up as an
 	// anonymous map (as it's always used by the BPF prog) and is clearly linked
 	// to tetragon
@@ -1111,6 +1216,7 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 	maps = append(maps, overrideTasksMap)
 
 	maps = append(maps, polInfo.policyConfMap(load), polInfo.policyStatsMap(load))
+	maps = append(maps, budgetMaps(load, polInfo)...)
 
 	if kprobeEntry.loadArgs.retprobe {
 		pinRetProg := sensors.PathJoin(kprobeEntry.funcName + "_return")
@@ -1142,6 +1248,15 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 
 		maps = append(maps, filterMaps(loadret, kprobeEntry)...)
 
+		retMatchBinariesPaths := program.MapBuilderProgram("tg_mb_paths", loadret)
+		if !kernels.MinKernelVersion("5.9") {
+			// Versions before 5.9 do not allow inner maps to have different sizes.
+			// See: https://lore.kernel.org/bpf/20200828011800.1970018-1-kafai@fb.com/
+			retMatchBinariesPaths.SetInnerMaxEntries(kprobeEntry.loadArgs.selectors.retrn.MatchBinariesPathsMaxEntries())
+		}
+		maps = append(maps, retMatchBinariesPaths)
+		maps = append(maps, retSelectorMaps(loadret)...)
+
 		// add maps with non-default paths (pins) to the retprobe
 		callHeap := program.MapBuilderSensor("process_call_heap", loadret)
 		maps = append(maps, callHeap)
@@ -1187,31 +1302,35 @@ func createSingleKprobeSensor(polInfo *policyInfo, ids []idtable.EntryID, has ha
 	return progs, maps, nil
 }
 
-func getMapLoad(load *program.Program, kprobeEntry *genericKprobe, index uint32) []*program.MapLoad {
+func getMapLoad(load *program.Program, kprobeEntry *genericKprobe, index uint32, slot uint32) []*program.MapLoad {
 	state := getProgramSelector(load, kprobeEntry)
 	if state == nil {
 		return []*program.MapLoad{}
 	}
-	return selectorsMaploads(state, index)
+	return selectorsMaploads(state, index, slot)
 }
 
-func loadSingleKprobeSensor(id idtable.EntryID, bpfDir string, load *program.Program, maps []*program.Map, verbose int) error {
-	gk, err := genericKprobeTableGet(id)
-	if err != nil {
-		return err
-	}
-
-	load.MapLoad = append(load.MapLoad, getMapLoad(load, gk, 0)...)
-
+// kprobeMapLoads returns the map loads of the kprobe at the given index in the
+// program: its selectors and config, written to the given selector slot.
+func kprobeMapLoads(load *program.Program, gk *genericKprobe, index uint32, slot uint32) []*program.MapLoad {
 	var configData bytes.Buffer
 	binary.Write(&configData, binary.LittleEndian, gk.loadArgs.config)
 	config := &program.MapLoad{
 		Name: "config_map",
 		Load: func(m *ebpf.Map, _ string) error {
-			return m.Update(uint32(0), configData.Bytes()[:], ebpf.UpdateAny)
+			return m.Update(index*selectorSlots+slot, configData.Bytes()[:], ebpf.UpdateAny)
 		},
 	}
-	load.MapLoad = append(load.MapLoad, config)
+	return append(getMapLoad(load, gk, index, slot), config)
+}
+
+func loadSingleKprobeSensor(id idtable.EntryID, bpfDir string, load *program.Program, maps []*program.Map, verbose int) error {
+	gk, err := genericKprobeTableGet(id)
+	if err != nil {
+		return err
+	}
+
+	load.MapLoad = append(load.MapLoad, kprobeMapLoads(load, gk, 0, 0)...)
 
 	if err := program.LoadKprobeProgram(bpfDir, load, maps, verbose); err == nil {
 		logger.GetLogger().Info(fmt.Sprintf("Loaded generic kprobe program: %s -> %s", load.Name, load.Attach))
@@ -1223,8 +1342,6 @@ func loadSingleKprobeSensor(id idtable.EntryID, bpfDir string, load *program.Pro
 }
 
 func loadMultiKprobeSensor(ids []idtable.EntryID, bpfDir string, load *program.Program, maps []*program.Map, verbose int) error {
-	binBuf := make([]bytes.Buffer, len(ids))
-
 	data := &program.MultiKprobeAttachData{}
 
 	for index, id := range ids {
@@ -1233,16 +1350,7 @@ func loadMultiKprobeSensor(ids []idtable.EntryID, bpfDir string, load *program.P
 			return err
 		}
 
-		load.MapLoad = append(load.MapLoad, getMapLoad(load, gk, uint32(index))...)
-
-		binary.Write(&binBuf[index], binary.LittleEndian, gk.loadArgs.config)
-		config := &program.MapLoad{
-			Name: "config_map",
-			Load: func(m *ebpf.Map, _ string) error {
-				return m.Update(uint32(index), binBuf[index].Bytes()[:], ebpf.UpdateAny)
-			},
-		}
-		load.MapLoad = append(load.MapLoad, config)
+		load.MapLoad = append(load.MapLoad, kprobeMapLoads(load, gk, uint32(index), 0)...)
 
 		data.Symbols = append(data.Symbols, gk.funcName)
 		data.Cookies = append(data.Cookies, uint64(index))
@@ -1314,6 +1422,24 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 	return ret, err
 }
 
+// sequenceStep returns the sequence step reached by an event with the SetState action.
+func sequenceStep(flags uint8, actionArgs *idtable.Table, actionArgID uint32) *sequences.Step {
+	if flags&processapi.MSG_COMMON_FLAG_SEQUENCE_STEP == 0 {
+		return nil
+	}
+	entry, err := actionArgs.GetEntry(idtable.EntryID{ID: int(actionArgID)})
+	if err != nil {
+		logger.GetLogger().Warn(fmt.Sprintf("Failed to find sequence step for id:%d", actionArgID), logfields.Error, err)
+		return nil
+	}
+	stateArg, ok := entry.(*selectors.StateArgEntry)
+	if !ok {
+		logger.GetLogger().Warn(fmt.Sprintf("Invalid sequence step for id:%d: %T", actionArgID, entry))
+		return nil
+	}
+	return stateArg.GetStep()
+}
+
 func handleMsgGenericKprobe(m *api.MsgGenericKprobe, gk *genericKprobe, r *bytes.Reader) ([]observer.Event, error) {
 	var err error
 
@@ -1341,6 +1467,8 @@ func handleMsgGenericKprobe(m *api.MsgGenericKprobe, gk *genericKprobe, r *bytes
 	unix.PolicyName = gk.policyName
 	unix.Message = gk.message
 	unix.Tags = gk.tags
+	unix.Metrics = gk.metrics
+	unix.Step = sequenceStep(m.Common.Flags, &gk.actionArgs, m.ActionArgId)
 
 	returnEvent := m.Common.Flags&processapi.MSG_COMMON_FLAG_RETURN != 0
 
@@ -1389,6 +1517,10 @@ func handleMsgGenericKprobe(m *api.MsgGenericKprobe, gk *genericKprobe, r *bytes
 
 	// Get argument objects for specific printers/types
 	for _, a := range printers {
+		// omitted args are removed from the event by the BPF program
+		if a.omit {
+			continue
+		}
 		arg := getArg(r, a)
 		// nop or unknown type (already logged)
 		if arg == nil {
@@ -1520,3 +1652,61 @@ func retprobeMergeEvents[T evArgsRetriever](unix T, pendingEvents *lru.Cache[pen
 func (k *observerKprobeSensor) LoadProbe(args sensors.LoadProbeArgs) error {
 	return loadGenericKprobeSensor(args.BPFDir, args.Load, args.Maps, args.Verbose)
 }
+
+func kprobeLoaderIDs(load *program.Program) []idtable.EntryID {
+	switch ids := load.LoaderData.(type) {
+	case idtable.EntryID:
+		return []idtable.EntryID{ids}
+	case []idtable.EntryID:
+		return ids
+	}
+	return nil
+}
+
+// UpdateProbe updates the selectors and config of a loaded kprobe program
+// with the ones of the program of a new version of the policy, which must
+// hook the same functions.
+func (k *observerKprobeSensor) UpdateProbe(args sensors.UpdateProbeArgs) error {
+	oldIDs, newIDs := kprobeLoaderIDs(args.Load), kprobeLoaderIDs(args.New)
+	if len(oldIDs) == 0 || len(oldIDs) != len(newIDs) {
+		return sensors.ErrNotUpdatable
+	}
+
+	pairs := make([][2]*genericKprobe, 0, len(oldIDs))
+	for i := range oldIDs {
+		oldGk, err := genericKprobeTableGet(oldIDs[i])
+		if err != nil {
+			return err
+		}
+		newGk, err := genericKprobeTableGet(newIDs[i])
+		if err != nil {
+			return err
+		}
+		if oldGk.funcName != newGk.funcName || oldGk.hasOverride != newGk.hasOverride ||
+			oldGk.loadArgs.retprobe != newGk.loadArgs.retprobe {
+			return fmt.Errorf("%w: kprobe %s changed", sensors.ErrNotUpdatable, oldGk.funcName)
+		}
+		if err := checkEventBudgetUpdate(oldGk.budget, newGk.budget); err != nil {
+			return err
+		}
+		pairs = append(pairs, [2]*genericKprobe{oldGk, newGk})
+	}
+
+	err := updateSelectors(args.Load, func(slot uint32) ([]*program.MapLoad, error) {
+		mapLoads := withoutEventBudgetLoad(args.New.MapLoad)
+		for index, pair := range pairs {
+			mapLoads = append(mapLoads, kprobeMapLoads(args.New, pair[1], uint32(index), slot)...)
+		}
+		return mapLoads, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the stack traces of the events are still read from the maps of the
+	// loaded program
+	for _, pair := range pairs {
+		pair[1].data = pair[0].data
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/bpf"
+	"github.com/cilium/tetragon/pkg/config"
 	"github.com/cilium/tetragon/pkg/idtable"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/policyfilter"
@@ -373,3 +374,28 @@ func Test_validateOverride(t *testing.T) {
 		})
 	}
 }
+
+func Test_omitArgsMask(t *testing.T) {
+	args := []v1alpha1.KProbeArg{
+		{Index: 0, Type: "int"},
+		{Index: 2, Type: "file"},
+		{Index: 1, Type: "char_buf", ReturnCopy: true},
+	}
+
+	mask, err := omitArgsMask(args, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), mask)
+
+	mask, err = omitArgsMask(args, []uint32{2})
+	if !config.EnableLargeProgs() {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0b10), mask)
+
+	_, err = omitArgsMask(args, []uint32{3})
+	require.ErrorContains(t, err, "argument 3 not found")
+	_, err = omitArgsMask(args, []uint32{1})
+	require.ErrorContains(t, err, "returnCopy")
+}
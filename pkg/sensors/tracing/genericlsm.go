@@ -94,7 +94,7 @@ func (k *observerLsmSensor) LoadProbe(args sensors.LoadProbeArgs) error {
 		if err != nil {
 			return err
 		}
-		args.Load.MapLoad = append(args.Load.MapLoad, selectorsMaploads(gl.selectors, 0)...)
+		args.Load.MapLoad = append(args.Load.MapLoad, selectorsMaploads(gl.selectors, 0, 0)...)
 		var configData bytes.Buffer
 		binary.Write(&configData, binary.LittleEndian, gl.config)
 		config := &program.MapLoad{
@@ -529,6 +529,8 @@ func createLsmSensorFromEntry(polInfo *policyInfo, lsmEntry *genericLsm,
 	}
 	maps = append(maps, matchBinariesPaths)
 
+	maps = append(maps, thresholdMaps(load, len(lsmEntry.selectors.Thresholds()) != 0)...)
+
 	overrideTasksMap := program.MapBuilderProgram("override_tasks", load)
 	maps = append(maps, overrideTasksMap)
 	overrideTasksMapOutput := program.MapBuilderProgram("override_tasks", loadOutput)
@@ -610,5 +612,14 @@ func filterMapsForLsm(load *program.Program, lsmEntry *genericLsm) []*program.Ma
 	}
 	maps = append(maps, stringPostfixFilterMaps)
 
+	stringPatternFilterMaps := program.MapBuilderProgram("string_pattern_maps", load)
+	if !kernels.MinKernelVersion("5.9") {
+		// Versions before 5.9 do not allow inner maps to have different sizes.
+		// See: https://lore.kernel.org/bpf/20200828011800.1970018-1-kafai@fb.com/
+		maxEntries := lsmEntry.selectors.StringPatternMapsMaxEntries()
+		stringPatternFilterMaps.SetInnerMaxEntries(maxEntries)
+	}
+	maps = append(maps, stringPatternFilterMaps)
+
 	return maps
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/metrics/enforcermetrics"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
@@ -36,6 +37,7 @@ import (
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
 	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/cilium/tetragon/pkg/sequences"
 	"github.com/cilium/tetragon/pkg/syscallinfo"
 	"github.com/cilium/tetragon/pkg/tracepoint"
 
@@ -90,12 +92,24 @@ type genericTracepoint struct {
 	// tags field of the Tracing Policy
 	tags []string
 
+	// policy metrics updated by the tracepoint
+	metrics *hookmetrics.Hook
+
+	// sequences of the policy
+	sequences *sequences.Policy
+
+	// mask of the args omitted from the events
+	omitArgs uint32
+
 	// parsed kernel selector state
 	selectors *selectors.KernelSelectorState
 
 	// custom event handler
 	customHandler eventhandler.Handler
 
+	// event budget of the policy, nil if the policy has none
+	budget *eventBudget
+
 	// is raw tracepoint
 	raw bool
 }
@@ -394,6 +408,13 @@ func createGenericTracepoint(
 		return nil, err
 	}
 
+	// NB: the spec args are the first ones in tpArgs, so positions in
+	// conf.Args are also config indexes.
+	omitArgs, err := omitArgsMask(conf.Args, conf.OmitArgs)
+	if err != nil {
+		return nil, err
+	}
+
 	ret := &genericTracepoint{
 		tableId:       idtable.UninitializedEntryID,
 		Info:          &tp,
@@ -404,7 +425,11 @@ func createGenericTracepoint(
 		customHandler: polInfo.customHandler,
 		message:       msgField,
 		tags:          tagsField,
+		metrics:       polInfo.metrics.Tracepoint(conf.Subsystem, conf.Event),
+		sequences:     polInfo.sequences,
+		omitArgs:      omitArgs,
 		raw:           conf.Raw,
+		budget:        polInfo.budget,
 	}
 
 	genericTracepointTable.AddEntry(ret)
@@ -545,6 +570,12 @@ func createGenericTracepointSensor(
 		filterMap := program.MapBuilderProgram("filter_map", prog0)
 		maps = append(maps, filterMap)
 
+		configMap := program.MapBuilderProgram("config_map", prog0)
+		maps = append(maps, configMap)
+
+		selSlotMap := program.MapBuilderProgram(selectorSlotMapName, prog0)
+		maps = append(maps, selSlotMap)
+
 		argFilterMaps := program.MapBuilderProgram("argfilter_maps", prog0)
 		if !kernels.MinKernelVersion("5.9") {
 			// Versions before 5.9 do not allow inner maps to have different sizes.
@@ -605,6 +636,15 @@ func createGenericTracepointSensor(
 		}
 		maps = append(maps, stringPostfixFilterMaps)
 
+		stringPatternFilterMaps := program.MapBuilderProgram("string_pattern_maps", prog0)
+		if !kernels.MinKernelVersion("5.9") {
+			// Versions before 5.9 do not allow inner maps to have different sizes.
+			// See: https://lore.kernel.org/bpf/20200828011800.1970018-1-kafai@fb.com/
+			maxEntries := tp.selectors.StringPatternMapsMaxEntries()
+			stringPatternFilterMaps.SetInnerMaxEntries(maxEntries)
+		}
+		maps = append(maps, stringPatternFilterMaps)
+
 		matchBinariesPaths := program.MapBuilderProgram("tg_mb_paths", prog0)
 		if !kernels.MinKernelVersion("5.9") {
 			// Versions before 5.9 do not allow inner maps to have different sizes.
@@ -624,7 +664,11 @@ func createGenericTracepointSensor(
 		selMatchBinariesMap := program.MapBuilderProgram("tg_mb_sel_opts", prog0)
 		maps = append(maps, selMatchBinariesMap)
 
+		maps = append(maps, thresholdMaps(prog0, selectors.HasThreshold(tp.Spec.Selectors))...)
+		maps = append(maps, stateMaps(prog0, polInfo)...)
+
 		maps = append(maps, polInfo.policyConfMap(prog0), polInfo.policyStatsMap(prog0))
+		maps = append(maps, budgetMaps(prog0, polInfo)...)
 	}
 
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
@@ -696,6 +740,7 @@ func (tp *genericTracepoint) InitKernelSelectors(lists []v1alpha1.ListSpec) erro
 		Data:           []v1alpha1.KProbeArg{},
 		ActionArgTable: &tp.actionArgs,
 		ListReader:     &listReader{lists},
+		Sequences:      tp.sequences,
 	})
 	if err != nil {
 		return err
@@ -713,6 +758,7 @@ func (tp *genericTracepoint) EventConfig() (*tracingapi.EventConfig, error) {
 	config := initEventConfig()
 	config.PolicyID = uint32(tp.policyID)
 	config.FuncId = uint32(tp.tableId.ID)
+	config.OmitArgs = tp.omitArgs
 
 	if tp.raw {
 		return tp.eventConfigRaw(config)
@@ -749,6 +795,24 @@ func (tp *genericTracepoint) eventConfig(config *tracingapi.EventConfig) (*traci
 	return config, nil
 }
 
+// mapLoads returns the map loads of the tracepoint program: its selectors and
+// config, written to the given selector slot.
+func (tp *genericTracepoint) mapLoads(slot uint32) ([]*program.MapLoad, error) {
+	config, err := tp.EventConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate config data for generic tracepoint: %w", err)
+	}
+	var binBuf bytes.Buffer
+	binary.Write(&binBuf, binary.LittleEndian, *config)
+	cfg := &program.MapLoad{
+		Name: "config_map",
+		Load: func(m *ebpf.Map, _ string) error {
+			return m.Update(slot, binBuf.Bytes()[:], ebpf.UpdateAny)
+		},
+	}
+	return append(selectorsMaploads(tp.selectors, 0, slot), cfg), nil
+}
+
 func LoadGenericTracepointSensor(bpfDir string, load *program.Program, maps []*program.Map, verbose int) error {
 
 	tracepointLog = logger.GetLogger()
@@ -763,21 +827,11 @@ func LoadGenericTracepointSensor(bpfDir string, load *program.Program, maps []*p
 		return fmt.Errorf("could not find generic tracepoint information for %s: %w", load.Attach, err)
 	}
 
-	load.MapLoad = append(load.MapLoad, selectorsMaploads(tp.selectors, 0)...)
-
-	config, err := tp.EventConfig()
+	mapLoads, err := tp.mapLoads(0)
 	if err != nil {
-		return fmt.Errorf("failed to generate config data for generic tracepoint: %w", err)
-	}
-	var binBuf bytes.Buffer
-	binary.Write(&binBuf, binary.LittleEndian, *config)
-	cfg := &program.MapLoad{
-		Name: "config_map",
-		Load: func(m *ebpf.Map, _ string) error {
-			return m.Update(uint32(0), binBuf.Bytes()[:], ebpf.UpdateAny)
-		},
+		return err
 	}
-	load.MapLoad = append(load.MapLoad, cfg)
+	load.MapLoad = append(load.MapLoad, mapLoads...)
 
 	if tp.raw {
 		err = program.LoadRawTracepointProgram(bpfDir, load, maps, verbose)
@@ -845,12 +899,14 @@ func handleMsgGenericTracepoint(
 	unix.Subsys = tp.Info.Subsys
 	unix.Event = tp.Info.Event
 	unix.PolicyName = tp.policyName
+	unix.Metrics = tp.metrics
+	unix.Step = sequenceStep(m.Common.Flags, &tp.actionArgs, m.ActionArgId)
 	unix.Message = tp.message
 	unix.Tags = tp.tags
 
 	for idx, out := range tp.args {
 
-		if out.nopTy {
+		if out.nopTy || tp.omitArgs&(1<<idx) != 0 {
 			continue
 		}
 
@@ -1146,3 +1202,39 @@ func handleMsgGenericTracepoint(
 func (t *observerTracepointSensor) LoadProbe(args sensors.LoadProbeArgs) error {
 	return LoadGenericTracepointSensor(args.BPFDir, args.Load, args.Maps, args.Verbose)
 }
+
+// UpdateProbe updates the selectors and config of a loaded tracepoint program
+// with the ones of the program of a new version of the policy, which must
+// hook the same tracepoint.
+func (t *observerTracepointSensor) UpdateProbe(args sensors.UpdateProbeArgs) error {
+	oldID, ok := args.Load.LoaderData.(idtable.EntryID)
+	if !ok {
+		return sensors.ErrNotUpdatable
+	}
+	newID, ok := args.New.LoaderData.(idtable.EntryID)
+	if !ok {
+		return sensors.ErrNotUpdatable
+	}
+	oldTp, err := genericTracepointTableGet(oldID)
+	if err != nil {
+		return err
+	}
+	newTp, err := genericTracepointTableGet(newID)
+	if err != nil {
+		return err
+	}
+	if oldTp.Info.Subsys != newTp.Info.Subsys || oldTp.Info.Event != newTp.Info.Event || oldTp.raw != newTp.raw {
+		return fmt.Errorf("%w: tracepoint %s/%s changed", sensors.ErrNotUpdatable, oldTp.Info.Subsys, oldTp.Info.Event)
+	}
+	if err := checkEventBudgetUpdate(oldTp.budget, newTp.budget); err != nil {
+		return err
+	}
+
+	return updateSelectors(args.Load, func(slot uint32) ([]*program.MapLoad, error) {
+		mapLoads, err := newTp.mapLoads(slot)
+		if err != nil {
+			return nil, err
+		}
+		return append(withoutEventBudgetLoad(args.New.MapLoad), mapLoads...), nil
+	})
+}
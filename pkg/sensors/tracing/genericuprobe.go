@@ -876,6 +876,7 @@ func createUprobeSensorFromEntry(uprobeEntry *genericUprobe,
 	retProbe := program.MapBuilderSensor("retprobe_map", load)
 	selMatchBinariesMap := program.MapBuilderProgram("tg_mb_sel_opts", load)
 	maps = append(maps, configMap, tailCalls, filterMap, selMatchBinariesMap, retProbe)
+	maps = append(maps, thresholdMaps(load, len(uprobeEntry.loadArgs.selectors.entry.Thresholds()) != 0)...)
 
 	if has.sleepableOffload {
 		regsMap := program.MapBuilderProgram("regs_map", load)
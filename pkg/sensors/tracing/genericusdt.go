@@ -225,6 +225,7 @@ func createUsdtSensorFromEntry(usdtEntry *genericUsdt,
 
 	selMatchBinariesMap := program.MapBuilderProgram("tg_mb_sel_opts", load)
 	maps = append(maps, configMap, tailCalls, filterMap, selMatchBinariesMap)
+	maps = append(maps, thresholdMaps(load, len(usdtEntry.selectors.Thresholds()) != 0)...)
 
 	if hasSleepableOffload {
 		sleepableOffloadMap := program.MapBuilderProgram("write_offload", load)
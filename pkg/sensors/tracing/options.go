@@ -6,6 +6,7 @@
 package tracing
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -47,6 +48,10 @@ type specOptions struct {
 	DisableUprobeMulti bool
 	OverrideMethod     OverrideMethod
 	policyMode         policyconf.Mode
+
+	eventBudget           uint64
+	eventBudgetMode       eventBudgetMode
+	eventBudgetSampleRate uint32
 }
 
 type opt struct {
@@ -55,8 +60,9 @@ type opt struct {
 
 func newDefaultSpecOptions() *specOptions {
 	return &specOptions{
-		DisableKprobeMulti: false,
-		OverrideMethod:     OverrideMethodDefault,
+		DisableKprobeMulti:    false,
+		OverrideMethod:        OverrideMethodDefault,
+		eventBudgetSampleRate: defaultEventBudgetSampleRate,
 	}
 }
 
@@ -94,6 +100,31 @@ var opts = map[string]opt{
 			return nil
 		},
 	},
+	keyEventBudget: {
+		set: func(str string, options *specOptions) (err error) {
+			options.eventBudget, err = strconv.ParseUint(str, 10, 64)
+			return err
+		},
+	},
+	keyEventBudgetMode: {
+		set: func(str string, options *specOptions) (err error) {
+			options.eventBudgetMode, err = eventBudgetModeParse(str)
+			return err
+		},
+	},
+	keyEventBudgetSampleRate: {
+		set: func(str string, options *specOptions) error {
+			rate, err := strconv.ParseUint(str, 10, 32)
+			if err != nil {
+				return err
+			}
+			if rate == 0 {
+				return errors.New("sample rate must be at least 1")
+			}
+			options.eventBudgetSampleRate = uint32(rate)
+			return nil
+		},
+	},
 }
 
 func getSpecOptions(specs []v1alpha1.OptionSpec) (*specOptions, error) {
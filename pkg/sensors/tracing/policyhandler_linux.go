@@ -12,12 +12,14 @@ import (
 	"github.com/cilium/tetragon/pkg/eventhandler"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
 	"github.com/cilium/tetragon/pkg/policyconf"
 	"github.com/cilium/tetragon/pkg/policyfilter"
 	"github.com/cilium/tetragon/pkg/policystats"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/cilium/tetragon/pkg/sequences"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -29,6 +31,11 @@ type policyInfo struct {
 	policyConf    *program.Map
 	policyStats   *program.Map
 	specOpts      *specOptions
+	metrics       *hookmetrics.Policy
+	sequences     *sequences.Policy
+	policyState   *program.Map
+	budget        *eventBudget
+	policyBudget  *program.Map
 }
 
 func newPolicyInfo(
@@ -100,6 +107,21 @@ func newPolicyInfoFromSpec(
 		opts.policyMode = policyconf.MonitorOnlyMode
 	}
 
+	metrics, err := hookmetrics.New(name, namespace, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	seqs, err := sequences.New(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := newEventBudget(namespace, name, spec, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &policyInfo{
 		name:          name,
 		namespace:     namespace,
@@ -108,9 +130,27 @@ func newPolicyInfoFromSpec(
 		policyConf:    nil,
 		policyStats:   nil,
 		specOpts:      opts,
+		metrics:       metrics,
+		sequences:     seqs,
+		budget:        budget,
 	}, nil
 }
 
+// addMetricsHooks makes the policy metrics available while the sensor is
+// loaded.
+func (pi *policyInfo) addMetricsHooks(sensor *sensors.Sensor) {
+	if pi.metrics == nil || sensor == nil {
+		return
+	}
+	sensor.AddPostLoadHook(func() error {
+		return hookmetrics.Register(pi.metrics)
+	})
+	sensor.AddPostUnloadHook(func() error {
+		hookmetrics.Unregister(pi.metrics)
+		return nil
+	})
+}
+
 func (pi *policyInfo) policyStatsMap(prog *program.Program) *program.Map {
 	if pi.policyStats != nil {
 		return program.MapUserFrom(pi.policyStats)
@@ -119,6 +159,18 @@ func (pi *policyInfo) policyStatsMap(prog *program.Program) *program.Map {
 	return pi.policyStats
 }
 
+func (pi *policyInfo) policyStateMap(prog *program.Program) *program.Map {
+	if pi.policyState != nil {
+		return program.MapUserFrom(pi.policyState)
+	}
+	pi.policyState = program.MapBuilderPolicy("tg_state_map", prog)
+	if pi.sequences != nil {
+		// expand the map only if needed to reduce the memory footprint when unused
+		pi.policyState.SetMaxEntries(stateMapMaxEntries)
+	}
+	return pi.policyState
+}
+
 func (pi *policyInfo) policyConfMap(prog *program.Program) *program.Map {
 	if pi.policyConf != nil {
 		return program.MapUserFrom(pi.policyConf)
@@ -169,7 +221,12 @@ func (h policyHandler) PolicyHandler(
 
 	polInfo, err := newPolicyInfo(policy, policyID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse options: %w", err)
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	// metrics are registered when the policy is loaded, but report name
+	// conflicts when it is added
+	if err := hookmetrics.Check(polInfo.metrics); err != nil {
+		return nil, err
 	}
 
 	if len(spec.KProbes) > 0 {
@@ -187,10 +244,20 @@ func (h policyHandler) PolicyHandler(
 		if allKprobesIgnored(validateInfo) {
 			return nil, nil
 		}
-		return createGenericKprobeSensor(spec, name, polInfo, validateInfo)
+		sensor, err := createGenericKprobeSensor(spec, name, polInfo, validateInfo)
+		if err != nil {
+			return nil, err
+		}
+		polInfo.addMetricsHooks(sensor)
+		return sensor, nil
 	}
 	if len(spec.Tracepoints) > 0 {
-		return createGenericTracepointSensor(spec, "generic_tracepoint", polInfo)
+		sensor, err := createGenericTracepointSensor(spec, "generic_tracepoint", polInfo)
+		if err != nil {
+			return nil, err
+		}
+		polInfo.addMetricsHooks(sensor)
+		return sensor, nil
 	}
 	if len(spec.LsmHooks) > 0 {
 		return createGenericLsmSensor(spec, "generic_lsm", polInfo)
@@ -6,6 +6,7 @@
 package tracing
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cilium/ebpf"
@@ -19,88 +20,117 @@ import (
 	"github.com/cilium/tetragon/pkg/sensors/program"
 )
 
-func selectorsMaploads(ks *selectors.KernelSelectorState, index uint32) []*program.MapLoad {
-	selBuff := ks.Buffer()
+const (
+	// selectorSlots is the number of slots of the selectors and config of the programs that
+	// can be updated in place (see SEL_SLOTS in bpf/process/selector_slots.h)
+	selectorSlots = 2
+	// selectorSlotMapName is the map holding the slot in use by the program
+	selectorSlotMapName = "sel_slot_map"
+
+	// number of entries of each slot of the matchBinaries maps
+	mbSlotEntries = selectors.MaxSelectors * selectors.MatchBinariesKinds
+)
+
+// selectorsMaploads returns the map loads of the selectors of the hook at the given index in
+// the program, written to the given selector slot. Programs that do not support slots only use
+// slot 0.
+func selectorsMaploads(ks *selectors.KernelSelectorState, index uint32, slot uint32) []*program.MapLoad {
+	selBuff := ks.SlotBuffer(slot)
 	maps := []*program.MapLoad{
 		{
 			Name: "filter_map",
 			Load: func(m *ebpf.Map, _ string) error {
-				return m.Update(index, selBuff[:], ebpf.UpdateAny)
+				return m.Update(index*selectorSlots+slot, selBuff[:], ebpf.UpdateAny)
 			},
 		}, {
 			Name: "argfilter_maps",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateArgFilterMaps(ks, pinPathPrefix, outerMap)
+				return populateArgFilterMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "addr4lpm_maps",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateAddr4FilterMaps(ks, pinPathPrefix, outerMap)
+				return populateAddr4FilterMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "addr6lpm_maps",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateAddr6FilterMaps(ks, pinPathPrefix, outerMap)
+				return populateAddr6FilterMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "tg_mb_sel_opts",
 			Load: func(outerMap *ebpf.Map, _ string) error {
-				return populateMatchBinariesMaps(ks, outerMap)
+				return populateMatchBinariesMaps(ks, outerMap, slot)
+			},
+		}, {
+			Name: "tg_threshold_sel_opts",
+			Load: func(outerMap *ebpf.Map, _ string) error {
+				return populateThresholdMaps(ks, outerMap, slot)
+			},
+		}, {
+			Name: "tg_state_sel_opts",
+			Load: func(outerMap *ebpf.Map, _ string) error {
+				return populateStateMaps(ks, outerMap, slot)
 			},
 		}, {
 			Name: "tg_mb_paths",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateMatchBinariesPathsMaps(ks, pinPathPrefix, outerMap)
+				return populateMatchBinariesPathsMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "string_prefix_maps",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringPrefixFilterMaps(ks, pinPathPrefix, outerMap)
+				return populateStringPrefixFilterMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "string_postfix_maps",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringPostfixFilterMaps(ks, pinPathPrefix, outerMap)
+				return populateStringPostfixFilterMaps(ks, pinPathPrefix, outerMap, slot)
+			},
+		}, {
+			Name: "string_pattern_maps",
+			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
+				return populateStringPatternFilterMaps(ks, pinPathPrefix, outerMap, slot)
 			},
 		}, {
 			Name: "string_maps_0",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 0)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 0, slot)
 			},
 		}, {
 			Name: "string_maps_1",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 1)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 1, slot)
 			},
 		}, {
 			Name: "string_maps_2",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 2)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 2, slot)
 			},
 		}, {
 			Name: "string_maps_3",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 3)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 3, slot)
 			},
 		}, {
 			Name: "string_maps_4",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 4)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 4, slot)
 			},
 		}, {
 			Name: "string_maps_5",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 5)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 5, slot)
 			},
 		}, {
 			Name: "string_maps_6",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 6)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 6, slot)
 			},
 		}, {
 			Name: "string_maps_7",
 			Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 7)
+				return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 7, slot)
 			},
 		},
 	}
@@ -109,17 +139,17 @@ func selectorsMaploads(ks *selectors.KernelSelectorState, index uint32) []*progr
 			{
 				Name: "string_maps_8",
 				Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 8)
+					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 8, slot)
 				},
 			}, {
 				Name: "string_maps_9",
 				Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 9)
+					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 9, slot)
 				},
 			}, {
 				Name: "string_maps_10",
 				Load: func(outerMap *ebpf.Map, pinPathPrefix string) error {
-					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 10)
+					return populateStringFilterMaps(ks, pinPathPrefix, outerMap, 10, slot)
 				},
 			},
 		}...)
@@ -127,10 +157,114 @@ func selectorsMaploads(ks *selectors.KernelSelectorState, index uint32) []*progr
 	return maps
 }
 
+// selectorOuterMaps returns the names of the outer maps of the selectors, whose ids are moved
+// to the selector slot by selectors.KernelSelectorState.SlotBuffer
+func selectorOuterMaps() []string {
+	names := []string{
+		"argfilter_maps", "addr4lpm_maps", "addr6lpm_maps",
+		"string_prefix_maps", "string_postfix_maps", "string_pattern_maps",
+	}
+	numSubMaps := selectors.StringMapsNumSubMaps
+	if !kernels.MinKernelVersion("5.11") {
+		numSubMaps = selectors.StringMapsNumSubMapsSmall
+	}
+	for i := range numSubMaps {
+		names = append(names, fmt.Sprintf("string_maps_%d", i))
+	}
+	return names
+}
+
+// clearSelectorSlot removes the selectors of the given slot from the maps of the loaded
+// program, so that none of the entries of a previous version of the policy is left in the slot
+// when the new version is written to it. The filter_map and config_map entries are not cleared
+// as they are all rewritten.
+func clearSelectorSlot(load *program.Program, slot uint32) error {
+	deleteKeys := func(name string, base, n uint32) error {
+		pm, ok := load.PinMap[name]
+		if !ok || pm.MapHandle == nil {
+			return nil
+		}
+		for key := base; key < base+n; key++ {
+			err := pm.MapHandle.Delete(key)
+			if err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				return fmt.Errorf("failed to delete %d from %s: %w", key, name, err)
+			}
+		}
+		return nil
+	}
+	zeroKeys := func(name string, base, n uint32) error {
+		pm, ok := load.PinMap[name]
+		if !ok || pm.MapHandle == nil {
+			return nil
+		}
+		zero := make([]byte, pm.MapHandle.ValueSize())
+		for key := base; key < base+n; key++ {
+			if err := pm.MapHandle.Update(key, zero, ebpf.UpdateAny); err != nil {
+				return fmt.Errorf("failed to clear %d in %s: %w", key, name, err)
+			}
+		}
+		return nil
+	}
+
+	for _, name := range selectorOuterMaps() {
+		err := deleteKeys(name, slot*selectors.SelectorMapsSlotEntries, selectors.SelectorMapsSlotEntries)
+		if err != nil {
+			return err
+		}
+	}
+	if err := deleteKeys("tg_mb_paths", slot*mbSlotEntries, mbSlotEntries); err != nil {
+		return err
+	}
+	// zero values of the options of the selectors mean no matchBinaries, matchThreshold and
+	// matchState
+	if err := zeroKeys("tg_mb_sel_opts", slot*mbSlotEntries, mbSlotEntries); err != nil {
+		return err
+	}
+	for _, name := range []string{"tg_threshold_sel_opts", "tg_state_sel_opts"} {
+		if err := zeroKeys(name, slot*selectors.MaxSelectors, selectors.MaxSelectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSelectors updates the selectors and config of a loaded program with the map loads
+// returned by mapLoads for a selector slot. They are written to the slot that is not in use,
+// after clearing it, and the program is then switched to it with a single update of its
+// sel_slot_map, so that the events are never filtered with a mix of both versions. The
+// previous slot is reused by the next update, assuming that the events that started before the
+// switch are done by then.
+func updateSelectors(load *program.Program, mapLoads func(slot uint32) ([]*program.MapLoad, error)) error {
+	pm, ok := load.PinMap[selectorSlotMapName]
+	if !ok || pm.MapHandle == nil {
+		return fmt.Errorf("updating selectors failed as map '%s' is not loaded", selectorSlotMapName)
+	}
+	var cur uint32
+	if err := pm.MapHandle.Lookup(uint32(0), &cur); err != nil {
+		return fmt.Errorf("failed to read the selector slot: %w", err)
+	}
+	next := (cur + 1) % selectorSlots
+	loads, err := mapLoads(next)
+	if err != nil {
+		return err
+	}
+	if err := clearSelectorSlot(load, next); err != nil {
+		return err
+	}
+	if err := load.UpdateMaps(loads); err != nil {
+		return err
+	}
+	if err := pm.MapHandle.Update(uint32(0), next, ebpf.UpdateAny); err != nil {
+		return fmt.Errorf("failed to switch to selector slot %d: %w", next, err)
+	}
+	return nil
+}
+
 func populateArgFilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntries := k.ValueMapsMaxEntries()
 	for i, vm := range k.ValueMaps() {
@@ -140,7 +274,11 @@ func populateArgFilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateArgFilterMap(pinPathPrefix, outerMap, uint32(i), vm.Data, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateArgFilterMap(pinPathPrefix, outerMap, id, vm.Data, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -190,6 +328,7 @@ func populateAddr4FilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntries := k.Addr4MapsMaxEntries()
 	for i, am := range k.Addr4Maps() {
@@ -199,7 +338,11 @@ func populateAddr4FilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateAddr4FilterMap(pinPathPrefix, outerMap, uint32(i), am, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateAddr4FilterMap(pinPathPrefix, outerMap, id, am, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -250,6 +393,7 @@ func populateAddr6FilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntries := k.Addr6MapsMaxEntries()
 	for i, am := range k.Addr6Maps() {
@@ -259,7 +403,11 @@ func populateAddr6FilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateAddr6FilterMap(pinPathPrefix, outerMap, uint32(i), am, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateAddr6FilterMap(pinPathPrefix, outerMap, id, am, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -311,6 +459,7 @@ func populateStringFilterMaps(
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
 	subMap int,
+	slot uint32,
 ) error {
 	maxEntries := k.StringMapsMaxEntries(subMap)
 	for i, am := range k.StringMaps(subMap) {
@@ -320,7 +469,11 @@ func populateStringFilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateStringFilterMap(pinPathPrefix, outerMap, subMap, uint32(i), am, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateStringFilterMap(pinPathPrefix, outerMap, subMap, id, am, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -372,22 +525,63 @@ func populateStringFilterMap(
 	return nil
 }
 
+// slotMapID returns the id of the i-th inner map of an outer map in the given selector slot
+func slotMapID(slot uint32, i int) (uint32, error) {
+	if i >= selectors.SelectorMapsSlotEntries {
+		return 0, fmt.Errorf("too many inner maps: %d, maximum is %d", i+1, selectors.SelectorMapsSlotEntries)
+	}
+	return slot*selectors.SelectorMapsSlotEntries + uint32(i), nil
+}
+
 func populateMatchBinariesMaps(
 	ks *selectors.KernelSelectorState,
 	bpfMap *ebpf.Map,
+	slot uint32,
 ) error {
 	for selID, sel := range ks.MatchBinaries() {
-		if err := bpfMap.Update(uint32(selID), sel, ebpf.UpdateAny); err != nil {
+		switch sel.Op {
+		case selectors.SelectorOpPrefix, selectors.SelectorOpNotPrefix,
+			selectors.SelectorOpPostfix, selectors.SelectorOpNotPostfix:
+			sel.MapID += slot * selectors.SelectorMapsSlotEntries
+		}
+		if err := bpfMap.Update(slot*mbSlotEntries+uint32(selID), sel, ebpf.UpdateAny); err != nil {
 			return fmt.Errorf("failed to insert %v: %w", sel, err)
 		}
 	}
 	return nil
 }
 
+func populateThresholdMaps(
+	ks *selectors.KernelSelectorState,
+	bpfMap *ebpf.Map,
+	slot uint32,
+) error {
+	for selID, opts := range ks.Thresholds() {
+		if err := bpfMap.Update(slot*selectors.MaxSelectors+uint32(selID), opts, ebpf.UpdateAny); err != nil {
+			return fmt.Errorf("failed to insert %v: %w", opts, err)
+		}
+	}
+	return nil
+}
+
+func populateStateMaps(
+	ks *selectors.KernelSelectorState,
+	bpfMap *ebpf.Map,
+	slot uint32,
+) error {
+	for selID, opts := range ks.States() {
+		if err := bpfMap.Update(slot*selectors.MaxSelectors+uint32(selID), opts, ebpf.UpdateAny); err != nil {
+			return fmt.Errorf("failed to insert %v: %w", opts, err)
+		}
+	}
+	return nil
+}
+
 func populateMatchBinariesPathsMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntriesFromAllSelector := k.MatchBinariesPathsMaxEntries()
 	matchBinaries := k.MatchBinaries()
@@ -399,7 +593,8 @@ func populateMatchBinariesPathsMaps(
 			maxEntries = maxEntriesFromAllSelector
 		}
 
-		innerName := fmt.Sprintf("tg_mb_path_%d", selectorID)
+		key := slot*mbSlotEntries + uint32(selectorID)
+		innerName := fmt.Sprintf("tg_mb_path_%d", key)
 		innerSpec := &ebpf.MapSpec{
 			Name:       innerName,
 			Type:       ebpf.Hash,
@@ -422,7 +617,7 @@ func populateMatchBinariesPathsMaps(
 			}
 		}
 
-		if err := outerMap.Update(uint32(selectorID), uint32(innerMap.FD()), 0); err != nil {
+		if err := outerMap.Update(key, uint32(innerMap.FD()), 0); err != nil {
 			return fmt.Errorf("failed to insert %s: %w", innerName, err)
 		}
 
@@ -440,6 +635,7 @@ func populateStringPrefixFilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntries := k.StringPrefixMapsMaxEntries()
 	for i, am := range k.StringPrefixMaps() {
@@ -449,7 +645,11 @@ func populateStringPrefixFilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateStringPrefixFilterMap(pinPathPrefix, outerMap, uint32(i), am, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateStringPrefixFilterMap(pinPathPrefix, outerMap, id, am, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -500,6 +700,7 @@ func populateStringPostfixFilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
 	outerMap *ebpf.Map,
+	slot uint32,
 ) error {
 	maxEntries := k.StringPostfixMapsMaxEntries()
 	for i, am := range k.StringPostfixMaps() {
@@ -509,7 +710,11 @@ func populateStringPostfixFilterMaps(
 		if !kernels.MinKernelVersion("5.9") {
 			nrEntries = uint32(maxEntries)
 		}
-		err := populateStringPostfixFilterMap(pinPathPrefix, outerMap, uint32(i), am, nrEntries)
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateStringPostfixFilterMap(pinPathPrefix, outerMap, id, am, nrEntries)
 		if err != nil {
 			return err
 		}
@@ -555,3 +760,70 @@ func populateStringPostfixFilterMap(
 
 	return nil
 }
+
+func populateStringPatternFilterMaps(
+	k *selectors.KernelSelectorState,
+	pinPathPrefix string,
+	outerMap *ebpf.Map,
+	slot uint32,
+) error {
+	maxEntries := k.StringPatternMapsMaxEntries()
+	for i, p := range k.StringPatternMaps() {
+		nrEntries := uint32(p.Entries())
+		// Versions before 5.9 do not allow inner maps to have different sizes.
+		// See: https://lore.kernel.org/bpf/20200828011800.1970018-1-kafai@fb.com/
+		if !kernels.MinKernelVersion("5.9") {
+			nrEntries = uint32(maxEntries)
+		}
+		id, err := slotMapID(slot, i)
+		if err != nil {
+			return err
+		}
+		err = populateStringPatternFilterMap(pinPathPrefix, outerMap, id, p, nrEntries)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func populateStringPatternFilterMap(
+	pinPathPrefix string,
+	outerMap *ebpf.Map,
+	innerID uint32,
+	innerData *selectors.KernelStringPattern,
+	maxEntries uint32,
+) error {
+	innerName := fmt.Sprintf("string_pattern_map_%d", innerID)
+	innerSpec := &ebpf.MapSpec{
+		Name:       innerName,
+		Type:       ebpf.Array,
+		KeySize:    uint32(4),
+		ValueSize:  uint32(2),
+		MaxEntries: maxEntries,
+	}
+	innerMap, err := ebpf.NewMapWithOptions(innerSpec, ebpf.MapOptions{
+		PinPath: sensors.PathJoin(pinPathPrefix, innerName),
+	})
+	if err != nil {
+		return fmt.Errorf("creating innerMap %s failed: %w", innerName, err)
+	}
+	defer innerMap.Close()
+
+	// array entries default to zero, i.e., the dead state, so only write the others
+	for key, next := range innerData.Trans {
+		if next == 0 {
+			continue
+		}
+		err := innerMap.Update(uint32(key), next, 0)
+		if err != nil {
+			return fmt.Errorf("failed to insert value into %s: %w", innerName, err)
+		}
+	}
+
+	if err := outerMap.Update(uint32(innerID), uint32(innerMap.FD()), 0); err != nil {
+		return fmt.Errorf("failed to insert %s: %w", innerName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/kernels"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+// selectorsTestProgram returns a program with the selector maps of a kprobe
+// program holding a single hook, as if it was loaded.
+func selectorsTestProgram(t *testing.T) *program.Program {
+	load := program.Builder("", "", "", "selectors_test", "")
+	newMap := func(name string, spec *ebpf.MapSpec) {
+		spec.Name = name
+		m, err := ebpf.NewMap(spec)
+		if err != nil {
+			t.Skipf("failed to create map %s: %v", name, err)
+		}
+		t.Cleanup(func() { m.Close() })
+		load.PinMap[name] = &program.Map{Name: name, Prog: load, MapHandle: m}
+	}
+	array := func(valueSize, maxEntries uint32) *ebpf.MapSpec {
+		return &ebpf.MapSpec{Type: ebpf.Array, KeySize: 4, ValueSize: valueSize, MaxEntries: maxEntries}
+	}
+	outer := func(maxEntries uint32, inner *ebpf.MapSpec) *ebpf.MapSpec {
+		inner.ValueSize = 1
+		inner.MaxEntries = 1
+		return &ebpf.MapSpec{Type: ebpf.ArrayOfMaps, KeySize: 4, ValueSize: 4, MaxEntries: maxEntries, InnerMap: inner}
+	}
+	outerEntries := uint32(selectors.SelectorMapsSlotEntries * selectorSlots)
+
+	newMap(selectorSlotMapName, array(4, 1))
+	newMap("filter_map", array(4096, selectorSlots))
+	newMap("tg_mb_sel_opts", array(16, mbSlotEntries*selectorSlots))
+	newMap("tg_threshold_sel_opts", array(16, selectors.MaxSelectors*selectorSlots))
+	newMap("tg_state_sel_opts", array(8, selectors.MaxSelectors*selectorSlots))
+	newMap("tg_mb_paths", outer(mbSlotEntries*selectorSlots, &ebpf.MapSpec{Type: ebpf.Hash, KeySize: processapi.BINARY_PATH_MAX_LEN}))
+	newMap("argfilter_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.Hash, KeySize: 8}))
+	newMap("addr4lpm_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.LPMTrie, KeySize: 8, Flags: 1}))
+	newMap("addr6lpm_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.LPMTrie, KeySize: 20, Flags: 1}))
+	newMap("string_prefix_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.LPMTrie, KeySize: 4 + selectors.StringPrefixMaxLength, Flags: 1}))
+	newMap("string_postfix_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.LPMTrie, KeySize: 4 + selectors.StringPostfixMaxLength, Flags: 1}))
+	newMap("string_pattern_maps", outer(outerEntries, &ebpf.MapSpec{Type: ebpf.Array, KeySize: 4}))
+	numSubMaps := selectors.StringMapsNumSubMaps
+	if !kernels.MinKernelVersion("5.11") {
+		numSubMaps = selectors.StringMapsNumSubMapsSmall
+	}
+	for i := range numSubMaps {
+		newMap(fmt.Sprintf("string_maps_%d", i), outer(outerEntries, &ebpf.MapSpec{Type: ebpf.Hash, KeySize: uint32(selectors.StringMapsSizes[i])}))
+	}
+	return load
+}
+
+func TestUpdateSelectorsSlots(t *testing.T) {
+	if !config.EnableLargeProgs() {
+		t.Skip("matchThreshold needs large BPF programs")
+	}
+	load := selectorsTestProgram(t)
+	update := func(sel v1alpha1.KProbeSelector) uint32 {
+		ks, err := selectors.InitKernelSelectorState(&selectors.KernelSelectorArgs{
+			Selectors: []v1alpha1.KProbeSelector{sel},
+		})
+		require.NoError(t, err)
+		require.NoError(t, updateSelectors(load, func(slot uint32) ([]*program.MapLoad, error) {
+			return selectorsMaploads(ks, 0, slot), nil
+		}))
+		var slot uint32
+		require.NoError(t, load.PinMap[selectorSlotMapName].MapHandle.Lookup(uint32(0), &slot))
+		return slot
+	}
+	mbKey := func(slot uint32) uint32 {
+		return slot*mbSlotEntries + uint32(selectors.MatchBinariesKey(0, selectors.MatchBinariesCurrent))
+	}
+	threshold := func(slot uint32) selectors.ThresholdSelectorOptions {
+		var opts selectors.ThresholdSelectorOptions
+		require.NoError(t, load.PinMap["tg_threshold_sel_opts"].MapHandle.Lookup(slot*selectors.MaxSelectors, &opts))
+		return opts
+	}
+	hasPaths := func(slot uint32) bool {
+		var id uint32
+		err := load.PinMap["tg_mb_paths"].MapHandle.Lookup(mbKey(slot), &id)
+		if errors.Is(err, ebpf.ErrKeyNotExist) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	v1 := v1alpha1.KProbeSelector{
+		MatchBinaries:  []v1alpha1.BinarySelector{{Operator: "In", Values: []string{"/usr/bin/cat"}}},
+		MatchThreshold: &v1alpha1.ThresholdSelector{Count: 3, Window: "10s"},
+	}
+	slot1 := update(v1)
+	assert.Equal(t, uint32(1), slot1, "the first update switches to the other slot")
+	assert.True(t, hasPaths(slot1))
+	assert.Equal(t, uint32(3), threshold(slot1).Count)
+
+	// the new version of the policy drops the matchBinaries and matchThreshold
+	slot2 := update(v1alpha1.KProbeSelector{})
+	assert.Equal(t, uint32(0), slot2)
+	assert.False(t, hasPaths(slot2))
+	assert.Equal(t, selectors.ThresholdSelectorOptions{}, threshold(slot2))
+	var mbOpts selectors.MatchBinariesSelectorOptions
+	require.NoError(t, load.PinMap["tg_mb_sel_opts"].MapHandle.Lookup(mbKey(slot2), &mbOpts))
+	assert.Equal(t, selectors.MatchBinariesSelectorOptions{}, mbOpts)
+
+	// the slot of the first version is cleared when it is reused
+	slot3 := update(v1alpha1.KProbeSelector{})
+	assert.Equal(t, slot1, slot3)
+	assert.False(t, hasPaths(slot3), "entry of the first version left in its slot")
+	assert.Equal(t, selectors.ThresholdSelectorOptions{}, threshold(slot3))
+}
+
+func TestUpdateEventBudget(t *testing.T) {
+	if !config.EnableLargeProgs() {
+		t.Skip("matchThreshold needs large BPF programs")
+	}
+	load := selectorsTestProgram(t)
+	budgetMap, err := ebpf.NewMap(&ebpf.MapSpec{
+		Name:       eventBudgetMapName,
+		Type:       ebpf.Array,
+		KeySize:    4,
+		ValueSize:  uint32(binary.Size(eventBudgetConf{})),
+		MaxEntries: 1,
+	})
+	if err != nil {
+		t.Skipf("failed to create map %s: %v", eventBudgetMapName, err)
+	}
+	t.Cleanup(func() { budgetMap.Close() })
+	load.PinMap[eventBudgetMapName] = &program.Map{Name: eventBudgetMapName, Prog: load, MapHandle: budgetMap}
+
+	budget := &eventBudget{events: 10, mode: eventBudgetModeCount}
+	throttled := budget.conf()
+	throttled.Curr = 20
+	throttled.Throttled = 1
+	require.NoError(t, budgetMap.Update(uint32(0), throttled, ebpf.UpdateAny))
+
+	// the new version of the policy has the same budget and another selector
+	newLoad := program.Builder("", "", "", "selectors_test", "")
+	polInfo := &policyInfo{budget: &eventBudget{events: 10, mode: eventBudgetModeCount}}
+	polInfo.policyBudgetMap(newLoad)
+	require.NoError(t, checkEventBudgetUpdate(budget, polInfo.budget))
+	ks, err := selectors.InitKernelSelectorState(&selectors.KernelSelectorArgs{
+		Selectors: []v1alpha1.KProbeSelector{{MatchThreshold: &v1alpha1.ThresholdSelector{Count: 3, Window: "10s"}}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, updateSelectors(load, func(slot uint32) ([]*program.MapLoad, error) {
+		return append(withoutEventBudgetLoad(newLoad.MapLoad), selectorsMaploads(ks, 0, slot)...), nil
+	}))
+	var conf eventBudgetConf
+	require.NoError(t, budgetMap.Lookup(uint32(0), &conf))
+	assert.Equal(t, *throttled, conf, "budget state reset by the update")
+
+	// budgets that changed cannot be updated in place
+	for _, newBudget := range []*eventBudget{
+		nil,
+		{events: 20, mode: eventBudgetModeCount},
+		{events: 10, mode: eventBudgetModeSample, sampleRate: defaultEventBudgetSampleRate},
+	} {
+		require.ErrorIs(t, checkEventBudgetUpdate(budget, newBudget), sensors.ErrNotUpdatable)
+	}
+	require.ErrorIs(t, checkEventBudgetUpdate(nil, budget), sensors.ErrNotUpdatable)
+	require.NoError(t, checkEventBudgetUpdate(nil, nil))
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package sensors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+// ErrNotUpdatable is returned when a loaded sensor cannot be updated in place
+// with the sensor of a new version of its policy, for example because the
+// hooks of the policy changed.
+var ErrNotUpdatable = errors.New("sensor cannot be updated in place")
+
+// probeUpdater is implemented by probe loaders that can update a loaded
+// program in place with the program of the same hook from a new version of
+// its policy.
+type probeUpdater interface {
+	UpdateProbe(args UpdateProbeArgs) error
+}
+
+// UpdateProbeArgs are the args to the UpdateProbe function.
+type UpdateProbeArgs struct {
+	// Load is the loaded program to update.
+	Load *program.Program
+	// New is the program of the new version of the policy, which is not
+	// loaded.
+	New *program.Program
+}
+
+func sameProgram(p, n *program.Program) bool {
+	return p.Name == n.Name && p.Label == n.Label && p.Attach == n.Attach &&
+		p.Type == n.Type && p.RetProbe == n.RetProbe &&
+		p.Override == n.Override && p.OverrideFmodRet == n.OverrideFmodRet &&
+		p.SleepableOffload == n.SleepableOffload
+}
+
+func sameMap(m, n *program.Map) bool {
+	if m.Name != n.Name || m.Type != n.Type {
+		return false
+	}
+	mMax, mOk := m.GetMaxEntries()
+	nMax, nOk := n.GetMaxEntries()
+	if mOk != nOk || mMax != nMax {
+		return false
+	}
+	mInner, mOk := m.GetMaxInnerEntries()
+	nInner, nOk := n.GetMaxInnerEntries()
+	return mOk == nOk && mInner == nInner
+}
+
+// updaters returns the probe updaters of the programs of the sensor, if it
+// can be updated in place with the new sensor. This requires both sensors to
+// have the same programs and maps.
+func (s *Sensor) updaters(n *Sensor) ([]probeUpdater, error) {
+	if s.Name != n.Name || len(s.Progs) != len(n.Progs) || len(s.Maps) != len(n.Maps) {
+		return nil, ErrNotUpdatable
+	}
+	for i := range s.Maps {
+		if !sameMap(s.Maps[i], n.Maps[i]) {
+			return nil, fmt.Errorf("%w: map %s changed", ErrNotUpdatable, s.Maps[i].Name)
+		}
+	}
+	ret := make([]probeUpdater, 0, len(s.Progs))
+	for i := range s.Progs {
+		if !sameProgram(s.Progs[i], n.Progs[i]) {
+			return nil, fmt.Errorf("%w: program %s changed", ErrNotUpdatable, s.Progs[i].Label)
+		}
+		updater, ok := registeredProbeLoad[s.Progs[i].Type].(probeUpdater)
+		if !ok {
+			return nil, fmt.Errorf("%w: programs of type %s cannot be updated", ErrNotUpdatable, s.Progs[i].Type)
+		}
+		ret = append(ret, updater)
+	}
+	return ret, nil
+}
+
+// Update updates the loaded sensor in place with the sensor of a new version
+// of its policy, which is not loaded. The programs of the sensor remain
+// attached and only the values of their maps, for example the selectors, are
+// updated. It returns ErrNotUpdatable if the sensor cannot be updated in place.
+// If updating one of the programs fails, the sensor might be partially
+// updated, and it should be reloaded.
+//
+// Once updated, the events of the sensor are handled with the user space state
+// of the new sensor, so its hooks replace the ones of the sensor. Its destroy
+// hook is kept along the one of the sensor, to clean up the state of both when
+// the sensor is destroyed.
+func (s *Sensor) Update(newSensor SensorIface) error {
+	n, ok := newSensor.(*Sensor)
+	if !ok {
+		return ErrNotUpdatable
+	}
+	if !s.Loaded {
+		return fmt.Errorf("update of sensor %s failed: sensor not loaded", s.Name)
+	}
+	updaters, err := s.updaters(n)
+	if err != nil {
+		return err
+	}
+
+	for i, updater := range updaters {
+		err := updater.UpdateProbe(UpdateProbeArgs{
+			Load: s.Progs[i],
+			New:  n.Progs[i],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update program %s: %w", s.Progs[i].Label, err)
+		}
+	}
+
+	if n.PostLoadHook != nil {
+		if err := n.PostLoadHook(); err != nil {
+			logger.GetLogger().Warn("Post load hook failed", "sensor", s.Name, logfields.Error, err)
+		}
+	}
+	s.PostLoadHook = n.PostLoadHook
+	s.PreUnloadHook = n.PreUnloadHook
+	s.PostUnloadHook = n.PostUnloadHook
+	oldDestroyHook, newDestroyHook := s.DestroyHook, n.DestroyHook
+	switch {
+	case oldDestroyHook == nil:
+		s.DestroyHook = newDestroyHook
+	case newDestroyHook != nil:
+		s.DestroyHook = func() error {
+			return errors.Join(oldDestroyHook(), newDestroyHook())
+		}
+	}
+	// the state of the new sensor now belongs to the sensor
+	n.DestroyHook = nil
+	n.Destroyed = true
+
+	logger.GetLogger().Info("Sensor updated", "sensor", s.Name)
+	return nil
+}
+
+// updateSensors updates the loaded sensors in place with the sensors of a new
+// version of their policy. It returns ErrNotUpdatable, without updating any of
+// the sensors, if one of them cannot be updated in place.
+func updateSensors(sensors, newSensors []SensorIface) error {
+	if len(sensors) != len(newSensors) {
+		return ErrNotUpdatable
+	}
+	for i := range sensors {
+		s, ok := sensors[i].(*Sensor)
+		if !ok || !s.Loaded {
+			return ErrNotUpdatable
+		}
+		n, ok := newSensors[i].(*Sensor)
+		if !ok {
+			return ErrNotUpdatable
+		}
+		if _, err := s.updaters(n); err != nil {
+			return err
+		}
+	}
+	for i := range sensors {
+		if err := sensors[i].(*Sensor).Update(newSensors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setGeneration sets the generation of the policy the sensors are loaded for.
+func setGeneration(sensors []SensorIface, generation uint64) {
+	for _, sensor := range sensors {
+		if s, ok := sensor.(*Sensor); ok {
+			s.Generation = generation
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package sensors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+type dummyUpdater struct {
+	updated []UpdateProbeArgs
+}
+
+func (d *dummyUpdater) LoadProbe(_ LoadProbeArgs) error {
+	return nil
+}
+
+func (d *dummyUpdater) UpdateProbe(args UpdateProbeArgs) error {
+	d.updated = append(d.updated, args)
+	return nil
+}
+
+type dummyLoader struct{}
+
+func (dummyLoader) LoadProbe(_ LoadProbeArgs) error {
+	return nil
+}
+
+func TestSensorUpdate(t *testing.T) {
+	updater := &dummyUpdater{}
+	registeredProbeLoad["dummy-updatable"] = updater
+	registeredProbeLoad["dummy-not-updatable"] = dummyLoader{}
+	t.Cleanup(func() {
+		delete(registeredProbeLoad, "dummy-updatable")
+		delete(registeredProbeLoad, "dummy-not-updatable")
+	})
+
+	newSensor := func(progType, attach string) *Sensor {
+		prog := program.Builder("bpf_prog.o", attach, "kprobe/"+attach, "prog", progType)
+		return &Sensor{
+			Name:  "sensor",
+			Progs: []*program.Program{prog},
+			Maps:  []*program.Map{program.MapBuilder("map", prog)},
+		}
+	}
+
+	loaded := newSensor("dummy-updatable", "fd_install")
+	require.Error(t, loaded.Update(newSensor("dummy-updatable", "fd_install")), "sensor is not loaded")
+	loaded.Loaded = true
+
+	require.ErrorIs(t, loaded.Update(newSensor("dummy-updatable", "security_file_open")), ErrNotUpdatable)
+	require.ErrorIs(t, loaded.Update(newSensor("dummy-not-updatable", "fd_install")), ErrNotUpdatable)
+	assert.Empty(t, updater.updated)
+
+	oldDestroyed, newDestroyed := false, false
+	loaded.DestroyHook = func() error {
+		oldDestroyed = true
+		return nil
+	}
+	n := newSensor("dummy-updatable", "fd_install")
+	n.DestroyHook = func() error {
+		newDestroyed = true
+		return nil
+	}
+	postLoad := false
+	n.PostLoadHook = func() error {
+		postLoad = true
+		return nil
+	}
+	require.NoError(t, loaded.Update(n))
+	require.Len(t, updater.updated, 1)
+	assert.Same(t, loaded.Progs[0], updater.updated[0].Load)
+	assert.Same(t, n.Progs[0], updater.updated[0].New)
+	assert.True(t, postLoad)
+	assert.True(t, n.Destroyed)
+
+	// the destroy hook of the sensor cleans up the state of both sensors
+	require.NoError(t, loaded.DestroyHook())
+	assert.True(t, oldDestroyed)
+	assert.True(t, newDestroyed)
+}
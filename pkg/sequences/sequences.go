@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package sequences implements the sequences defined in the sequences section
+// of tracing policies. Each step of a sequence is a bit of the per-process
+// state kept in the kernel: the SetState action sets it, and matchState
+// selectors match on it. In user space, the events that reached the steps are
+// recorded so that the event completing a sequence references them.
+package sequences
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+const (
+	// MaxSteps is the maximum number of steps of all the sequences of a
+	// policy, as each step is a bit of the 32 bits state of a process (see
+	// tg_state_map in bpf/process/state_maps.h).
+	MaxSteps = 32
+
+	// maxProcesses is the maximum number of processes whose reached steps
+	// are recorded for a policy.
+	maxProcesses = 8192
+)
+
+// Event is implemented by messages of hooks that can reach sequence steps.
+type Event interface {
+	// SequenceStep returns the step reached by the event, or nil.
+	SequenceStep() *Step
+	// SequenceHook returns the hook of the event, as reported in the steps
+	// referenced by the event completing the sequence.
+	SequenceHook() string
+}
+
+// Policy holds the sequences of a tracing policy.
+type Policy struct {
+	sequences map[string]*Sequence
+
+	// mu protects reached
+	mu sync.Mutex
+	// reached holds the earlier steps reached by processes, per sequence
+	reached *lru.Cache[reachedKey, []*tetragon.SequenceStep]
+}
+
+// Sequence is a sequence of a tracing policy.
+type Sequence struct {
+	policy *Policy
+	name   string
+	steps  []*Step
+	// mask holds the bits of all the steps of the sequence
+	mask uint32
+}
+
+// Step is a step of a sequence.
+type Step struct {
+	sequence *Sequence
+	name     string
+	bit      uint32
+}
+
+type reachedKey struct {
+	sequence string
+	execID   string
+}
+
+// New creates the sequences of a policy. It returns nil if the policy has no
+// sequences.
+func New(spec *v1alpha1.TracingPolicySpec) (*Policy, error) {
+	if len(spec.Sequences) == 0 {
+		return nil, nil
+	}
+
+	if len(spec.UProbes) != 0 || len(spec.LsmHooks) != 0 || len(spec.Usdts) != 0 {
+		return nil, errors.New("sequences are only supported in policies with kprobes or tracepoints")
+	}
+
+	reached, err := lru.New[reachedKey, []*tetragon.SequenceStep](maxProcesses)
+	if err != nil {
+		return nil, err
+	}
+	ret := &Policy{
+		sequences: map[string]*Sequence{},
+		reached:   reached,
+	}
+	bit := uint32(0)
+	for i := range spec.Sequences {
+		s := &spec.Sequences[i]
+		if s.Name == "" {
+			return nil, fmt.Errorf("sequences[%d]: name must be set", i)
+		}
+		if _, ok := ret.sequences[s.Name]; ok {
+			return nil, fmt.Errorf("sequences[%d]: sequence %q is defined more than once", i, s.Name)
+		}
+		if len(s.Steps) < 2 {
+			return nil, fmt.Errorf("sequences[%d]: a sequence needs at least 2 steps", i)
+		}
+		if int(bit)+len(s.Steps) > MaxSteps {
+			return nil, fmt.Errorf("sequences[%d]: the sequences of a policy can have at most %d steps", i, MaxSteps)
+		}
+		seq := &Sequence{policy: ret, name: s.Name}
+		for j, name := range s.Steps {
+			if name == "" {
+				return nil, fmt.Errorf("sequences[%d]: steps[%d]: name must be set", i, j)
+			}
+			if slices.Contains(s.Steps[:j], name) {
+				return nil, fmt.Errorf("sequences[%d]: step %q is defined more than once", i, name)
+			}
+			seq.steps = append(seq.steps, &Step{sequence: seq, name: name, bit: bit})
+			seq.mask |= 1 << bit
+			bit++
+		}
+		ret.sequences[s.Name] = seq
+	}
+	return ret, nil
+}
+
+func (p *Policy) sequence(name string) (*Sequence, error) {
+	if p == nil {
+		return nil, fmt.Errorf("sequence %q not found: the policy has no sequences", name)
+	}
+	seq, ok := p.sequences[name]
+	if !ok {
+		return nil, fmt.Errorf("sequence %q not found in the sequences of the policy", name)
+	}
+	return seq, nil
+}
+
+func (s *Sequence) step(name string) (*Step, error) {
+	for _, step := range s.steps {
+		if step.name == name {
+			return step, nil
+		}
+	}
+	return nil, fmt.Errorf("step %q not found in the steps of sequence %q", name, s.name)
+}
+
+// Step returns the step of a sequence of the policy.
+func (p *Policy) Step(sequence, step string) (*Step, error) {
+	seq, err := p.sequence(sequence)
+	if err != nil {
+		return nil, err
+	}
+	return seq.step(step)
+}
+
+// StepsMask returns the state bits of the given steps of a sequence of the
+// policy.
+func (p *Policy) StepsMask(sequence string, steps []string) (uint32, error) {
+	seq, err := p.sequence(sequence)
+	if err != nil {
+		return 0, err
+	}
+	if len(steps) == 0 {
+		return 0, errors.New("no steps")
+	}
+	mask := uint32(0)
+	for _, name := range steps {
+		step, err := seq.step(name)
+		if err != nil {
+			return 0, err
+		}
+		mask |= 1 << step.bit
+	}
+	return mask, nil
+}
+
+// Name returns the name of the step.
+func (s *Step) Name() string {
+	return s.name
+}
+
+// Sequence returns the name of the sequence of the step.
+func (s *Step) Sequence() string {
+	return s.sequence.name
+}
+
+// Final returns whether the step is the last step of its sequence.
+func (s *Step) Final() bool {
+	steps := s.sequence.steps
+	return steps[len(steps)-1] == s
+}
+
+// StateMasks returns the state bits that reaching the step sets and resets.
+// Reaching the last step resets the steps of the sequence, so that the
+// process can go through the sequence again.
+func (s *Step) StateMasks() (set uint32, reset uint32) {
+	if s.Final() {
+		return 0, s.sequence.mask
+	}
+	return 1 << s.bit, 0
+}
+
+// Handle records the event that reached the step. If the step completes the
+// sequence, the event is updated to reference the events of the earlier
+// steps reached by the process.
+func (s *Step) Handle(hook string, processed *tetragon.GetEventsResponse) {
+	if s == nil || processed == nil {
+		return
+	}
+
+	var execID string
+	var setSequence func(*tetragon.SequenceMatch)
+	switch ev := processed.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		execID = ev.ProcessKprobe.GetProcess().GetExecId()
+		setSequence = func(m *tetragon.SequenceMatch) { ev.ProcessKprobe.Sequence = m }
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		execID = ev.ProcessTracepoint.GetProcess().GetExecId()
+		setSequence = func(m *tetragon.SequenceMatch) { ev.ProcessTracepoint.Sequence = m }
+	default:
+		return
+	}
+	if execID == "" {
+		return
+	}
+
+	p := s.sequence.policy
+	key := reachedKey{sequence: s.sequence.name, execID: execID}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	steps, _ := p.reached.Get(key)
+	if s.Final() {
+		p.reached.Remove(key)
+		setSequence(&tetragon.SequenceMatch{
+			Name:  s.sequence.name,
+			Steps: steps,
+		})
+		return
+	}
+
+	// a step reached again is referenced by its latest event
+	steps = slices.DeleteFunc(slices.Clone(steps), func(step *tetragon.SequenceStep) bool {
+		return step.Name == s.name
+	})
+	steps = append(steps, &tetragon.SequenceStep{
+		Name: s.name,
+		Time: processed.Time,
+		Hook: hook,
+	})
+	p.reached.Add(key, steps)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package sequences
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func testSpec(seqs ...v1alpha1.SequenceSpec) *v1alpha1.TracingPolicySpec {
+	return &v1alpha1.TracingPolicySpec{
+		KProbes:   []v1alpha1.KProbeSpec{{Call: "sys_memfd_create"}},
+		Sequences: seqs,
+	}
+}
+
+func kprobeEvent(execID string, sec int64) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			Process: &tetragon.Process{ExecId: execID},
+		}},
+		Time: &timestamppb.Timestamp{Seconds: sec},
+	}
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(testSpec())
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = New(testSpec(
+		v1alpha1.SequenceSpec{Name: "memfd-exec", Steps: []string{"create", "write", "exec"}},
+		v1alpha1.SequenceSpec{Name: "ptrace-write", Steps: []string{"attach", "write"}},
+	))
+	require.NoError(t, err)
+
+	mask, err := p.StepsMask("memfd-exec", []string{"create", "write"})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0b011), mask)
+	mask, err = p.StepsMask("ptrace-write", []string{"write"})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0b10000), mask)
+
+	step, err := p.Step("memfd-exec", "write")
+	require.NoError(t, err)
+	assert.False(t, step.Final())
+	set, reset := step.StateMasks()
+	assert.Equal(t, uint32(0b010), set)
+	assert.Equal(t, uint32(0), reset)
+
+	step, err = p.Step("memfd-exec", "exec")
+	require.NoError(t, err)
+	assert.True(t, step.Final())
+	set, reset = step.StateMasks()
+	assert.Equal(t, uint32(0), set)
+	assert.Equal(t, uint32(0b111), reset)
+
+	_, err = p.Step("memfd-exec", "attach")
+	require.Error(t, err)
+	_, err = p.StepsMask("unknown", []string{"create"})
+	require.Error(t, err)
+	_, err = (*Policy)(nil).Step("memfd-exec", "create")
+	require.Error(t, err)
+}
+
+func TestNewErrors(t *testing.T) {
+	tooMany := make([]string, MaxSteps+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a' + i))
+	}
+	for _, spec := range []*v1alpha1.TracingPolicySpec{
+		testSpec(v1alpha1.SequenceSpec{Name: "", Steps: []string{"a", "b"}}),
+		testSpec(v1alpha1.SequenceSpec{Name: "s", Steps: []string{"a"}}),
+		testSpec(v1alpha1.SequenceSpec{Name: "s", Steps: []string{"a", "a"}}),
+		testSpec(v1alpha1.SequenceSpec{Name: "s", Steps: []string{"a", ""}}),
+		testSpec(v1alpha1.SequenceSpec{Name: "s", Steps: []string{"a", "b"}}, v1alpha1.SequenceSpec{Name: "s", Steps: []string{"c", "d"}}),
+		testSpec(v1alpha1.SequenceSpec{Name: "s", Steps: tooMany}),
+		{
+			LsmHooks:  []v1alpha1.LsmHookSpec{{Hook: "file_open"}},
+			Sequences: []v1alpha1.SequenceSpec{{Name: "s", Steps: []string{"a", "b"}}},
+		},
+	} {
+		_, err := New(spec)
+		require.Error(t, err, spec.Sequences)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	p, err := New(testSpec(v1alpha1.SequenceSpec{Name: "memfd-exec", Steps: []string{"create", "write", "exec"}}))
+	require.NoError(t, err)
+	create, _ := p.Step("memfd-exec", "create")
+	write, _ := p.Step("memfd-exec", "write")
+	exec, _ := p.Step("memfd-exec", "exec")
+
+	create.Handle("kprobe:sys_memfd_create", kprobeEvent("proc1", 1))
+	create.Handle("kprobe:sys_memfd_create", kprobeEvent("proc2", 2))
+	write.Handle("kprobe:sys_write", kprobeEvent("proc1", 3))
+	// reaching a step again replaces the earlier reference
+	write.Handle("kprobe:sys_write", kprobeEvent("proc1", 4))
+
+	ev := kprobeEvent("proc1", 5)
+	exec.Handle("kprobe:sys_execveat", ev)
+	seq := ev.GetProcessKprobe().GetSequence()
+	require.NotNil(t, seq)
+	assert.Equal(t, "memfd-exec", seq.Name)
+	require.Len(t, seq.Steps, 2)
+	assert.Equal(t, "create", seq.Steps[0].Name)
+	assert.Equal(t, "kprobe:sys_memfd_create", seq.Steps[0].Hook)
+	assert.Equal(t, int64(1), seq.Steps[0].Time.GetSeconds())
+	assert.Equal(t, "write", seq.Steps[1].Name)
+	assert.Equal(t, int64(4), seq.Steps[1].Time.GetSeconds())
+
+	// completing the sequence resets the steps of the process
+	ev = kprobeEvent("proc1", 6)
+	exec.Handle("kprobe:sys_execveat", ev)
+	assert.Empty(t, ev.GetProcessKprobe().GetSequence().GetSteps())
+
+	ev = kprobeEvent("proc2", 7)
+	exec.Handle("kprobe:sys_execveat", ev)
+	require.Len(t, ev.GetProcessKprobe().GetSequence().GetSteps(), 1)
+
+	// events of other hooks are ignored
+	var nilStep *Step
+	nilStep.Handle("kprobe:sys_write", kprobeEvent("proc1", 8))
+}
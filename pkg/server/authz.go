@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+)
+
+// Role is the role of a gRPC client, which determines the RPCs it can call.
+type Role int
+
+const (
+	// RoleNone is the role of clients that are not authorized to call any RPC.
+	RoleNone Role = iota
+	// RoleReadOnly is the role of clients that can only retrieve events and
+	// list the state of the agent.
+	RoleReadOnly
+	// RoleAdmin is the role of clients that can call any RPC, including the
+	// ones modifying policies and the debug ones.
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleReadOnly:
+		return "read-only"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+// readOnlyMethods are the RPCs that the read-only role can call. All other
+// RPCs, including the ones added in the future, require the admin role.
+var readOnlyMethods = map[string]struct{}{
+	tetragon.FineGuidanceSensors_GetEvents_FullMethodName:           {},
+	tetragon.FineGuidanceSensors_GetHealth_FullMethodName:           {},
+	tetragon.FineGuidanceSensors_GetVersion_FullMethodName:          {},
+	tetragon.FineGuidanceSensors_ListTracingPolicies_FullMethodName: {},
+	tetragon.FineGuidanceSensors_ListSensors_FullMethodName:         {},
+	tetragon.FineGuidanceSensors_ListProcesses_FullMethodName:       {},
+	tetragon.FineGuidanceSensors_GetProcessTree_FullMethodName:      {},
+}
+
+// MethodRole returns the role required to call an RPC.
+func MethodRole(fullMethod string) Role {
+	if _, ok := readOnlyMethods[fullMethod]; ok {
+		return RoleReadOnly
+	}
+	return RoleAdmin
+}
+
+// AuthzConfig configures the authorization of the gRPC server RPCs. Clients
+// are identified by the certificate they present with mutual TLS. The
+// identities of a certificate are its subject common name and its DNS, URI
+// and email subject alternative names.
+type AuthzConfig struct {
+	// AdminIdentities can call all RPCs.
+	AdminIdentities []string
+	// ReadOnlyIdentities can only call the read-only RPCs.
+	ReadOnlyIdentities []string
+}
+
+// Enabled returns true if authorization is configured.
+func (c *AuthzConfig) Enabled() bool {
+	return len(c.AdminIdentities)+len(c.ReadOnlyIdentities) > 0
+}
+
+// Authorizer checks that gRPC clients have the role required by the RPCs
+// they call, and logs the denied calls.
+type Authorizer struct {
+	roles map[string]Role
+}
+
+// NewAuthorizer returns an authorizer for the given configuration.
+func NewAuthorizer(conf AuthzConfig) (*Authorizer, error) {
+	roles := map[string]Role{}
+	for _, id := range conf.ReadOnlyIdentities {
+		if id == "" {
+			return nil, errors.New("empty read-only identity")
+		}
+		roles[id] = RoleReadOnly
+	}
+	for _, id := range conf.AdminIdentities {
+		if id == "" {
+			return nil, errors.New("empty admin identity")
+		}
+		roles[id] = RoleAdmin
+	}
+	return &Authorizer{roles: roles}, nil
+}
+
+// peerIdentities returns the identities of the verified client certificate
+// of the peer.
+func peerIdentities(ctx context.Context) ([]string, string) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ""
+	}
+	var addr string
+	if p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, addr
+	}
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	var ids []string
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	ids = append(ids, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		ids = append(ids, uri.String())
+	}
+	ids = append(ids, cert.EmailAddresses...)
+	return ids, addr
+}
+
+// role returns the highest role of the identities.
+func (a *Authorizer) role(ids []string) Role {
+	ret := RoleNone
+	for _, id := range ids {
+		if r := a.roles[id]; r > ret {
+			ret = r
+		}
+	}
+	return ret
+}
+
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) error {
+	ids, addr := peerIdentities(ctx)
+	role := a.role(ids)
+	required := MethodRole(fullMethod)
+	if role >= required {
+		return nil
+	}
+
+	logger.GetLogger().Warn("gRPC request denied",
+		"audit", true,
+		"method", fullMethod,
+		"peer", addr,
+		"identities", strings.Join(ids, ","),
+		"role", role.String(),
+		"requiredRole", required.String())
+	if len(ids) == 0 {
+		return status.Errorf(codes.Unauthenticated, "%s requires a client certificate", fullMethod)
+	}
+	return status.Errorf(codes.PermissionDenied, "%s requires the %s role", fullMethod, required)
+}
+
+// UnaryInterceptor returns an interceptor authorizing unary RPCs.
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns an interceptor authorizing streaming RPCs.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	ca.write(t, "ca.pem", "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) write(t *testing.T, name, pemType string, der []byte) string {
+	fname := filepath.Join(ca.dir, name)
+	require.NoError(t, os.WriteFile(fname, pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: der}), 0600))
+	return fname
+}
+
+// issue writes a certificate signed by the CA and its key, and returns their
+// files.
+func (ca *testCA) issue(t *testing.T, name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return ca.write(t, name+".pem", "CERTIFICATE", der), ca.write(t, name+"-key.pem", "EC PRIVATE KEY", keyDer)
+}
+
+func TestMethodRole(t *testing.T) {
+	assert.Equal(t, RoleReadOnly, MethodRole(tetragon.FineGuidanceSensors_GetEvents_FullMethodName))
+	assert.Equal(t, RoleReadOnly, MethodRole(tetragon.FineGuidanceSensors_ListTracingPolicies_FullMethodName))
+	assert.Equal(t, RoleAdmin, MethodRole(tetragon.FineGuidanceSensors_AddTracingPolicy_FullMethodName))
+	assert.Equal(t, RoleAdmin, MethodRole(tetragon.FineGuidanceSensors_SetDebug_FullMethodName))
+	assert.Equal(t, RoleAdmin, MethodRole("/tetragon.FineGuidanceSensors/NewMethod"))
+}
+
+func TestServerOptionsErrors(t *testing.T) {
+	_, err := ServerOptions(TLSConfig{CertFile: "cert.pem"}, AuthzConfig{})
+	require.Error(t, err)
+	_, err = ServerOptions(TLSConfig{}, AuthzConfig{AdminIdentities: []string{"admin"}})
+	require.ErrorContains(t, err, "requires mutual TLS")
+	opts, err := ServerOptions(TLSConfig{}, AuthzConfig{})
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
+func TestAuthorization(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, "server", 2, x509.ExtKeyUsageServerAuth)
+	opts, err := ServerOptions(TLSConfig{
+		CertFile:      serverCert,
+		KeyFile:       serverKey,
+		ClientCAFiles: []string{filepath.Join(ca.dir, "ca.pem")},
+	}, AuthzConfig{
+		AdminIdentities:    []string{"admin"},
+		ReadOnlyIdentities: []string{"reader"},
+	})
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(opts...)
+	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, tetragon.UnimplementedFineGuidanceSensorsServer{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	client := func(name string) tetragon.FineGuidanceSensorsClient {
+		conf := &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+		if name != "" {
+			certFile, keyFile := ca.issue(t, name, 3, x509.ExtKeyUsageClientAuth)
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			require.NoError(t, err)
+			conf.Certificates = []tls.Certificate{cert}
+		}
+		conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(conf)))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return tetragon.NewFineGuidanceSensorsClient(conn)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// authorized calls reach the server, which does not implement them
+	checkCode := func(cli tetragon.FineGuidanceSensorsClient, code codes.Code) {
+		_, err := cli.ListTracingPolicies(ctx, &tetragon.ListTracingPoliciesRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		_, err = cli.AddTracingPolicy(ctx, &tetragon.AddTracingPolicyRequest{})
+		assert.Equal(t, code, status.Code(err))
+		stream, err := cli.GetEvents(ctx, &tetragon.GetEventsRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	}
+	checkCode(client("admin"), codes.Unimplemented)
+	checkCode(client("reader"), codes.PermissionDenied)
+
+	_, err = client("unknown").ListSensors(ctx, &tetragon.ListSensorsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	// the client certificate is required by the TLS handshake
+	_, err = client("").ListSensors(ctx, &tetragon.ListSensorsRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestCertReload(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue(t, "server", 2, x509.ExtKeyUsageServerAuth)
+	conf, err := NewServerTLSConfig(TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+
+	serial := func() int64 {
+		c, err := conf.GetConfigForClient(&tls.ClientHelloInfo{})
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+		require.NoError(t, err)
+		return cert.SerialNumber.Int64()
+	}
+	assert.Equal(t, int64(2), serial())
+
+	// rotate the certificate, with a modification time that differs even
+	// on file systems with a coarse resolution
+	ca.issue(t, "server", 4, x509.ExtKeyUsageServerAuth)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	assert.Equal(t, int64(4), serial())
+
+	// an invalid certificate is not loaded
+	require.NoError(t, os.WriteFile(certFile, []byte("invalid"), 0600))
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	assert.Equal(t, int64(4), serial())
+}
@@ -17,6 +17,10 @@ func (f *FakeObserver) AddTracingPolicy(ctx context.Context, tp tracingpolicy.Tr
 	return nil
 }
 
+func (f *FakeObserver) UpdateTracingPolicy(ctx context.Context, tp tracingpolicy.TracingPolicy) error {
+	return nil
+}
+
 func (f *FakeObserver) DeleteTracingPolicy(ctx context.Context, sensorName string, sensorNamespace string) error {
 	return nil
 }
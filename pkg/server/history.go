@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// EventHistory assigns sequence numbers to events and keeps the most recent
+// ones in a ring buffer, so that GetEvents streams can be resumed. Sequence
+// numbers restart at one with every history, so they are tagged with a random
+// instance identifier. It is not safe for concurrent use: callers must serialize Add and Since with the
+// registration of listeners, so that a resuming listener gets every event
+// exactly once.
+type EventHistory struct {
+	instanceID string
+	events     []*tetragon.GetEventsResponse
+	next       int
+	count      int
+	lastSeq    uint64
+}
+
+// NewEventHistory returns a history keeping the last size events. With a size
+// of zero, sequence numbers are assigned but no event is kept.
+func NewEventHistory(size uint) *EventHistory {
+	return &EventHistory{
+		instanceID: uuid.NewString(),
+		events:     make([]*tetragon.GetEventsResponse, size),
+	}
+}
+
+// InstanceID returns the identifier of the history, which is set as the
+// instance_id of every event added.
+func (h *EventHistory) InstanceID() string {
+	return h.instanceID
+}
+
+// Add sets the sequence number and instance identifier of event and records it, evicting the oldest
+// event if the history is full.
+func (h *EventHistory) Add(event *tetragon.GetEventsResponse) {
+	h.lastSeq++
+	event.Sequence = h.lastSeq
+	event.InstanceId = h.instanceID
+	if len(h.events) == 0 {
+		return
+	}
+	h.events[h.next] = event
+	h.next = (h.next + 1) % len(h.events)
+	h.count = min(h.count+1, len(h.events))
+}
+
+// LastSequence returns the sequence number of the last event added.
+func (h *EventHistory) LastSequence() uint64 {
+	return h.lastSeq
+}
+
+// Since returns the events of the history with a sequence number greater than
+// seq, oldest first. If some of the events following seq were evicted, it also
+// returns the range of missing events. A seq of another instance, as sent by a
+// client that was connected to a previous instance of the agent, cannot be
+// compared with the sequence numbers of this history: all the events are
+// returned, with a gap flagged with InstanceChanged. A seq of this instance
+// beyond the last event, which no client could have received, has no events
+// to replay.
+func (h *EventHistory) Since(seq uint64, instanceID string) ([]*tetragon.GetEventsResponse, *tetragon.EventsGap) {
+	oldest := h.lastSeq - uint64(h.count) + 1
+	var gap *tetragon.EventsGap
+	if instanceID == h.instanceID && seq >= h.lastSeq {
+		return nil, nil
+	}
+	if instanceID != h.instanceID {
+		gap = &tetragon.EventsGap{FirstSequence: 1, LastSequence: oldest - 1, InstanceChanged: true}
+		seq = oldest - 1
+	} else if seq+1 < oldest {
+		gap = &tetragon.EventsGap{FirstSequence: seq + 1, LastSequence: oldest - 1}
+		seq = oldest - 1
+	}
+	n := int(h.lastSeq - seq)
+	ret := make([]*tetragon.GetEventsResponse, 0, n)
+	start := h.next - n
+	if start < 0 {
+		start += len(h.events)
+	}
+	for i := range n {
+		ret = append(ret, h.events[(start+i)%len(h.events)])
+	}
+	return ret, gap
+}
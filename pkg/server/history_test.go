@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/rthooks"
+)
+
+func historyEvent(binary string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: binary}},
+		},
+	}
+}
+
+func sequences(events []*tetragon.GetEventsResponse) []uint64 {
+	ret := []uint64{}
+	for _, ev := range events {
+		ret = append(ret, ev.Sequence)
+	}
+	return ret
+}
+
+func TestEventHistory(t *testing.T) {
+	h := NewEventHistory(3)
+	events, gap := h.Since(0, h.InstanceID())
+	assert.Empty(t, events)
+	assert.Nil(t, gap)
+
+	for range 2 {
+		h.Add(historyEvent("/bin/true"))
+	}
+	events, gap = h.Since(0, h.InstanceID())
+	assert.Equal(t, []uint64{1, 2}, sequences(events))
+	assert.Nil(t, gap)
+
+	for range 3 {
+		h.Add(historyEvent("/bin/true"))
+	}
+	assert.Equal(t, uint64(5), h.LastSequence())
+
+	events, gap = h.Since(2, h.InstanceID())
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.Nil(t, gap)
+
+	events, gap = h.Since(4, h.InstanceID())
+	assert.Equal(t, []uint64{5}, sequences(events))
+	assert.Nil(t, gap)
+
+	events, gap = h.Since(5, h.InstanceID())
+	assert.Empty(t, events)
+	assert.Nil(t, gap)
+
+	events, gap = h.Since(1, h.InstanceID())
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.Equal(t, &tetragon.EventsGap{FirstSequence: 2, LastSequence: 2}, gap)
+
+	for _, ev := range events {
+		assert.Equal(t, h.InstanceID(), ev.InstanceId)
+	}
+
+	// sequence numbers from a previous instance of the agent
+	for _, seq := range []uint64{1, 4, 100} {
+		events, gap = h.Since(seq, "previous")
+		assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+		assert.Equal(t, &tetragon.EventsGap{FirstSequence: 1, LastSequence: 2, InstanceChanged: true}, gap)
+	}
+	events, gap = h.Since(4, "")
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.True(t, gap.GetInstanceChanged())
+}
+
+func TestEventHistoryInstanceChanged(t *testing.T) {
+	h := NewEventHistory(3)
+	h.Add(historyEvent("/bin/true"))
+	assert.NotEqual(t, h.InstanceID(), NewEventHistory(3).InstanceID())
+
+	// no event of this instance is missing
+	events, gap := h.Since(1, "previous")
+	assert.Equal(t, []uint64{1}, sequences(events))
+	assert.Equal(t, &tetragon.EventsGap{FirstSequence: 1, LastSequence: 0, InstanceChanged: true}, gap)
+}
+
+func TestEventHistoryFuture(t *testing.T) {
+	h := NewEventHistory(4)
+	for range 3 {
+		h.Add(historyEvent("/bin/true"))
+	}
+
+	// a sequence number beyond the last event has nothing to replay
+	for _, seq := range []uint64{4, 10, math.MaxUint64} {
+		events, gap := h.Since(seq, h.InstanceID())
+		assert.Empty(t, events)
+		assert.Nil(t, gap)
+	}
+}
+
+func TestEventHistoryDisabled(t *testing.T) {
+	h := NewEventHistory(0)
+	h.Add(historyEvent("/bin/true"))
+	h.Add(historyEvent("/bin/true"))
+	events, gap := h.Since(1, h.InstanceID())
+	assert.Empty(t, events)
+	assert.Equal(t, &tetragon.EventsGap{FirstSequence: 2, LastSequence: 2}, gap)
+}
+
+type fakeResumableNotifier struct {
+	mu        sync.Mutex
+	history   *EventHistory
+	listeners map[Listener]struct{}
+	added     chan struct{}
+}
+
+func (n *fakeResumableNotifier) AddListener(l Listener) {
+	n.AddListenerFrom(l, 0, "")
+}
+
+func (n *fakeResumableNotifier) AddListenerFrom(l Listener, seq uint64, instanceID string) ([]*tetragon.GetEventsResponse, *tetragon.GetEventsResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.listeners[l] = struct{}{}
+	defer func() { n.added <- struct{}{} }()
+	if seq == 0 {
+		return nil, nil
+	}
+	events, gap := n.history.Since(seq, instanceID)
+	if gap == nil {
+		return events, nil
+	}
+	return events, &tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_EventsGap{EventsGap: gap}}
+}
+
+func (n *fakeResumableNotifier) RemoveListener(l Listener) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.listeners, l)
+}
+
+func (n *fakeResumableNotifier) NotifyListener(_ any, processed *tetragon.GetEventsResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.history.Add(processed)
+	for l := range n.listeners {
+		l.Notify(processed)
+	}
+}
+
+type fakeEventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	mu     sync.Mutex
+	events []*tetragon.GetEventsResponse
+}
+
+func (s *fakeEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeEventsStream) Send(ev *tetragon.GetEventsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, ev)
+	return nil
+}
+
+func (s *fakeEventsStream) received() []*tetragon.GetEventsResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*tetragon.GetEventsResponse(nil), s.events...)
+}
+
+func TestGetEventsResume(t *testing.T) {
+	var wg sync.WaitGroup
+	notifier := &fakeResumableNotifier{
+		history:   NewEventHistory(4),
+		listeners: make(map[Listener]struct{}),
+		added:     make(chan struct{}, 1),
+	}
+	srv := NewServer(t.Context(), &wg, notifier, &FakeObserver{}, rthooks.DummyHookRunner{})
+	for _, binary := range []string{"a", "b", "c", "d", "e", "f"} {
+		notifier.NotifyListener(nil, historyEvent(binary))
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	stream := &fakeEventsStream{ctx: ctx}
+	request := &tetragon.GetEventsRequest{
+		ResumeFrom:       1,
+		ResumeInstanceId: notifier.history.InstanceID(),
+		DenyList:         []*tetragon.Filter{{BinaryRegex: []string{"^d$"}}},
+	}
+	done := make(chan error)
+	go func() { done <- srv.GetEvents(request, stream) }()
+	<-notifier.added
+	notifier.NotifyListener(nil, historyEvent("g"))
+
+	require.Eventually(t, func() bool { return len(stream.received()) == 5 }, 5*time.Second, 10*time.Millisecond)
+	events := stream.received()
+	// events 2 was evicted from the history, 4 is filtered out
+	assert.Equal(t, &tetragon.EventsGap{FirstSequence: 2, LastSequence: 2}, events[0].GetEventsGap())
+	assert.Equal(t, []uint64{3, 5, 6, 7}, sequences(events[1:]))
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestGetEventsResumeInstanceChanged(t *testing.T) {
+	var wg sync.WaitGroup
+	notifier := &fakeResumableNotifier{
+		history:   NewEventHistory(4),
+		listeners: make(map[Listener]struct{}),
+		added:     make(chan struct{}, 1),
+	}
+	srv := NewServer(t.Context(), &wg, notifier, &FakeObserver{}, rthooks.DummyHookRunner{})
+	for _, binary := range []string{"a", "b"} {
+		notifier.NotifyListener(nil, historyEvent(binary))
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	stream := &fakeEventsStream{ctx: ctx}
+	// The aggregator sends on the same stream: the gap must still come first.
+	request := &tetragon.GetEventsRequest{
+		ResumeFrom:         100,
+		ResumeInstanceId:   "previous",
+		AggregationOptions: &tetragon.AggregationOptions{ChannelBufferSize: 10},
+	}
+	done := make(chan error)
+	go func() { done <- srv.GetEvents(request, stream) }()
+	<-notifier.added
+
+	require.Eventually(t, func() bool { return len(stream.received()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	events := stream.received()
+	assert.Equal(t, &tetragon.EventsGap{FirstSequence: 1, LastSequence: 0, InstanceChanged: true}, events[0].GetEventsGap())
+	assert.Equal(t, []uint64{1, 2}, sequences(events[1:]))
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestGetEventsResumeUnsupported(t *testing.T) {
+	var wg sync.WaitGroup
+	srv := NewServer(t.Context(), &wg, nil, &FakeObserver{}, rthooks.DummyHookRunner{})
+	stream := &fakeEventsStream{ctx: t.Context()}
+	err := srv.GetEvents(&tetragon.GetEventsRequest{ResumeFrom: 1}, stream)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/cilium/tetragon/pkg/version"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Listener interface {
@@ -38,9 +40,24 @@ type Notifier interface {
 	NotifyListener(original any, processed *tetragon.GetEventsResponse)
 }
 
+// ResumableNotifier is a Notifier that keeps a history of recent events, used
+// to resume GetEvents streams.
+type ResumableNotifier interface {
+	Notifier
+	// AddListenerFrom adds listener like AddListener and returns the events
+	// of the history with a sequence number greater than seq, which are not
+	// delivered to listener. If some of these events were evicted from the
+	// history, or if seq was assigned by another instance than instanceID, it
+	// also returns an events_gap response describing them.
+	AddListenerFrom(listener Listener, seq uint64, instanceID string) ([]*tetragon.GetEventsResponse, *tetragon.GetEventsResponse)
+}
+
 type observer interface {
 	// AddTracingPolicy will add a new tracing policy
 	AddTracingPolicy(ctx context.Context, policy tracingpolicy.TracingPolicy) error
+	// UpdateTracingPolicy replaces a tracing policy that was added with
+	// AddTracingPolicy with a new version of it, with the same name.
+	UpdateTracingPolicy(ctx context.Context, policy tracingpolicy.TracingPolicy) error
 	// DeleteTracingPolicy deletes a tracing policy that was added with
 	// AddTracingPolicy as defined by its name (policy.TpName()).
 	DeleteTracingPolicy(ctx context.Context, name string, namespace string) error
@@ -126,7 +143,15 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		"events.allow_list", request.GetAllowList(),
 		"events.deny_list", request.GetDenyList(),
 		"events.field_filters", request.GetFieldFilters(),
-		"events.aggregation_options", request.GetAggregationOptions())
+		"events.aggregation_options", request.GetAggregationOptions(),
+		"events.resume_from", request.GetResumeFrom())
+	resumable, ok := s.notifier.(ResumableNotifier)
+	if request.GetResumeFrom() > 0 && !ok {
+		if readyWG != nil {
+			readyWG.Done()
+		}
+		return status.Error(codes.Unimplemented, "resuming event streams is not supported")
+	}
 	allowList, err := filters.BuildFilterList(s.ctx, request.AllowList, filters.Filters)
 	if err != nil {
 		if readyWG != nil {
@@ -148,55 +173,78 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		}
 		return err
 	}
-	if aggregator != nil {
-		go aggregator.Start()
-	}
-
 	l := newListener()
-	s.notifier.AddListener(l)
+	var history []*tetragon.GetEventsResponse
+	var gap *tetragon.GetEventsResponse
+	if request.ResumeFrom > 0 {
+		history, gap = resumable.AddListenerFrom(l, request.ResumeFrom, request.ResumeInstanceId)
+	} else {
+		s.notifier.AddListener(l)
+	}
 	defer s.removeNotifierAndDrain(l)
 	if readyWG != nil {
 		readyWG.Done()
 	}
 	s.ctxCleanupWG.Add(1)
 	defer s.ctxCleanupWG.Done()
-	for {
-		select {
-		case event := <-l.events:
-			if !filters.Apply(allowList, denyList, &pkgEvent.Event{Event: event}) {
-				// Event is filtered out. Nothing to do here. Continue.
-				continue
-			}
 
-			// Get field filters
-			filters, err := fieldfilters.FieldFiltersFromGetEventsRequest(request)
+	handleEvent := func(event *tetragon.GetEventsResponse) error {
+		if !filters.Apply(allowList, denyList, &pkgEvent.Event{Event: event}) {
+			// Event is filtered out. Nothing to do here. Continue.
+			return nil
+		}
+
+		// Get field filters
+		filters, err := fieldfilters.FieldFiltersFromGetEventsRequest(request)
+		if err != nil {
+			return fmt.Errorf("failed to create field filters: %w", err)
+		}
+
+		// Apply field filters
+		for _, filter := range filters {
+			ev, err := filter.Filter(event)
 			if err != nil {
-				return fmt.Errorf("failed to create field filters: %w", err)
+				logger.GetLogger().Warn("Failed to apply field filter", "filter", filter, logfields.Error, err)
+				continue
 			}
+			event = ev
+		}
 
-			// Apply field filters
-			for _, filter := range filters {
-				ev, err := filter.Filter(event)
-				if err != nil {
-					logger.GetLogger().Warn("Failed to apply field filter", "filter", filter, logfields.Error, err)
-					continue
-				}
-				event = ev
+		if aggregator != nil {
+			// Send event to aggregator.
+			select {
+			case aggregator.GetEventChannel() <- event:
+			default:
+				logger.GetLogger().Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.",
+					"request", request)
 			}
+			return nil
+		}
+		// No need to aggregate. Directly send out the response.
+		return server.Send(event)
+	}
 
-			if aggregator != nil {
-				// Send event to aggregator.
-				select {
-				case aggregator.GetEventChannel() <- event:
-				default:
-					logger.GetLogger().Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.",
-						"request", request)
-				}
-			} else {
-				// No need to aggregate. Directly send out the response.
-				if err = server.Send(event); err != nil {
-					return err
-				}
+	// The gap marker is not subject to filters, so that the client always
+	// knows that events are missing. It is sent before the aggregator starts,
+	// since the aggregator sends on the same stream.
+	if gap != nil {
+		if err := server.Send(gap); err != nil {
+			return err
+		}
+	}
+	if aggregator != nil {
+		go aggregator.Start(server.Context())
+	}
+	for _, event := range history {
+		if err := handleEvent(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event := <-l.events:
+			if err := handleEvent(event); err != nil {
+				return err
 			}
 		case <-server.Context().Done():
 			if closer != nil {
@@ -247,6 +295,31 @@ func (s *Server) AddTracingPolicy(ctx context.Context, req *tetragon.AddTracingP
 	return &tetragon.AddTracingPolicyResponse{}, nil
 }
 
+func (s *Server) UpdateTracingPolicy(ctx context.Context, req *tetragon.UpdateTracingPolicyRequest) (*tetragon.UpdateTracingPolicyResponse, error) {
+	tp, err := tracingpolicy.FromYAML(req.GetYaml())
+	if err != nil {
+		logger.GetLogger().Warn("Server UpdateTracingPolicy request failed", logfields.Error, err)
+		return nil, err
+	}
+	namespace := ""
+	if tpNs, ok := tp.(tracingpolicy.TracingPolicyNamespaced); ok {
+		namespace = tpNs.TpNamespace()
+	}
+
+	logger.GetLogger().Debug("Received an UpdateTracingPolicy request",
+		"metadata.namespace", namespace,
+		"metadata.name", tp.TpName())
+
+	if err := s.observer.UpdateTracingPolicy(ctx, tp); err != nil {
+		logger.GetLogger().Warn("Server UpdateTracingPolicy request failed",
+			logfields.Error, err,
+			"metadata.namespace", namespace,
+			"metadata.name", tp.TpName())
+		return nil, err
+	}
+	return &tetragon.UpdateTracingPolicyResponse{}, nil
+}
+
 func (s *Server) DeleteTracingPolicy(ctx context.Context, req *tetragon.DeleteTracingPolicyRequest) (*tetragon.DeleteTracingPolicyResponse, error) {
 	logger.GetLogger().Debug("Received a DeleteTracingPolicy request", "name", req.GetName())
 
@@ -321,6 +394,28 @@ func (s *Server) GetVersion(_ context.Context, _ *tetragon.GetVersionRequest) (*
 	return &tetragon.GetVersionResponse{Version: version.Version}, nil
 }
 
+func (s *Server) ListProcesses(_ context.Context, req *tetragon.ListProcessesRequest) (*tetragon.ListProcessesResponse, error) {
+	processes, err := process.ListProcesses(req)
+	if err != nil {
+		if errors.Is(err, process.ErrCacheNotInitialized) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &tetragon.ListProcessesResponse{Processes: processes}, nil
+}
+
+func (s *Server) GetProcessTree(_ context.Context, req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	tree, err := process.GetProcessTree(req)
+	if err != nil {
+		if errors.Is(err, process.ErrProcessNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return tree, nil
+}
+
 func (s *Server) RuntimeHook(ctx context.Context, req *tetragon.RuntimeHookRequest) (*tetragon.RuntimeHookResponse, error) {
 	logger.GetLogger().Debug("Received a RuntimeHook request", "request", req)
 	err := s.hookRunner.RunHooks(ctx, req)
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+// TLSConfig configures TLS for the gRPC server.
+type TLSConfig struct {
+	// CertFile and KeyFile are the PEM encoded certificate and key of the
+	// server.
+	CertFile string
+	KeyFile  string
+	// ClientCAFiles are PEM encoded CA certificates. If set, clients have
+	// to present a certificate signed by one of them (mutual TLS).
+	ClientCAFiles []string
+}
+
+// Enabled returns true if TLS is configured.
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || len(c.ClientCAFiles) > 0
+}
+
+// certReloader holds the server certificate and the client CAs, and reloads
+// them when their files change, so that certificates can be rotated without
+// restarting the agent.
+type certReloader struct {
+	conf TLSConfig
+
+	mu        sync.Mutex
+	modTimes  []time.Time
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func (r *certReloader) files() []string {
+	return append([]string{r.conf.CertFile, r.conf.KeyFile}, r.conf.ClientCAFiles...)
+}
+
+func (r *certReloader) currentModTimes() ([]time.Time, error) {
+	files := r.files()
+	ret := make([]time.Time, 0, len(files))
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, fi.ModTime())
+	}
+	return ret, nil
+}
+
+func (r *certReloader) load() (*tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(r.conf.CertFile, r.conf.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	if len(r.conf.ClientCAFiles) == 0 {
+		return &cert, nil, nil
+	}
+	pool := x509.NewCertPool()
+	for _, f := range r.conf.ClientCAFiles {
+		pem, err := os.ReadFile(f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("failed to parse client CA file %s: no certificate found", f)
+		}
+	}
+	return &cert, pool, nil
+}
+
+// reload reloads the certificates if their files changed since they were
+// last loaded. On failure, the previously loaded certificates are kept.
+func (r *certReloader) reload() error {
+	modTimes, err := r.currentModTimes()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changed := r.cert == nil || len(modTimes) != len(r.modTimes)
+	for i := 0; !changed && i < len(modTimes); i++ {
+		changed = !modTimes[i].Equal(r.modTimes[i])
+	}
+	if !changed {
+		return nil
+	}
+
+	cert, clientCAs, err := r.load()
+	if err != nil {
+		return err
+	}
+	if r.cert != nil {
+		logger.GetLogger().Info("Reloaded gRPC server certificates")
+	}
+	r.modTimes = modTimes
+	r.cert = cert
+	r.clientCAs = clientCAs
+	return nil
+}
+
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	if err := r.reload(); err != nil {
+		logger.GetLogger().Warn("Failed to reload gRPC server certificates, using previous ones", logfields.Error, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conf := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*r.cert},
+	}
+	if r.clientCAs != nil {
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+		conf.ClientCAs = r.clientCAs
+	}
+	return conf, nil
+}
+
+// NewServerTLSConfig returns the TLS configuration of the gRPC server. The
+// certificates are loaded when it is created, and reloaded on new
+// connections if their files changed.
+func NewServerTLSConfig(conf TLSConfig) (*tls.Config, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required for TLS")
+	}
+	r := &certReloader{conf: conf}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}, nil
+}
+
+// ServerOptions returns the gRPC server options implementing the TLS and
+// authorization configurations.
+func ServerOptions(tlsConf TLSConfig, authzConf AuthzConfig) ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+	if tlsConf.Enabled() {
+		conf, err := NewServerTLSConfig(tlsConf)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(conf)))
+	}
+	if authzConf.Enabled() {
+		// clients are identified by their certificates
+		if len(tlsConf.ClientCAFiles) == 0 {
+			return nil, errors.New("authorization requires mutual TLS: client CA files must be set")
+		}
+		authz, err := NewAuthorizer(authzConf)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authz.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(authz.StreamInterceptor()))
+	}
+	return opts, nil
+}
@@ -20,7 +20,7 @@ import (
 type DummyPF struct{}
 
 func (s *DummyPF) AddPolicy(_ policyfilter.PolicyID, _ string, _ *slimv1.LabelSelector,
-	_ *slimv1.LabelSelector) error {
+	_ *slimv1.LabelSelector, _ *slimv1.LabelSelector, _ *slimv1.LabelSelector) error {
 	return nil
 }
 
@@ -46,9 +46,20 @@ func (s *DummyPF) DelPod(_ policyfilter.PodID) error {
 	return nil
 }
 
+func (s *DummyPF) UpdateNamespace(_ string, _ labels.Labels) error {
+	return nil
+}
+
+func (s *DummyPF) DelNamespace(_ string) error {
+	return nil
+}
+
 func (s *DummyPF) RegisterPodHandlers(_ cache.SharedIndexInformer) {
 }
 
+func (s *DummyPF) RegisterNamespaceHandlers(_ cache.SharedIndexInformer) {
+}
+
 func (s *DummyPF) Close() error {
 	return nil
 }
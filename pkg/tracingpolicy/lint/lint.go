@@ -0,0 +1,510 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+// Package lint validates tracing policies offline, reporting the mistakes that
+// would otherwise only be caught when the sensors of the policy are loaded.
+package lint
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
+
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/arch"
+	"github.com/cilium/tetragon/pkg/btf"
+	"github.com/cilium/tetragon/pkg/idtable"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/ksyms"
+	"github.com/cilium/tetragon/pkg/metrics/hookmetrics"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/sequences"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a problem found in a policy. Path is the field path of the
+// problem in the policy, such as spec.kprobes[0].selectors[1].matchArgs[0],
+// and Line and Column its position in the YAML document.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Path     string   `json:"path,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	var b strings.Builder
+	if d.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d: ", d.Line, d.Column)
+	}
+	b.WriteString(string(d.Severity))
+	if d.Path != "" {
+		b.WriteString(": " + d.Path)
+	}
+	b.WriteString(": " + d.Message)
+	return b.String()
+}
+
+// Options configures the checks that depend on the kernel.
+type Options struct {
+	// BTF is used to check that kprobe hooks exist and that their
+	// arguments match the kernel prototypes. Nil skips these checks.
+	BTF *btf.Spec
+	// Ksyms is used to find hooks in kernel modules. It can be nil.
+	Ksyms *ksyms.Ksyms
+	// ErrorInjection is the set of functions that can be overridden, as
+	// returned by ReadErrorInjectionList. Nil skips the check.
+	ErrorInjection map[string]struct{}
+}
+
+// DefaultErrorInjectionList is the path of the kernel list of functions
+// allowed for error injection.
+const DefaultErrorInjectionList = "/sys/kernel/debug/error_injection/list"
+
+// ReadErrorInjectionList reads the list of functions allowed for error
+// injection, which are the only syscalls the Override action can be used on.
+func ReadErrorInjectionList(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ret := map[string]struct{}{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		_, name := arch.CutSyscallPrefix(fields[0])
+		ret[name] = struct{}{}
+	}
+	return ret, scanner.Err()
+}
+
+var yamlLineRe = regexp.MustCompile(`line (\d+)`)
+
+type linter struct {
+	opts      *Options
+	root      *yaml.Node
+	diags     []Diagnostic
+	sequences *sequences.Policy
+}
+
+func (l *linter) add(sev Severity, path string, format string, args ...any) {
+	line, col := position(l.root, path)
+	l.diags = append(l.diags, Diagnostic{
+		Severity: sev,
+		Path:     path,
+		Line:     line,
+		Column:   col,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint checks a tracing policy YAML document and returns the problems found.
+func Lint(data []byte, opts *Options) []Diagnostic {
+	if opts == nil {
+		opts = &Options{}
+	}
+	l := &linter{opts: opts, root: &yaml.Node{}}
+	if err := yaml.Unmarshal(data, l.root); err != nil {
+		d := Diagnostic{Severity: SeverityError, Message: err.Error()}
+		if m := yamlLineRe.FindStringSubmatch(err.Error()); m != nil {
+			d.Line, _ = strconv.Atoi(m[1])
+		}
+		return []Diagnostic{d}
+	}
+
+	spec := l.parse(string(data))
+	if spec == nil {
+		return l.diags
+	}
+	l.checkSpec(spec)
+	return l.diags
+}
+
+// parse parses the policy, reporting schema validation errors. It returns nil
+// if the policy cannot be checked further.
+func (l *linter) parse(data string) *v1alpha1.TracingPolicySpec {
+	var tp tracingpolicy.TracingPolicy
+	var err error
+	kind := kindOf(l.root)
+	switch kind {
+	case v1alpha1.TPKindDefinition:
+		tp, err = tracingpolicy.TPContext.FromYAML(data)
+	case v1alpha1.TPNamespacedKindDefinition:
+		tp, err = tracingpolicy.TPNContext.FromYAML(data)
+	default:
+		l.add(SeverityError, "kind", "unknown kind %q, expected %s or %s", kind,
+			v1alpha1.TPKindDefinition, v1alpha1.TPNamespacedKindDefinition)
+		return nil
+	}
+	if err == nil {
+		return tp.TpSpec()
+	}
+
+	// validation errors are joined, other errors are fatal
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		l.add(SeverityError, "", "%s", err)
+		return nil
+	}
+	for _, e := range joined.Unwrap() {
+		var fieldErr *field.Error
+		var schemaErr *openapierrors.Validation
+		switch {
+		case errors.As(e, &fieldErr):
+			l.add(SeverityError, fieldErr.Field, "%s", fieldErr.ErrorBody())
+		case errors.As(e, &schemaErr):
+			l.add(SeverityError, schemaErr.Name, "%s", strings.TrimPrefix(schemaErr.Error(), schemaErr.Name+" "))
+		default:
+			l.add(SeverityError, "", "%s", e)
+		}
+	}
+	// do not report the same problems again as sensor errors
+	return nil
+}
+
+func kindOf(root *yaml.Node) string {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "kind" {
+			return root.Content[i+1].Value
+		}
+	}
+	return ""
+}
+
+func (l *linter) checkSpec(spec *v1alpha1.TracingPolicySpec) {
+	seqs, err := sequences.New(spec)
+	if err != nil {
+		l.add(SeverityError, "spec.sequences", "%v", err)
+	}
+	l.sequences = seqs
+
+	for i := range spec.KProbes {
+		l.checkKprobe(fmt.Sprintf("spec.kprobes[%d]", i), &spec.KProbes[i], spec.Lists)
+	}
+	for i := range spec.Tracepoints {
+		tp := &spec.Tracepoints[i]
+		// the type of auto arguments is read from tracefs when loading
+		// the policy, so filters on them cannot be checked
+		typed := func(arg *v1alpha1.ArgSelector) bool {
+			for _, a := range tp.Args {
+				if a.Index == arg.Index {
+					return a.Type != "" && a.Type != "auto"
+				}
+			}
+			return true
+		}
+		l.checkOmitArgs(fmt.Sprintf("spec.tracepoints[%d]", i), tp.OmitArgs, tp.Args)
+		l.checkSelectors(fmt.Sprintf("spec.tracepoints[%d]", i), tp.Selectors, tp.Args, nil, spec.Lists, false, typed)
+	}
+	for i := range spec.UProbes {
+		up := &spec.UProbes[i]
+		l.checkSelectors(fmt.Sprintf("spec.uprobes[%d]", i), up.Selectors, up.Args, up.Data, spec.Lists, true, nil)
+	}
+	for i := range spec.LsmHooks {
+		lsm := &spec.LsmHooks[i]
+		l.checkSelectors(fmt.Sprintf("spec.lsmhooks[%d]", i), lsm.Selectors, lsm.Args, nil, spec.Lists, false, nil)
+	}
+	for i := range spec.Metrics {
+		if err := hookmetrics.ValidateMetric(spec, i); err != nil {
+			l.add(SeverityError, fmt.Sprintf("spec.metrics[%d]", i), "%v", err)
+		}
+	}
+}
+
+// kprobeCalls returns the functions hooked by a kprobe, and whether they are
+// syscalls. It returns nil if they cannot be known offline.
+func (l *linter) kprobeCalls(path string, kp *v1alpha1.KProbeSpec, lists []v1alpha1.ListSpec) ([]string, bool) {
+	name, isList := strings.CutPrefix(kp.Call, "list:")
+	if !isList {
+		return []string{kp.Call}, kp.Syscall
+	}
+	for i := range lists {
+		list := &lists[i]
+		if list.Name != name {
+			continue
+		}
+		switch strings.ToLower(list.Type) {
+		case "syscalls":
+			var calls []string
+			for _, v := range list.Values {
+				// skip values with an ABI, such as i386/sys_dup
+				if !strings.Contains(v, "/") {
+					calls = append(calls, v)
+				}
+			}
+			return calls, true
+		case "":
+			return list.Values, kp.Syscall
+		}
+		// generated lists
+		return nil, false
+	}
+	l.add(SeverityError, path+".call", "list %q not found", name)
+	return nil, false
+}
+
+func (l *linter) checkKprobe(path string, kp *v1alpha1.KProbeSpec, lists []v1alpha1.ListSpec) {
+	if len(kp.Args)+len(kp.Data) > tracingapi.EventConfigMaxArgs {
+		l.add(SeverityError, path+".args", "too many arguments, max %d: args(%d) data(%d)",
+			tracingapi.EventConfigMaxArgs, len(kp.Args), len(kp.Data))
+	}
+	for j := range kp.Args {
+		arg := &kp.Args[j]
+		if arg.Index > 4 {
+			l.add(SeverityError, fmt.Sprintf("%s.args[%d].index", path, j), "index %d out of bounds, max 4", arg.Index)
+		}
+		if arg.Type == "auto" || arg.Type == "syscall64" {
+			l.add(SeverityError, fmt.Sprintf("%s.args[%d].type", path, j), "type '%s' is invalid for kprobes", arg.Type)
+		}
+	}
+
+	l.checkOmitArgs(path, kp.OmitArgs, kp.Args)
+
+	calls, isSyscall := l.kprobeCalls(path, kp, lists)
+	if l.opts.BTF != nil {
+		ks := l.opts.Ksyms
+		if ks == nil {
+			ks = &ksyms.Ksyms{}
+		}
+		spec := *kp
+		spec.Syscall = isSyscall
+		for _, call := range calls {
+			err := btf.ValidateKprobeSpec(l.opts.BTF, call, &spec, ks)
+			var warn *btf.ValidationWarnError
+			switch {
+			case err == nil:
+			case errors.As(err, &warn):
+				l.add(SeverityWarning, path+".call", "%s", err)
+			default:
+				l.add(SeverityError, path+".call", "%s", err)
+			}
+		}
+	}
+
+	l.checkOverride(path, kp, calls, isSyscall)
+	l.checkSelectors(path, kp.Selectors, kp.Args, kp.Data, lists, false, nil)
+	if kp.ReturnArg != nil {
+		_, err := selectors.InitKernelReturnSelectorState(kp.Selectors, kp.ReturnArg, idtable.New(), &listReader{lists}, nil)
+		if err != nil {
+			l.add(SeverityError, path+".selectors", "%s", err)
+		}
+	}
+}
+
+// checkOmitArgs checks that the omitted args are args of the hook whose data
+// is read when the hook is hit.
+func (l *linter) checkOmitArgs(path string, omitArgs []uint32, args []v1alpha1.KProbeArg) {
+	for j, index := range omitArgs {
+		argPath := fmt.Sprintf("%s.omitArgs[%d]", path, j)
+		pos := slices.IndexFunc(args, func(a v1alpha1.KProbeArg) bool {
+			return a.Index == index
+		})
+		switch {
+		case pos == -1:
+			l.add(SeverityError, argPath, "argument %d not found in the args", index)
+		case args[pos].ReturnCopy:
+			l.add(SeverityError, argPath, "argument %d has returnCopy set and cannot be omitted", index)
+		case slices.Contains(omitArgs[:j], index):
+			l.add(SeverityWarning, argPath, "argument %d is omitted more than once", index)
+		}
+	}
+}
+
+// syscallName returns the name of a syscall as found in the error injection
+// list, once stripped of its arch prefix.
+func syscallName(call string) string {
+	_, name := arch.CutSyscallPrefix(call)
+	if !strings.HasPrefix(name, "sys_") {
+		name = "sys_" + name
+	}
+	return name
+}
+
+func (l *linter) checkOverride(path string, kp *v1alpha1.KProbeSpec, calls []string, isSyscall bool) {
+	for s := range kp.Selectors {
+		for a, action := range kp.Selectors[s].MatchActions {
+			if !strings.EqualFold(action.Action, "override") {
+				continue
+			}
+			actionPath := fmt.Sprintf("%s.selectors[%d].matchActions[%d]", path, s, a)
+			for _, call := range calls {
+				switch {
+				case strings.HasPrefix(call, "security_"):
+				case !isSyscall:
+					l.add(SeverityError, actionPath, "override action can be used only with syscalls and security_ hooks, not %s", call)
+				case l.opts.ErrorInjection != nil:
+					if _, ok := l.opts.ErrorInjection[syscallName(call)]; !ok {
+						l.add(SeverityError, actionPath, "%s is not in the kernel error injection list and cannot be overridden", call)
+					}
+				}
+			}
+		}
+	}
+}
+
+// selectorField is a list of filters of a selector, checked one element at a
+// time to report errors at the element level.
+type selectorField struct {
+	name string
+	len  func(*v1alpha1.KProbeSelector) int
+	only func(*v1alpha1.KProbeSelector, int) v1alpha1.KProbeSelector
+}
+
+var selectorFields = []selectorField{
+	{"matchPIDs",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchPIDs) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchPIDs: s.MatchPIDs[i : i+1]}
+		}},
+	{"matchArgs",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchArgs) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchArgs: s.MatchArgs[i : i+1]}
+		}},
+	{"matchData",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchData) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchData: s.MatchData[i : i+1]}
+		}},
+	{"matchActions",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchActions) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchActions: s.MatchActions[i : i+1]}
+		}},
+	{"matchBinaries",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchBinaries) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchBinaries: s.MatchBinaries[i : i+1]}
+		}},
+	{"matchNamespaces",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchNamespaces) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchNamespaces: s.MatchNamespaces[i : i+1]}
+		}},
+	{"matchNamespaceChanges",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchNamespaceChanges) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchNamespaceChanges: s.MatchNamespaceChanges[i : i+1]}
+		}},
+	{"matchCapabilities",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchCapabilities) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchCapabilities: s.MatchCapabilities[i : i+1]}
+		}},
+	{"matchCapabilityChanges",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchCapabilityChanges) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchCapabilityChanges: s.MatchCapabilityChanges[i : i+1]}
+		}},
+	{"matchState",
+		func(s *v1alpha1.KProbeSelector) int { return len(s.MatchState) },
+		func(s *v1alpha1.KProbeSelector, i int) v1alpha1.KProbeSelector {
+			return v1alpha1.KProbeSelector{MatchState: s.MatchState[i : i+1]}
+		}},
+}
+
+// checkSelectors parses selectors the way the sensors do when loading the
+// policy. Each filter is parsed alone first, then each selector, then all
+// the selectors of the hook, so that errors are reported at the most
+// precise path. matchArgs filters for which checkArg returns false are
+// skipped.
+func (l *linter) checkSelectors(path string, sels []v1alpha1.KProbeSelector, args, data []v1alpha1.KProbeArg,
+	lists []v1alpha1.ListSpec, isUprobe bool, checkArg func(*v1alpha1.ArgSelector) bool) {
+	parse := func(sels []v1alpha1.KProbeSelector) error {
+		_, err := selectors.InitKernelSelectorState(&selectors.KernelSelectorArgs{
+			Selectors:      sels,
+			Args:           args,
+			Data:           data,
+			ActionArgTable: idtable.New(),
+			ListReader:     &listReader{lists},
+			IsUprobe:       isUprobe,
+			Sequences:      l.sequences,
+		})
+		return err
+	}
+
+	checked := make([]v1alpha1.KProbeSelector, 0, len(sels))
+	failed := false
+	for s := range sels {
+		sel := *sels[s].DeepCopy()
+		if checkArg != nil {
+			sel.MatchArgs = sel.MatchArgs[:0]
+			for _, arg := range sels[s].MatchArgs {
+				if checkArg(&arg) {
+					sel.MatchArgs = append(sel.MatchArgs, arg)
+				}
+			}
+		}
+		selPath := fmt.Sprintf("%s.selectors[%d]", path, s)
+		selFailed := false
+		for _, f := range selectorFields {
+			for j := range f.len(&sels[s]) {
+				if f.name == "matchArgs" && checkArg != nil && !checkArg(&sels[s].MatchArgs[j]) {
+					continue
+				}
+				if err := parse([]v1alpha1.KProbeSelector{f.only(&sels[s], j)}); err != nil {
+					l.add(SeverityError, fmt.Sprintf("%s.%s[%d]", selPath, f.name, j), "%s", err)
+					selFailed = true
+				}
+			}
+		}
+		if !selFailed {
+			if err := parse([]v1alpha1.KProbeSelector{sel}); err != nil {
+				l.add(SeverityError, selPath, "%s", err)
+				selFailed = true
+			}
+		}
+		failed = failed || selFailed
+		checked = append(checked, sel)
+	}
+	if !failed && len(checked) > 0 {
+		if err := parse(checked); err != nil {
+			l.add(SeverityError, path+".selectors", "%s", err)
+		}
+	}
+}
+
+// listReader implements selectors.ValueReader. It checks that lists exist,
+// without resolving syscall numbers.
+type listReader struct {
+	lists []v1alpha1.ListSpec
+}
+
+func (lr *listReader) Read(name string, _ uint32) ([]uint32, error) {
+	for i := range lr.lists {
+		if lr.lists[i].Name == name {
+			return make([]uint32, len(lr.lists[i].Values)), nil
+		}
+	}
+	return nil, fmt.Errorf("error list '%s' not found", name)
+}
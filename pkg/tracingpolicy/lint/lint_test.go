@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+func TestMain(m *testing.M) {
+	// do not probe the kernel for large programs support
+	option.Config.ForceLargeProgs = true
+	os.Exit(m.Run())
+}
+
+const validPolicy = `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "valid"
+spec:
+  kprobes:
+  - call: "sys_openat"
+    syscall: true
+    args:
+    - index: 1
+      type: "string"
+    selectors:
+    - matchArgs:
+      - index: 1
+        operator: "Prefix"
+        values:
+        - "/etc/"
+      matchActions:
+      - action: Post
+        rateLimit: "1m"
+`
+
+func TestLintValid(t *testing.T) {
+	assert.Empty(t, Lint([]byte(validPolicy), nil))
+}
+
+func TestLintSchema(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "schema"
+spec:
+  kprobes:
+  - call: "sys_openat"
+    args:
+    - index: 1
+      type: "strin"
+`
+	diags := Lint([]byte(policy), nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, SeverityError, diags[0].Severity)
+	assert.Equal(t, "spec.kprobes[0].args[0].type", diags[0].Path)
+	assert.Equal(t, 10, diags[0].Line)
+	assert.Contains(t, diags[0].Message, "should be one of")
+}
+
+func TestLintSelectors(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "selectors"
+spec:
+  kprobes:
+  - call: "fd_install"
+    syscall: false
+    args:
+    - index: 0
+      type: "int"
+    - index: 1
+      type: "file"
+    selectors:
+    - matchArgs:
+      - index: 2
+        operator: "Equal"
+        values:
+        - "1"
+      - index: 1
+        operator: "GT"
+        values:
+        - "1"
+      matchActions:
+      - action: Sigkill
+        rateLimit: "1m"
+      - action: Override
+        argError: -1
+`
+	diags := Lint([]byte(policy), nil)
+	paths := map[string]int{}
+	for _, d := range diags {
+		paths[d.Path] = d.Line
+	}
+	assert.Equal(t, map[string]int{
+		"spec.kprobes[0].selectors[0].matchArgs[0]":    16,
+		"spec.kprobes[0].selectors[0].matchArgs[1]":    20,
+		"spec.kprobes[0].selectors[0].matchActions[0]": 25,
+		"spec.kprobes[0].selectors[0].matchActions[1]": 27,
+	}, paths, "diagnostics: %v", diags)
+}
+
+func TestLintErrorInjection(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "override"
+spec:
+  lists:
+  - name: "calls"
+    type: "syscalls"
+    values:
+    - "sys_openat"
+    - "sys_dup"
+  kprobes:
+  - call: "list:calls"
+    selectors:
+    - matchActions:
+      - action: Override
+        argError: -1
+`
+	list := filepath.Join(t.TempDir(), "list")
+	require.NoError(t, os.WriteFile(list, []byte("__x64_sys_openat\tERRNO\n__arm64_sys_openat\tERRNO\n"), 0o644))
+	allowed, err := ReadErrorInjectionList(list)
+	require.NoError(t, err)
+
+	diags := Lint([]byte(policy), &Options{ErrorInjection: allowed})
+	require.Len(t, diags, 1, "diagnostics: %v", diags)
+	assert.Equal(t, "spec.kprobes[0].selectors[0].matchActions[0]", diags[0].Path)
+	assert.Contains(t, diags[0].Message, "sys_dup is not in the kernel error injection list")
+}
+
+func TestLintYAMLError(t *testing.T) {
+	diags := Lint([]byte("kind: TracingPolicy\nspec:\n  kprobes: [\n"), nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, SeverityError, diags[0].Severity)
+	assert.Positive(t, diags[0].Line)
+}
+
+func TestPosition(t *testing.T) {
+	diags := Lint([]byte("apiVersion: cilium.io/v1alpha1\nkind: Policy\n"), nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, Diagnostic{
+		Severity: SeverityError,
+		Path:     "kind",
+		Line:     2,
+		Column:   1,
+		Message:  `unknown kind "Policy", expected TracingPolicy or TracingPolicyNamespaced`,
+	}, diags[0])
+	assert.Equal(t, `2:1: error: kind: unknown kind "Policy", expected TracingPolicy or TracingPolicyNamespaced`, diags[0].String())
+}
+
+func TestLintMetrics(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "metrics"
+spec:
+  kprobes:
+  - call: "sys_write"
+    syscall: true
+    args:
+    - index: 0
+      type: "int"
+  metrics:
+  - name: "writes_total"
+    kprobe: "sys_write"
+    labels:
+    - name: "fd"
+      source: "arg"
+      index: 0
+  - name: "reads_total"
+    kprobe: "sys_read"
+`
+	diags := Lint([]byte(policy), nil)
+	require.Len(t, diags, 1, "diagnostics: %v", diags)
+	assert.Equal(t, "spec.metrics[1]", diags[0].Path)
+	assert.Equal(t, 19, diags[0].Line)
+	assert.Contains(t, diags[0].Message, `kprobe "sys_read" not found`)
+}
+
+func TestLintSequences(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "sequences"
+spec:
+  sequences:
+  - name: "memfd-exec"
+    steps: ["create", "exec"]
+  kprobes:
+  - call: "sys_memfd_create"
+    syscall: true
+    selectors:
+    - matchActions:
+      - action: SetState
+        argSequence: "memfd-exec"
+        argStep: "create"
+  - call: "sys_execveat"
+    syscall: true
+    selectors:
+    - matchState:
+      - sequence: "memfd-exec"
+        operator: In
+        values: ["create", "write"]
+      matchActions:
+      - action: SetState
+        argSequence: "memfd-exec"
+        argStep: "exec"
+`
+	diags := Lint([]byte(policy), nil)
+	require.Len(t, diags, 1, "diagnostics: %v", diags)
+	assert.Equal(t, "spec.kprobes[1].selectors[0].matchState[0]", diags[0].Path)
+	assert.Contains(t, diags[0].Message, `step "write" not found`)
+}
+
+func TestLintOmitArgs(t *testing.T) {
+	policy := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "omit-args"
+spec:
+  kprobes:
+  - call: "sys_read"
+    syscall: true
+    args:
+    - index: 0
+      type: "int"
+    - index: 1
+      type: "char_buf"
+      returnCopy: true
+      sizeArgIndex: 3
+    - index: 2
+      type: "size_t"
+    omitArgs: [0, 1, 4]
+`
+	diags := Lint([]byte(policy), nil)
+	require.Len(t, diags, 2, "diagnostics: %v", diags)
+	assert.Equal(t, "spec.kprobes[0].omitArgs[1]", diags[0].Path)
+	assert.Contains(t, diags[0].Message, "returnCopy")
+	assert.Equal(t, "spec.kprobes[0].omitArgs[2]", diags[1].Path)
+	assert.Contains(t, diags[1].Message, "argument 4 not found")
+}
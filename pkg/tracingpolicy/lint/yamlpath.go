@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package lint
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// splitPath splits a field path such as "spec.kprobes[0].args[1].type" into
+// mapping keys and sequence indexes. Indexes are returned as "[N]".
+func splitPath(path string) []string {
+	var ret []string
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			i := strings.IndexByte(part, '[')
+			if i < 0 {
+				ret = append(ret, part)
+				break
+			}
+			if i > 0 {
+				ret = append(ret, part[:i])
+			}
+			j := strings.IndexByte(part[i:], ']')
+			if j < 0 {
+				ret = append(ret, part[i:])
+				break
+			}
+			ret = append(ret, part[i:i+j+1])
+			part = part[i+j+1:]
+		}
+	}
+	return ret
+}
+
+// position returns the line and column of the node at path in the YAML
+// document root. If the path does not fully exist, the position of its
+// deepest existing ancestor is returned.
+func position(root *yaml.Node, path string) (int, int) {
+	if root == nil {
+		return 0, 0
+	}
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line, col := node.Line, node.Column
+	for _, elem := range splitPath(path) {
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == elem {
+					// point to the key, so that scalar values and
+					// nested blocks are reported on the same line
+					line, col = node.Content[i].Line, node.Content[i].Column
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			idx, err := strconv.Atoi(strings.Trim(elem, "[]"))
+			if err == nil && idx >= 0 && idx < len(node.Content) {
+				next = node.Content[idx]
+				line, col = next.Line, next.Column
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return line, col
+}
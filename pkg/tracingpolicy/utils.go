@@ -32,3 +32,14 @@ func policyDir(namespace, policyName string) string {
 func PolicyDir(namespace, policyName string) string {
 	return filepath.Join(policyDir(namespace, policyName))
 }
+
+// PolicyGenerationDir returns the directory of the BPF objects of the policy
+// loaded for the given generation. The first generation uses the directory of
+// the policy, the ones loaded when updating the policy, while the previous
+// generation is still loaded, use their own directory.
+func PolicyGenerationDir(namespace, policyName string, generation uint64) string {
+	if generation <= 1 {
+		return PolicyDir(namespace, policyName)
+	}
+	return fmt.Sprintf("%s@%d", PolicyDir(namespace, policyName), generation)
+}
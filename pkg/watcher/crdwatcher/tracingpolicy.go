@@ -94,18 +94,11 @@ func deleteTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors.Manager,
 	oldObj any, newObj any) {
 
-	update := func(oldTp, newTp tracingpolicy.TracingPolicy) {
-		var namespace string
-		if oldTpNs, ok := oldTp.(tracingpolicy.TracingPolicyNamespaced); ok {
-			namespace = oldTpNs.TpNamespace()
-		}
-
-		if err := s.DeleteTracingPolicy(ctx, oldTp.TpName(), namespace); err != nil {
-			log.Warn("updateTracingPolicy: failed to remove old policy", "old-name", oldTp.TpName(), logfields.Error, err)
-			return
-		}
-		if err := s.AddTracingPolicy(ctx, newTp); err != nil {
-			log.Warn("updateTracingPolicy: failed to add new policy", "new-name", newTp.TpName(), logfields.Error, err)
+	update := func(_, newTp tracingpolicy.TracingPolicy) {
+		// the sensor manager keeps the old policy loaded until the new one
+		// replaces it, and keeps it if the new one fails to load
+		if err := s.UpdateTracingPolicy(ctx, newTp); err != nil {
+			log.Warn("updateTracingPolicy: failed to update policy", "new-name", newTp.TpName(), logfields.Error, err)
 			return
 		}
 	}